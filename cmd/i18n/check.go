@@ -0,0 +1,175 @@
+// Copyright 2026 Joe Chen. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"go/token"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"gopkg.in/ini.v1"
+)
+
+var checkCommand = &command{
+	name: "check",
+	desc: "find missing, untranslated and unused translation keys",
+	run:  runCheck,
+}
+
+var checkUsage = `%[1]s check compares translation keys used by Go packages with keys defined by
+locale files, and exits with non-zero status if any problem is found. Keys used
+with dynamic keys can be annotated with a "; @dynamic" comment line above the key
+or the section to not be reported as unused. Keys and plural forms with empty
+values (e.g. added by the sync command) are reported as untranslated.
+
+Usage: %[1]s check [options] [packages]
+
+Options:
+
+`
+
+func runCheck(args []string) error {
+	fs := newFlagSet("check", checkUsage)
+	locales := fs.String("locales", "", `comma-separated list of glob patterns of locale files, e.g. "conf/locale/*.ini"`)
+	funcs := fs.String("funcs", "", "comma-separated list of additional functions that take translation keys, see the extract command")
+	tests := fs.Bool("tests", false, "whether to include test files")
+	_ = fs.Parse(args)
+
+	files, err := globFiles(*locales)
+	if err != nil {
+		return errors.Wrap(err, "glob locale files")
+	} else if len(files) == 0 {
+		return errors.Errorf("no locale files found by %q", *locales)
+	}
+
+	keyFuncs, err := parseKeyFuncs(*funcs)
+	if err != nil {
+		return errors.Wrap(err, "parse funcs")
+	}
+
+	patterns := fs.Args()
+	if len(patterns) == 0 {
+		patterns = []string{"./..."}
+	}
+	result, err := extractKeys(".", patterns, *tests, keyFuncs)
+	if err != nil {
+		return err
+	}
+
+	for _, call := range result.dynamic {
+		warnf("%s: dynamic key in call to %s cannot be checked", call.pos, call.fn)
+	}
+
+	problems, err := checkLocales(result, files)
+	if err != nil {
+		return err
+	}
+	for _, p := range problems {
+		fmt.Println(p)
+	}
+	if len(problems) > 0 {
+		return errors.Errorf("found %d problems in %d locale files", len(problems), len(files))
+	}
+	infof("no problems found in %d locale files", len(files))
+	return nil
+}
+
+// problem is a problem found in a locale file.
+type problem struct {
+	file string
+	kind string // e.g. "missing key", "untranslated key", "unused key" and "unused plural"
+	name string
+	refs []token.Position
+}
+
+func (p *problem) String() string {
+	s := fmt.Sprintf("%s: %s %q", p.file, p.kind, p.name)
+	if len(p.refs) == 0 {
+		return s
+	}
+
+	refs := make([]string, 0, len(p.refs))
+	for _, pos := range p.refs {
+		refs = append(refs, pos.String())
+	}
+	return s + " (used at " + strings.Join(refs, ", ") + ")"
+}
+
+// checkLocales returns problems of locale files compared with the extraction,
+// i.e. keys that are used but missing, keys and plural forms that are defined
+// with empty values, keys that are defined but unused, and plural nouns that
+// are defined but unused. Keys annotated as used with dynamic keys are not
+// reported as unused.
+func checkLocales(result *extraction, files []string) ([]*problem, error) {
+	var problems []*problem
+	for _, path := range files {
+		file, err := loadLocaleFile(path)
+		if err != nil {
+			return nil, errors.Wrapf(err, "load %q", path)
+		}
+
+		messages := localeMessages(file)
+		for _, key := range result.sortedKeys() {
+			if _, ok := messages[key]; !ok {
+				problems = append(problems, &problem{file: path, kind: "missing key", name: key, refs: result.keys[key]})
+			}
+		}
+		defined := make([]string, 0, len(messages))
+		for key := range messages {
+			defined = append(defined, key)
+		}
+		sort.Strings(defined)
+		dynamic := dynamicKeys(file)
+		for _, key := range defined {
+			refs, ok := result.keys[key]
+			if !ok && !dynamic[key] {
+				problems = append(problems, &problem{file: path, kind: "unused key", name: key})
+			} else if messages[key] == "" {
+				problems = append(problems, &problem{file: path, kind: "untranslated key", name: key, refs: refs})
+			}
+		}
+
+		for _, form := range untranslatedForms(file) {
+			problems = append(problems, &problem{file: path, kind: "untranslated plural", name: form})
+		}
+
+		nouns, used := pluralNouns(file)
+		for _, noun := range sortedSet(nouns) {
+			if _, ok := result.nouns[noun]; !ok && !used[noun] {
+				problems = append(problems, &problem{file: path, kind: "unused plural", name: noun})
+			}
+		}
+	}
+	return problems, nil
+}
+
+// untranslatedForms returns names of plural forms with empty values in the
+// order of the locale file, e.g. "file.few".
+func untranslatedForms(file *ini.File) []string {
+	s, err := file.GetSection(pluralsSection)
+	if err != nil {
+		return nil
+	}
+
+	var forms []string
+	for _, k := range s.Keys() {
+		if k.Value() == "" {
+			forms = append(forms, k.Name())
+		}
+	}
+	return forms
+}
+
+// sortedSet returns the sorted list of the set.
+func sortedSet(set map[string]bool) []string {
+	list := make([]string, 0, len(set))
+	for s := range set {
+		list = append(list, s)
+	}
+	sort.Strings(list)
+	return list
+}
@@ -0,0 +1,79 @@
+// Copyright 2026 Joe Chen. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckLocales(t *testing.T) {
+	funcs, err := parseKeyFuncs("(*unknwon.dev/i18n/cmd/i18n/testdata/extract.context).Tr:0")
+	require.Nil(t, err)
+	result, err := extractKeys(".", []string{"./testdata/extract"}, false, funcs)
+	require.Nil(t, err)
+
+	files, err := globFiles("testdata/locales/*.ini")
+	require.Nil(t, err)
+	assert.Equal(t, []string{"testdata/locales/locale_en-US.ini", "testdata/locales/locale_zh-CN.ini"}, files)
+
+	problems, err := checkLocales(result, files)
+	require.Nil(t, err)
+
+	got := make([]string, 0, len(problems))
+	for _, p := range problems {
+		got = append(got, p.String())
+	}
+	want := []string{
+		`testdata/locales/locale_zh-CN.ini: missing key "messages::wrapped" (used at testdata/extract/main.go:32:19)`,
+		`testdata/locales/locale_zh-CN.ini: unused key "messages::unused"`,
+		`testdata/locales/locale_zh-CN.ini: unused plural "cat"`,
+	}
+	assert.Equal(t, want, got)
+
+	t.Run("synced file", func(t *testing.T) {
+		src, err := readDocument("testdata/locales/locale_en-US.ini", "ini")
+		require.Nil(t, err)
+
+		path := filepath.Join(t.TempDir(), "locale_ru-RU.ini")
+		err = ioutil.WriteFile(path, []byte(`title = Добро пожаловать
+
+[plurals]
+file.one = файл
+file.other = файла
+
+[messages]
+hello = Привет, %s!
+`), 0644)
+		require.Nil(t, err)
+		_, _, err = syncFile(path, src, "TODO: translate", false)
+		require.Nil(t, err)
+
+		problems, err := checkLocales(result, []string{path})
+		require.Nil(t, err)
+
+		got := make([]string, 0, len(problems))
+		for _, p := range problems {
+			got = append(got, p.String())
+		}
+		want := []string{
+			path + `: untranslated key "messages::fallback" (used at testdata/extract/main.go:27:48)`,
+			path + `: untranslated key "messages::goodbye" (used at testdata/extract/main.go:26:26)`,
+			path + `: untranslated key "messages::wrapped" (used at testdata/extract/main.go:32:19)`,
+			path + `: untranslated plural "file.few"`,
+			path + `: untranslated plural "file.many"`,
+			path + `: untranslated plural "dog.one"`,
+			path + `: untranslated plural "dog.few"`,
+			path + `: untranslated plural "dog.many"`,
+			path + `: untranslated plural "dog.other"`,
+			path + `: unused plural "file"`,
+		}
+		assert.Equal(t, want, got)
+	})
+}
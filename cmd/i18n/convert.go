@@ -0,0 +1,176 @@
+// Copyright 2026 Joe Chen. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"unknwon.dev/i18n"
+)
+
+var convertCommand = &command{
+	name: "convert",
+	desc: "convert locale files between INI, JSON, PO and XLIFF",
+	run:  runConvert,
+}
+
+var convertUsage = `%[1]s convert converts a locale file between supported formats, i.e. "ini", "json",
+"po" and "xliff". Section names, plural nouns and comments are preserved so
+that the conversion can be reversed without losses. Metadata of messages are
+kept as notes for translators in PO and XLIFF rather than "@meta" keys.
+
+Usage: %[1]s convert [options]
+
+Options:
+
+`
+
+// fileFormat is a supported format of locale files.
+type fileFormat struct {
+	read  func(data []byte) (*document, error)
+	write func(w io.Writer, doc *document) error
+}
+
+// formats is the set of supported formats by names.
+var formats = map[string]*fileFormat{
+	"ini":   {read: readINI, write: writeINI},
+	"json":  {read: readJSON, write: writeJSON},
+	"po":    {read: readPO, write: writePO},
+	"xliff": {read: readXLIFF, write: writeXLIFF},
+}
+
+// formatOf returns the name of the format inferred by the extension of the
+// path.
+func formatOf(path string) string {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".ini":
+		return "ini"
+	case ".json":
+		return "json"
+	case ".po", ".pot":
+		return "po"
+	case ".xlf", ".xliff":
+		return "xliff"
+	}
+	return ""
+}
+
+func formatNames() string {
+	names := make([]string, 0, len(formats))
+	for name := range formats {
+		names = append(names, `"`+name+`"`)
+	}
+	sort.Strings(names)
+	return strings.Join(names, ", ")
+}
+
+func runConvert(args []string) error {
+	fs := newFlagSet("convert", convertUsage)
+	from := fs.String("from", "", "the format of the input file, default is inferred by the extension")
+	to := fs.String("to", "", "the format of the output file, default is inferred by the extension")
+	input := fs.String("i", "", "the input file")
+	output := fs.String("o", "", "the output file, default is the standard output")
+	lang := fs.String("lang", "", "the language of the locale, default is read from the input file or decided by its name")
+	source := fs.String("source", "", "the source locale file to take source texts from for bilingual formats (i.e. XLIFF)")
+	_ = fs.Parse(args)
+
+	if *input == "" {
+		return errors.New("the input file is required")
+	}
+	if *from == "" {
+		*from = formatOf(*input)
+	}
+	if *to == "" {
+		*to = formatOf(*output)
+	}
+
+	doc, err := readDocument(*input, *from)
+	if err != nil {
+		return err
+	}
+	if *lang != "" {
+		doc.lang = *lang
+	} else if doc.lang == "" {
+		doc.lang = langOf(*input)
+	}
+
+	if *source != "" {
+		src, err := readDocument(*source, formatOf(*source))
+		if err != nil {
+			return errors.Wrap(err, "read source")
+		}
+		doc.sourceLang = src.lang
+		if doc.sourceLang == "" {
+			doc.sourceLang = langOf(*source)
+		}
+		doc.sources = src.values()
+	}
+
+	var buf bytes.Buffer
+	if err = convert(&buf, doc, *to); err != nil {
+		return err
+	}
+
+	if *output == "" {
+		_, err = os.Stdout.Write(buf.Bytes())
+		return err
+	}
+	return ioutil.WriteFile(*output, buf.Bytes(), 0644)
+}
+
+// readDocument reads the document from the file in the format.
+func readDocument(path, name string) (*document, error) {
+	f, ok := formats[name]
+	if !ok {
+		return nil, errors.Errorf("unsupported format %q of %q, must be one of %s", name, path, formatNames())
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	doc, err := f.read(data)
+	if err != nil {
+		return nil, errors.Wrapf(err, "read %q", path)
+	}
+	return doc, nil
+}
+
+// convert validates the document as a locale and writes it in the format.
+func convert(w io.Writer, doc *document, name string) error {
+	f, ok := formats[name]
+	if !ok {
+		return errors.Errorf("unsupported output format %q, must be one of %s", name, formatNames())
+	}
+
+	data, err := doc.toINI()
+	if err != nil {
+		return err
+	}
+	_, err = i18n.NewStore().AddLocale(doc.lang, doc.lang, data)
+	if err != nil {
+		return errors.Wrap(err, "validate")
+	}
+	return f.write(w, doc)
+}
+
+// values returns values of all entries of the document by keys.
+func (d *document) values() map[string]string {
+	values := make(map[string]string)
+	for _, s := range d.sections {
+		for _, e := range s.entries {
+			values[joinKey(s.name, e.name)] = e.value
+		}
+	}
+	return values
+}
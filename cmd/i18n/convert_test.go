@@ -0,0 +1,308 @@
+// Copyright 2026 Joe Chen. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormatOf(t *testing.T) {
+	tests := []struct {
+		path string
+		want string
+	}{
+		{path: "locale_en-US.ini", want: "ini"},
+		{path: "en-US.json", want: "json"},
+		{path: "messages.po", want: "po"},
+		{path: "messages.XLF", want: "xliff"},
+		{path: "messages.xliff", want: "xliff"},
+		{path: "messages.txt", want: ""},
+	}
+	for _, test := range tests {
+		t.Run(test.path, func(t *testing.T) {
+			assert.Equal(t, test.want, formatOf(test.path))
+		})
+	}
+}
+
+func TestConvert(t *testing.T) {
+	doc, err := readDocument("testdata/convert/locale_en-US.ini", "ini")
+	require.Nil(t, err)
+	doc.lang = "en-US"
+
+	want, err := doc.toINI()
+	require.Nil(t, err)
+
+	for name, f := range formats {
+		t.Run(name, func(t *testing.T) {
+			var buf bytes.Buffer
+			require.Nil(t, convert(&buf, doc, name))
+
+			got, err := f.read(buf.Bytes())
+			require.Nil(t, err)
+			if name != "ini" {
+				assert.Equal(t, "en-US", got.lang)
+			}
+
+			// Converting back to INI should be lossless.
+			data, err := got.toINI()
+			require.Nil(t, err)
+			assert.Equal(t, string(want), string(data))
+		})
+	}
+
+	t.Run("unsupported format", func(t *testing.T) {
+		err := convert(new(bytes.Buffer), doc, "yaml")
+		assert.Equal(t, `unsupported output format "yaml", must be one of "ini", "json", "po", "xliff"`, fmt.Sprintf("%v", err))
+	})
+
+	t.Run("invalid locale", func(t *testing.T) {
+		doc := &document{lang: "en-US"}
+		doc.section("messages").entries = []*entry{{name: "hello", value: "${dog, 0}"}}
+		err := convert(new(bytes.Buffer), doc, "ini")
+		assert.Equal(t, `validate: new locale: the smallest index is 1 but got 0 for "${dog, 0}"`, fmt.Sprintf("%v", err))
+	})
+}
+
+func TestWritePO(t *testing.T) {
+	doc, err := readDocument("testdata/convert/locale_en-US.ini", "ini")
+	require.Nil(t, err)
+	doc.lang = "en-US"
+
+	var buf bytes.Buffer
+	require.Nil(t, writePO(&buf, doc))
+	want := `msgid ""
+msgstr ""
+"Language: en-US\n"
+"MIME-Version: 1.0\n"
+"Content-Type: text/plain; charset=UTF-8\n"
+"Content-Transfer-Encoding: 8bit\n"
+"Plural-Forms: nplurals=2; plural=(n==1 ? 0 : 1);\n"
+
+# The title of the home page
+msgid "title"
+msgstr "Welcome"
+
+#. section: Plural nouns
+msgctxt "plurals"
+msgid "file"
+msgid_plural "file"
+msgstr[0] "file"
+msgstr[1] "files"
+
+#. section: Messages of the home page
+#. section: and the dashboard
+# Greeting with the name of the user
+#. Shown after signing in
+#. context: home
+#, max-length:40
+msgctxt "messages"
+msgid "hello"
+msgstr "Hello, %s!"
+
+msgctxt "messages"
+msgid "changes"
+msgstr "%[1]d ${file, 1} changed, \"quoted\" <b>bold</b>"
+
+msgctxt "messages"
+msgid "multiline"
+msgstr "first line\nsecond line"
+
+msgctxt "messages"
+msgid "empty"
+msgstr ""
+`
+	assert.Equal(t, want, buf.String())
+
+	t.Run("continued lines", func(t *testing.T) {
+		got, err := readPO([]byte(`msgid ""
+msgstr ""
+"Language: zh-CN\n"
+
+#: main.go:10
+#, c-format
+msgctxt "messages"
+msgid "hello"
+msgstr ""
+"Hello, "
+"%s!"
+`))
+		require.Nil(t, err)
+		assert.Equal(t, "zh-CN", got.lang)
+		assert.Equal(t, map[string]string{"messages::hello": "Hello, %s!"}, got.values())
+	})
+
+	t.Run("plurals", func(t *testing.T) {
+		data, err := ioutil.ReadFile("testdata/convert/ru.po")
+		require.Nil(t, err)
+		doc, err := readPO(data)
+		require.Nil(t, err)
+		assert.Equal(t, "ru", doc.lang)
+
+		// Plural forms are mapped by the "Plural-Forms" header of gettext, which has
+		// no form for fractions.
+		want := map[string]string{
+			"title":                "Добро пожаловать",
+			"plurals::file.one":    "файл",
+			"plurals::file.few":    "файла",
+			"plurals::file.many":   "файлов",
+			"plurals::comment.one": "комментарий",
+			"messages::hello":      "Привет, %s!",
+		}
+		assert.Equal(t, want, doc.values())
+
+		data, err = doc.toINI()
+		require.Nil(t, err)
+		wantINI := `title = Добро пожаловать
+
+# Plural nouns
+[plurals]
+# Files of the repository
+file.one = файл
+file.few = файла
+file.many = файлов
+comment.one = комментарий
+
+[messages]
+# Greeting with the name of the user
+hello = Привет, %s!
+
+# Settings are not translated yet
+[settings]
+
+`
+		assert.Equal(t, wantINI, string(data))
+
+		// Plural forms are written in the order of the CLDR rule, and empty
+		// sections are kept.
+		var buf bytes.Buffer
+		require.Nil(t, writePO(&buf, doc))
+		got, err := readPO(buf.Bytes())
+		require.Nil(t, err)
+		assert.Equal(t, doc, got)
+		assert.Contains(t, buf.String(), `"Plural-Forms: nplurals=4; plural=(n%10==1 && n%100!=11 ? 0 : (n%10>=2 && n%10<=4) && (n%100<12 || n%100>14) ? 1 : (n%10==0 || (n%10>=5 && n%10<=9) || (n%100>=11 && n%100<=14)) ? 2 : 3);\n"`)
+		assert.Contains(t, buf.String(), `msgid "file"
+msgid_plural "file"
+msgstr[0] "файл"
+msgstr[1] "файла"
+msgstr[2] "файлов"
+msgstr[3] ""
+`)
+	})
+
+	t.Run("metadata keys", func(t *testing.T) {
+		doc := &document{lang: "en-US"}
+		doc.section("messages").entries = []*entry{
+			{name: "open", value: "Open", comment: "The verb"},
+			{name: "open@meta", value: `{"context": "button", "max_length": 10}`},
+		}
+
+		var buf bytes.Buffer
+		require.Nil(t, writePO(&buf, doc))
+		assert.NotContains(t, buf.String(), "open@meta")
+		assert.Contains(t, buf.String(), `# The verb
+#. context: button
+#, max-length:10
+msgctxt "messages"
+msgid "open"
+msgstr "Open"
+`)
+
+		got, err := readPO(buf.Bytes())
+		require.Nil(t, err)
+		want := []*entry{
+			{name: "open", value: "Open", comment: "The verb\n@context: button\n@max_length: 10"},
+		}
+		assert.Equal(t, want, got.section("messages").entries)
+	})
+
+	t.Run("plurals without a rule", func(t *testing.T) {
+		_, err := readPO([]byte(`msgid ""
+msgstr ""
+"Language: xx\n"
+
+msgid "file"
+msgid_plural "files"
+msgstr[0] "file"
+`))
+		assert.Equal(t, `line 7: no plural rule found for the language "xx"`, fmt.Sprintf("%v", err))
+	})
+}
+
+func TestWriteXLIFF(t *testing.T) {
+	doc := &document{lang: "zh-CN"}
+	s := doc.section("messages")
+	s.comment = "Messages"
+	s.entries = []*entry{
+		{name: "hello", value: "你好，%s！", comment: "Greeting"},
+		{name: "goodbye", value: "再见 <b>"},
+	}
+	doc.sourceLang = "en-US"
+	doc.sources = map[string]string{
+		"messages::hello":   "Hello, %s!",
+		"messages::goodbye": "Goodbye <b>",
+	}
+
+	var buf bytes.Buffer
+	require.Nil(t, writeXLIFF(&buf, doc))
+	want := `<?xml version="1.0" encoding="UTF-8"?>
+<xliff xmlns="urn:oasis:names:tc:xliff:document:1.2" version="1.2">
+  <file original="i18n" source-language="en-US" target-language="zh-CN" datatype="plaintext">
+    <body>
+      <group id="messages">
+        <note>Messages</note>
+        <trans-unit id="messages::hello" resname="hello">
+          <source>Hello, %s!</source>
+          <target>你好，%s！</target>
+          <note>Greeting</note>
+        </trans-unit>
+        <trans-unit id="messages::goodbye" resname="goodbye">
+          <source>Goodbye &lt;b&gt;</source>
+          <target>再见 &lt;b&gt;</target>
+        </trans-unit>
+      </group>
+    </body>
+  </file>
+</xliff>
+`
+	assert.Equal(t, want, buf.String())
+
+	got, err := readXLIFF(buf.Bytes())
+	require.Nil(t, err)
+	assert.Equal(t, doc, got)
+
+	t.Run("metadata keys", func(t *testing.T) {
+		doc := &document{lang: "en-US"}
+		doc.section("messages").entries = []*entry{
+			{name: "open", value: "Open", comment: "The verb\n@description: Opens the issue"},
+			{name: "open@meta", value: `{"context": "button", "max_length": 10}`},
+		}
+
+		var buf bytes.Buffer
+		require.Nil(t, writeXLIFF(&buf, doc))
+		assert.NotContains(t, buf.String(), "open@meta")
+		assert.Contains(t, buf.String(), `
+        <trans-unit id="messages::open" resname="open" maxwidth="10" size-unit="char">
+          <source>Open</source>
+          <note>The verb</note>
+          <note from="developer">Opens the issue</note>
+          <note from="developer">context: button</note>
+        </trans-unit>
+`)
+
+		got, err := readXLIFF(buf.Bytes())
+		require.Nil(t, err)
+		want := []*entry{
+			{name: "open", value: "Open", comment: "The verb\n@description: Opens the issue\n@context: button\n@max_length: 10"},
+		}
+		assert.Equal(t, want, got.section("messages").entries)
+	})
+}
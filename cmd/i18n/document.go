@@ -0,0 +1,200 @@
+// Copyright 2026 Joe Chen. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"gopkg.in/ini.v1"
+
+	"unknwon.dev/i18n"
+)
+
+// document is the format-independent model of a locale file, which keeps the
+// order of sections and entries, and comments.
+type document struct {
+	lang     string
+	sections []*section
+
+	// The optional language and messages of the source locale by keys, which
+	// are only used by bilingual formats (i.e. XLIFF).
+	sourceLang string
+	sources    map[string]string
+}
+
+// section is a section of a locale file, the "[plurals]" section contains
+// entries of plural nouns, e.g. "file.one".
+type section struct {
+	name    string
+	comment string // Without comment markers, lines are separated by "\n"
+	entries []*entry
+}
+
+// entry is a message or a plural form of a noun.
+type entry struct {
+	name    string
+	value   string
+	comment string // Without comment markers, lines are separated by "\n"
+}
+
+// section returns the section with the given name, it is created if not
+// exists.
+func (d *document) section(name string) *section {
+	for _, s := range d.sections {
+		if s.name == name {
+			return s
+		}
+	}
+
+	s := &section{name: name}
+	d.sections = append(d.sections, s)
+	return s
+}
+
+// stripComment returns the comment text without comment markers.
+func stripComment(comment string) string {
+	if comment == "" {
+		return ""
+	}
+
+	lines := strings.Split(comment, "\n")
+	for i, line := range lines {
+		line = strings.TrimSpace(line)
+		if line != "" && (line[0] == '#' || line[0] == ';') {
+			line = strings.TrimSpace(line[1:])
+		}
+		lines[i] = line
+	}
+	return strings.Join(lines, "\n")
+}
+
+// iniComment returns the comment text with comment markers of INI.
+func iniComment(comment string) string {
+	if comment == "" {
+		return ""
+	}
+
+	lines := strings.Split(comment, "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimSpace("# " + line)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// readINI reads the document from data of INI.
+func readINI(data []byte) (*document, error) {
+	file, err := ini.LoadSources(
+		ini.LoadOptions{
+			IgnoreInlineComment:         true,
+			UnescapeValueCommentSymbols: true,
+		},
+		data,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "load")
+	}
+
+	doc := new(document)
+	for _, s := range file.Sections() {
+		if s.Name() == ini.DefaultSection && len(s.Keys()) == 0 && s.Comment == "" {
+			continue
+		}
+
+		ds := doc.section(s.Name())
+		ds.comment = stripComment(s.Comment)
+		for _, k := range s.Keys() {
+			ds.entries = append(ds.entries, &entry{
+				name:    k.Name(),
+				value:   k.Value(), // The raw value without interpolation
+				comment: stripComment(k.Comment),
+			})
+		}
+	}
+	return doc, nil
+}
+
+// writeINI writes the document as INI.
+func writeINI(w io.Writer, doc *document) error {
+	file := ini.Empty()
+	for _, s := range doc.sections {
+		fs, err := file.NewSection(s.name)
+		if err != nil {
+			return errors.Wrapf(err, "new section %q", s.name)
+		}
+		fs.Comment = iniComment(s.comment)
+
+		for _, e := range s.entries {
+			k, err := fs.NewKey(e.name, e.value)
+			if err != nil {
+				return errors.Wrapf(err, "new key %q", joinKey(s.name, e.name))
+			}
+			k.Comment = iniComment(e.comment)
+		}
+	}
+
+	_, err := file.WriteTo(w)
+	return err
+}
+
+// toINI returns the document as INI.
+func (d *document) toINI() ([]byte, error) {
+	var buf bytes.Buffer
+	if err := writeINI(&buf, d); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// messages returns entries of the section except those of metadata (see
+// isMetaKey).
+func (s *section) messages() []*entry {
+	entries := make([]*entry, 0, len(s.entries))
+	for _, e := range s.entries {
+		if !isMetaKey(e.name) {
+			entries = append(entries, e)
+		}
+	}
+	return entries
+}
+
+// meta returns the metadata of the entry read from its comment and the
+// "<name>@meta" entry of the section (see i18n.ParseMessageMeta).
+func (s *section) meta(e *entry) i18n.MessageMeta {
+	var object string
+	for _, me := range s.entries {
+		if me.name == e.name+metaKeySuffix {
+			object = me.value
+			break
+		}
+	}
+	return i18n.ParseMessageMeta(e.comment, object)
+}
+
+// contextNotePrefix is the prefix of notes of the context of messages for
+// formats that keep metadata as notes for translators, i.e. PO and XLIFF.
+const contextNotePrefix = "context: "
+
+// metaComment returns the comment of the metadata with fields appended as
+// "@<field>: <value>" lines, which is the reverse of i18n.ParseMessageMeta.
+func metaComment(meta i18n.MessageMeta) string {
+	var lines []string
+	if meta.Comment != "" {
+		lines = append(lines, meta.Comment)
+	}
+	if meta.Description != "" {
+		lines = append(lines, "@description: "+meta.Description)
+	}
+	if meta.Context != "" {
+		lines = append(lines, "@context: "+meta.Context)
+	}
+	if meta.MaxLength > 0 {
+		lines = append(lines, "@max_length: "+strconv.Itoa(meta.MaxLength))
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,342 @@
+// Copyright 2026 Joe Chen. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"golang.org/x/tools/go/packages"
+)
+
+var extractCommand = &command{
+	name: "extract",
+	desc: "extract translation keys from Go source",
+	run:  runExtract,
+}
+
+var extractUsage = `%[1]s extract finds translation keys used by Go packages, and writes new keys
+to the source locale file.
+
+Usage: %[1]s extract [options] [packages]
+
+Options:
+
+`
+
+func runExtract(args []string) error {
+	fs := newFlagSet("extract", extractUsage)
+	out := fs.String("o", "", "the source locale file to write or update")
+	funcs := fs.String("funcs", "", `comma-separated list of additional functions that take translation keys, e.g. "(*example.com/web.Context).Tr:0" where the number is the index of the key argument`)
+	tests := fs.Bool("tests", false, "whether to include test files")
+	_ = fs.Parse(args)
+
+	keyFuncs, err := parseKeyFuncs(*funcs)
+	if err != nil {
+		return errors.Wrap(err, "parse funcs")
+	}
+
+	patterns := fs.Args()
+	if len(patterns) == 0 {
+		patterns = []string{"./..."}
+	}
+	result, err := extractKeys(".", patterns, *tests, keyFuncs)
+	if err != nil {
+		return err
+	}
+
+	for _, call := range result.dynamic {
+		warnf("%s: dynamic key in call to %s", call.pos, call.fn)
+	}
+
+	for _, key := range result.sortedKeys() {
+		fmt.Println(key)
+		for _, pos := range result.keys[key] {
+			fmt.Printf("\t%s\n", pos)
+		}
+	}
+	infof("found %d keys and %d dynamic keys", len(result.keys), len(result.dynamic))
+
+	if *out == "" {
+		return nil
+	}
+
+	added, unused, err := updateSourceFile(*out, result)
+	if err != nil {
+		return errors.Wrap(err, "update source file")
+	}
+	for _, key := range unused {
+		warnf("%s: unused key %q", *out, key)
+	}
+	infof("added %d keys to %s", len(added), *out)
+	return nil
+}
+
+// defaultKeyFuncs is the list of functions that take translation keys, with
+// the index of the key argument.
+var defaultKeyFuncs = map[string]int{
+	"(*unknwon.dev/i18n.Locale).Translate":             0,
+	"(*unknwon.dev/i18n.Locale).TranslateWithFallback": 1,
+	"(*unknwon.dev/i18n.Locale).TranslateNamed":        0,
+	"(*unknwon.dev/i18n.Locale).TranslateHTML":         0,
+	"(*unknwon.dev/i18n.Locale).TranslateNamedHTML":    0,
+	"unknwon.dev/i18n.T":                               1,
+	"unknwon.dev/i18n.TN":                              1,
+	"unknwon.dev/i18n.NewError":                        0,
+	"unknwon.dev/i18n.WrapError":                       1,
+	"unknwon.dev/i18n.Lazy":                            0,
+}
+
+// nounFuncs is the list of functions that take plural nouns, with the index of
+// the noun argument.
+var nounFuncs = map[string]int{
+	"(*unknwon.dev/i18n.Locale).Plural": 0,
+}
+
+// parseKeyFuncs parses the comma-separated list of functions in the form of
+// "<full name>:<index>" and merges them with the default ones.
+func parseKeyFuncs(s string) (map[string]int, error) {
+	funcs := make(map[string]int, len(defaultKeyFuncs))
+	for name, index := range defaultKeyFuncs {
+		funcs[name] = index
+	}
+
+	for _, f := range strings.Split(s, ",") {
+		f = strings.TrimSpace(f)
+		if f == "" {
+			continue
+		}
+
+		i := strings.LastIndex(f, ":")
+		if i < 0 {
+			return nil, errors.Errorf("missing index of the key argument for %q", f)
+		}
+		index, err := strconv.Atoi(f[i+1:])
+		if err != nil || index < 0 {
+			return nil, errors.Errorf("invalid index of the key argument for %q", f)
+		}
+		funcs[f[:i]] = index
+	}
+	return funcs, nil
+}
+
+// dynamicCall is a call that takes a translation key which is not a constant.
+type dynamicCall struct {
+	pos token.Position
+	fn  string
+}
+
+// extraction is the result of extracting translation keys.
+type extraction struct {
+	keys    map[string][]token.Position
+	nouns   map[string][]token.Position // Plural nouns
+	dynamic []dynamicCall
+}
+
+// sortedKeys returns the sorted list of keys.
+func (e *extraction) sortedKeys() []string {
+	keys := make([]string, 0, len(e.keys))
+	for key := range e.keys {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// extractKeys loads packages of the patterns in the directory, and finds keys
+// of calls to the functions.
+func extractKeys(dir string, patterns []string, tests bool, funcs map[string]int) (*extraction, error) {
+	pkgs, err := loadPackages(dir, patterns, tests)
+	if err != nil {
+		return nil, err
+	}
+
+	wd, _ := filepath.Abs(dir)
+	result := &extraction{
+		keys:  make(map[string][]token.Position),
+		nouns: make(map[string][]token.Position),
+	}
+	seen := make(map[token.Position]bool) // Test variants of packages have the same files
+	for _, pkg := range pkgs {
+		for _, file := range pkg.Syntax {
+			for _, decl := range file.Decls {
+				// Keys passed through wrapper functions are dynamic by nature, and they
+				// are reported at calls to the wrapper functions.
+				inWrapper := false
+				if fd, ok := decl.(*ast.FuncDecl); ok {
+					if fn, ok := pkg.TypesInfo.Defs[fd.Name].(*types.Func); ok {
+						_, inWrapper = funcs[fn.FullName()]
+					}
+				}
+
+				ast.Inspect(decl, func(node ast.Node) bool {
+					call, ok := node.(*ast.CallExpr)
+					if !ok {
+						return true
+					}
+
+					fn := calledFunc(pkg.TypesInfo, call)
+					if fn == nil {
+						return true
+					}
+					refs := result.keys
+					index, ok := funcs[fn.FullName()]
+					if !ok {
+						refs = result.nouns
+						index, ok = nounFuncs[fn.FullName()]
+					}
+					if !ok || index >= len(call.Args) {
+						return true
+					}
+
+					arg := call.Args[index]
+					pos := pkg.Fset.Position(arg.Pos())
+					if seen[pos] {
+						return true
+					}
+					seen[pos] = true
+
+					if rel, err := filepath.Rel(wd, pos.Filename); err == nil {
+						pos.Filename = rel
+					}
+
+					tv := pkg.TypesInfo.Types[arg]
+					if tv.Value == nil || tv.Value.Kind() != constant.String {
+						if !inWrapper {
+							result.dynamic = append(result.dynamic, dynamicCall{pos: pos, fn: fn.FullName()})
+						}
+						return true
+					}
+
+					key := constant.StringVal(tv.Value)
+					refs[key] = append(refs[key], pos)
+					return true
+				})
+			}
+		}
+	}
+
+	sort.Slice(result.dynamic, func(i, j int) bool {
+		return lessPosition(result.dynamic[i].pos, result.dynamic[j].pos)
+	})
+	for _, refs := range []map[string][]token.Position{result.keys, result.nouns} {
+		for _, positions := range refs {
+			sort.Slice(positions, func(i, j int) bool {
+				return lessPosition(positions[i], positions[j])
+			})
+		}
+	}
+	return result, nil
+}
+
+// loadPackages loads and type-checks packages of the patterns in the directory.
+func loadPackages(dir string, patterns []string, tests bool) ([]*packages.Package, error) {
+	cfg := &packages.Config{
+		Mode:  packages.LoadAllSyntax,
+		Dir:   dir,
+		Tests: tests,
+	}
+	pkgs, err := packages.Load(cfg, patterns...)
+	if err != nil {
+		return nil, errors.Wrap(err, "load packages")
+	}
+
+	var errs []string
+	for _, pkg := range pkgs {
+		for _, err := range pkg.Errors {
+			errs = append(errs, err.Error())
+		}
+	}
+	if len(errs) > 0 {
+		return nil, errors.Errorf("load packages:\n%s", strings.Join(errs, "\n"))
+	}
+	return pkgs, nil
+}
+
+// calledFunc returns the function or method of the call, or nil if it is not a
+// static call.
+func calledFunc(info *types.Info, call *ast.CallExpr) *types.Func {
+	var ident *ast.Ident
+	switch fun := call.Fun.(type) {
+	case *ast.Ident:
+		ident = fun
+	case *ast.SelectorExpr:
+		ident = fun.Sel
+	default:
+		return nil
+	}
+
+	fn, _ := info.Uses[ident].(*types.Func)
+	return fn
+}
+
+func lessPosition(a, b token.Position) bool {
+	if a.Filename != b.Filename {
+		return a.Filename < b.Filename
+	}
+	if a.Line != b.Line {
+		return a.Line < b.Line
+	}
+	return a.Column < b.Column
+}
+
+// updateSourceFile adds new keys of the extraction to the source locale file
+// with comments of their positions. It returns added keys and keys in the file
+// that are not used, except keys annotated as used with dynamic keys.
+func updateSourceFile(path string, result *extraction) (added, unused []string, err error) {
+	file, err := loadLocaleFile(path)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "load")
+	}
+
+	for _, key := range result.sortedKeys() {
+		section, name := splitKey(key)
+		s := file.Section(section)
+		if s.HasKey(name) {
+			continue
+		}
+
+		k, err := s.NewKey(name, "")
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "new key %q", key)
+		}
+
+		refs := make([]string, 0, len(result.keys[key]))
+		for _, pos := range result.keys[key] {
+			refs = append(refs, fmt.Sprintf("%s:%d", pos.Filename, pos.Line))
+		}
+		k.Comment = "# " + strings.Join(refs, ", ")
+		added = append(added, key)
+	}
+
+	dynamic := dynamicKeys(file)
+	for _, s := range file.Sections() {
+		if s.Name() == pluralsSection {
+			continue
+		}
+		for _, k := range s.Keys() {
+			if isMetaKey(k.Name()) {
+				continue
+			}
+			key := joinKey(s.Name(), k.Name())
+			if _, ok := result.keys[key]; !ok && !dynamic[key] {
+				unused = append(unused, key)
+			}
+		}
+	}
+
+	if err = file.SaveTo(path); err != nil {
+		return nil, nil, errors.Wrap(err, "save")
+	}
+	return added, unused, nil
+}
@@ -0,0 +1,138 @@
+// Copyright 2026 Joe Chen. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseKeyFuncs(t *testing.T) {
+	got, err := parseKeyFuncs("(*example.com/web.Context).Tr:0, example.com/web.T:1")
+	require.Nil(t, err)
+	assert.Equal(t, 0, got["(*example.com/web.Context).Tr"])
+	assert.Equal(t, 1, got["example.com/web.T"])
+	assert.Equal(t, 1, got["(*unknwon.dev/i18n.Locale).TranslateWithFallback"])
+
+	t.Run("missing index", func(t *testing.T) {
+		_, err := parseKeyFuncs("example.com/web.T")
+		assert.Equal(t, `missing index of the key argument for "example.com/web.T"`, fmt.Sprintf("%v", err))
+	})
+
+	t.Run("invalid index", func(t *testing.T) {
+		_, err := parseKeyFuncs("example.com/web.T:-1")
+		assert.Equal(t, `invalid index of the key argument for "example.com/web.T:-1"`, fmt.Sprintf("%v", err))
+	})
+}
+
+func positions(e *extraction) map[string][]string {
+	got := make(map[string][]string, len(e.keys))
+	for key, positions := range e.keys {
+		for _, pos := range positions {
+			got[key] = append(got[key], pos.String())
+		}
+	}
+	return got
+}
+
+func TestExtractKeys(t *testing.T) {
+	funcs, err := parseKeyFuncs("(*unknwon.dev/i18n/cmd/i18n/testdata/extract.context).Tr:0")
+	require.Nil(t, err)
+
+	result, err := extractKeys(".", []string{"./testdata/extract"}, false, funcs)
+	require.Nil(t, err)
+
+	want := map[string][]string{
+		"messages::hello":    {"testdata/extract/context.go:10:21", "testdata/extract/main.go:25:26", "testdata/extract/main.go:31:19"},
+		"messages::goodbye":  {"testdata/extract/main.go:26:26"},
+		"messages::fallback": {"testdata/extract/main.go:27:48"},
+		"title":              {"testdata/extract/main.go:28:31"},
+		"messages::wrapped":  {"testdata/extract/main.go:32:19"},
+	}
+	assert.Equal(t, want, positions(result))
+
+	require.Len(t, result.dynamic, 1)
+	assert.Equal(t, "testdata/extract/main.go:35:26", result.dynamic[0].pos.String())
+	assert.Equal(t, "(*unknwon.dev/i18n.Locale).Translate", result.dynamic[0].fn)
+
+	t.Run("without wrapper functions", func(t *testing.T) {
+		result, err := extractKeys(".", []string{"./testdata/extract"}, false, defaultKeyFuncs)
+		require.Nil(t, err)
+		assert.NotContains(t, result.keys, "messages::wrapped")
+		// The call in the wrapper function is now dynamic.
+		assert.Len(t, result.dynamic, 2)
+	})
+}
+
+func TestUpdateSourceFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "locale_en-US.ini")
+	err := ioutil.WriteFile(path, []byte(`[plurals]
+file.one = file
+file.other = files
+
+[messages]
+; Greeting on the home page
+hello = Hello, %s!
+unused = I am not used
+# @dynamic
+status_open = Open
+
+; @dynamic
+[status]
+closed = Closed
+`), 0644)
+	require.Nil(t, err)
+
+	funcs, err := parseKeyFuncs("(*unknwon.dev/i18n/cmd/i18n/testdata/extract.context).Tr:0")
+	require.Nil(t, err)
+	result, err := extractKeys(".", []string{"./testdata/extract"}, false, funcs)
+	require.Nil(t, err)
+
+	added, unused, err := updateSourceFile(path, result)
+	require.Nil(t, err)
+	assert.Equal(t, []string{"messages::fallback", "messages::goodbye", "messages::wrapped", "title"}, added)
+	assert.Equal(t, []string{"messages::unused"}, unused)
+
+	got, err := ioutil.ReadFile(path)
+	require.Nil(t, err)
+	want := "# testdata/extract/main.go:28\n" +
+		"title = \n" +
+		"\n" +
+		"[plurals]\n" +
+		"file.one = file\n" +
+		"file.other = files\n" +
+		"\n" +
+		"[messages]\n" +
+		"; Greeting on the home page\n" +
+		"hello = Hello, %s!\n" +
+		"unused = I am not used\n" +
+		"# @dynamic\n" +
+		"status_open = Open\n" +
+		"# testdata/extract/main.go:27\n" +
+		"fallback = \n" +
+		"# testdata/extract/main.go:26\n" +
+		"goodbye = \n" +
+		"# testdata/extract/main.go:32\n" +
+		"wrapped = \n" +
+		"\n" +
+		"; @dynamic\n" +
+		"[status]\n" +
+		"closed = Closed\n" +
+		"\n"
+	assert.Equal(t, want, string(got))
+
+	t.Run("new file", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "locale_en-US.ini")
+		added, unused, err := updateSourceFile(path, result)
+		require.Nil(t, err)
+		assert.Len(t, added, 5)
+		assert.Empty(t, unused)
+	})
+}
@@ -0,0 +1,426 @@
+// Copyright 2026 Joe Chen. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"go/format"
+	"go/token"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+	"unicode"
+
+	"github.com/pkg/errors"
+)
+
+var generateCommand = &command{
+	name: "generate",
+	desc: "generate typed accessors for messages of a locale",
+	run:  runGenerate,
+}
+
+var generateUsage = `%[1]s generate generates a Go package of functions to translate messages of the
+source locale file, one for each key with arguments typed by fmt verbs, plural
+and format placeholders of the message, e.g.
+
+	func MessagesHello(l *i18n.Locale, arg1 string) string
+
+It is designed to be used with "go generate", e.g.
+
+	//go:generate go run unknwon.dev/i18n/cmd/i18n generate -source ../conf/locale/locale_en-US.ini -o msgs.go
+
+Usage: %[1]s generate [options]
+
+Options:
+
+`
+
+func runGenerate(args []string) error {
+	fs := newFlagSet("generate", generateUsage)
+	source := fs.String("source", "", "the source locale file that defines reference keys")
+	output := fs.String("o", "", "the output file, default is the standard output")
+	pkg := fs.String("pkg", "", `the package name, default is the name of the output directory or "msgs"`)
+	_ = fs.Parse(args)
+
+	if *source == "" {
+		return errors.New("the source locale file is required")
+	}
+	if *pkg == "" {
+		*pkg = "msgs"
+		if *output != "" {
+			abs, err := filepath.Abs(*output)
+			if err == nil && token.IsIdentifier(filepath.Base(filepath.Dir(abs))) {
+				*pkg = filepath.Base(filepath.Dir(abs))
+			}
+		}
+	}
+
+	doc, err := readDocument(*source, formatOf(*source))
+	if err != nil {
+		return errors.Wrap(err, "read source")
+	}
+
+	code, err := generateAccessors(doc, *pkg, filepath.Base(*source))
+	if err != nil {
+		return err
+	}
+
+	if *output == "" {
+		_, err = os.Stdout.Write(code)
+		return err
+	}
+	return ioutil.WriteFile(*output, code, 0644)
+}
+
+// accessor is a generated function to translate a message.
+type accessor struct {
+	Name    string
+	Key     string
+	Message string
+	Params  []*param
+	Named   bool // Whether to translate with named arguments
+}
+
+// param is a parameter of an accessor.
+type param struct {
+	Name string
+	Arg  string // The name of the argument for named placeholders
+	Type string
+}
+
+// Types of parameters inferred by verbs and placeholders.
+const (
+	typeInterface = "interface{}"
+	typeString    = "string"
+	typeInt       = "int"
+	typeRune      = "rune"
+	typeFloat     = "float64"
+	typeBool      = "bool"
+	typeTime      = "time.Time"
+	typeDuration  = "time.Duration"
+	typeStrings   = "[]string"
+)
+
+// verbTypes is the type of the argument for each fmt verb, verbs that are not
+// listed (e.g. %v and %x) accept any type.
+var verbTypes = map[rune]string{
+	's': typeString,
+	'q': typeString,
+	'd': typeInt,
+	'b': typeInt,
+	'o': typeInt,
+	'O': typeInt,
+	'c': typeRune,
+	'U': typeRune,
+	'e': typeFloat,
+	'E': typeFloat,
+	'f': typeFloat,
+	'F': typeFloat,
+	'g': typeFloat,
+	'G': typeFloat,
+	't': typeBool,
+}
+
+// formatTypes is the type of the argument for each kind of format
+// placeholders.
+var formatTypes = map[string]string{
+	"date":     typeTime,
+	"time":     typeTime,
+	"datetime": typeTime,
+	"relative": typeDuration,
+	"list":     typeStrings,
+	"unit":     typeFloat,
+	"compact":  typeFloat,
+}
+
+var (
+	namedPlaceholderRe  = regexp.MustCompile(`{{|}}|{([a-zA-Z_]\w*)}`)                    // e.g. {name} => ["name"], and escaped braces "{{" and "}}" => [""]
+	formatPlaceholderRe = regexp.MustCompile(`\${([a-z]+):[^,}]+,\s*(\d+|[a-zA-Z_]\w*)}`) // e.g. ${date:medium, 1} => ["date", "1"]
+	placeholderRe       = regexp.MustCompile(`\${[^}]*}`)
+)
+
+// argTypes collects types of arguments, and the type becomes "interface{}" if
+// an argument is used with conflicting types.
+type argTypes map[string]string
+
+func (a argTypes) add(arg, typ string) {
+	if t, ok := a[arg]; ok && t != typ {
+		typ = typeInterface
+	}
+	a[arg] = typ
+}
+
+// inferVerbTypes adds types of arguments inferred by fmt verbs in the format,
+// arguments are indexes starting from 1.
+func inferVerbTypes(format string, types argTypes) {
+	argNum := 1
+	// argIndex parses the explicit argument index (e.g. "[2]") at i.
+	argIndex := func(i int) int {
+		if i >= len(format) || format[i] != '[' {
+			return i
+		}
+		end := strings.IndexByte(format[i:], ']')
+		if end < 0 {
+			return i
+		}
+		if n, err := strconv.Atoi(format[i+1 : i+end]); err == nil && n > 0 {
+			argNum = n
+		}
+		return i + end + 1
+	}
+	// number parses the width or precision at i.
+	number := func(i int) int {
+		i = argIndex(i)
+		if i < len(format) && format[i] == '*' {
+			types.add(strconv.Itoa(argNum), typeInt)
+			argNum++
+			return i + 1
+		}
+		for i < len(format) && '0' <= format[i] && format[i] <= '9' {
+			i++
+		}
+		return i
+	}
+
+	for i := 0; i < len(format); i++ {
+		if format[i] != '%' {
+			continue
+		}
+
+		i++
+		for i < len(format) && strings.IndexByte("+-# 0", format[i]) >= 0 {
+			i++
+		}
+		i = number(i)
+		if i < len(format) && format[i] == '.' {
+			i = number(i + 1)
+		}
+		i = argIndex(i)
+		if i >= len(format) || format[i] == '%' {
+			continue
+		}
+
+		verb := []rune(format[i:])[0]
+		typ, ok := verbTypes[verb]
+		if !ok {
+			typ = typeInterface
+		}
+		types.add(strconv.Itoa(argNum), typ)
+		argNum++
+	}
+}
+
+// newAccessor returns the accessor of the message with types of parameters
+// inferred by its verbs and placeholders.
+func newAccessor(name, key, message string) *accessor {
+	a := &accessor{
+		Name:    name,
+		Key:     key,
+		Message: message,
+	}
+
+	var args []string // Arguments in the order of first use
+	first := make(map[string]int)
+	nouns := make(map[string]string)
+	types := make(argTypes)
+	addArg := func(arg, typ string, pos int) {
+		if _, ok := types[arg]; !ok {
+			args = append(args, arg)
+			first[arg] = pos
+		} else if pos < first[arg] {
+			first[arg] = pos
+		}
+		types.add(arg, typ)
+	}
+	for _, m := range pluralPlaceholderRe.FindAllStringSubmatchIndex(message, -1) {
+		noun, arg := message[m[2]:m[3]], message[m[4]:m[5]]
+		addArg(arg, typeInt, m[0])
+		if _, ok := nouns[arg]; !ok {
+			nouns[arg] = noun
+		}
+	}
+	for _, m := range formatPlaceholderRe.FindAllStringSubmatchIndex(message, -1) {
+		typ, ok := formatTypes[message[m[2]:m[3]]]
+		if !ok {
+			typ = typeInterface
+		}
+		addArg(message[m[4]:m[5]], typ, m[0])
+	}
+	for _, m := range namedPlaceholderRe.FindAllStringSubmatchIndex(message, -1) {
+		if m[2] < 0 || (m[0] > 0 && message[m[0]-1] == '$') {
+			continue
+		}
+		addArg(message[m[2]:m[3]], typeInterface, m[0])
+	}
+	sort.SliceStable(args, func(i, j int) bool { return first[args[i]] < first[args[j]] })
+
+	for _, arg := range args {
+		if _, err := strconv.Atoi(arg); err != nil {
+			a.Named = true
+		}
+	}
+	if a.Named {
+		// Only named arguments are used, and fmt verbs are not processed. Names
+		// that only differ in case (e.g. "Count" and "count") have the same
+		// parameter name, which is made unique with a number suffix.
+		seen := make(map[string]bool)
+		for _, arg := range args {
+			if _, err := strconv.Atoi(arg); err != nil {
+				name := uniqueName(paramName(arg), seen)
+				a.Params = append(a.Params, &param{Name: name, Arg: arg, Type: types[arg]})
+			}
+		}
+		return a
+	}
+
+	inferVerbTypes(placeholderRe.ReplaceAllString(message, ""), types)
+	count := 0
+	for arg := range types {
+		if n, _ := strconv.Atoi(arg); n > count {
+			count = n
+		}
+	}
+	seen := map[string]bool{"l": true}
+	for i := 1; i <= count; i++ {
+		arg := strconv.Itoa(i)
+		typ, ok := types[arg]
+		if !ok {
+			typ = typeInterface
+		}
+
+		name := "arg" + arg
+		if noun, ok := nouns[arg]; ok && !seen[paramName(noun)] {
+			name = paramName(noun)
+		}
+		seen[name] = true
+		a.Params = append(a.Params, &param{Name: name, Type: typ})
+	}
+	return a
+}
+
+// paramName returns a valid parameter name for the argument or noun.
+func paramName(name string) string {
+	r := []rune(name)
+	r[0] = unicode.ToLower(r[0])
+	name = string(r)
+	if token.IsKeyword(name) || name == "l" || name == "i18n" || name == "time" {
+		name += "_"
+	}
+	return name
+}
+
+// uniqueName returns the name with the smallest number suffix starting from 2
+// that is not seen if the name has been seen, and marks it as seen.
+func uniqueName(name string, seen map[string]bool) string {
+	unique := name
+	for i := 2; seen[unique]; i++ {
+		unique = name + strconv.Itoa(i)
+	}
+	seen[unique] = true
+	return unique
+}
+
+// identifier returns the exported identifier of the key, e.g. "MessagesHello"
+// for "messages::hello".
+func identifier(key string) string {
+	var buf strings.Builder
+	upper := true
+	for _, r := range key {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			upper = true
+			continue
+		}
+		if buf.Len() == 0 && unicode.IsDigit(r) {
+			buf.WriteString("M")
+		}
+		if upper {
+			r = unicode.ToUpper(r)
+			upper = false
+		}
+		buf.WriteRune(r)
+	}
+	return buf.String()
+}
+
+// generateAccessors returns the formatted Go code of accessors for messages of
+// the document.
+func generateAccessors(doc *document, pkg, source string) ([]byte, error) {
+	var accessors []*accessor
+	names := make(map[string]string) // Identifier => Key
+	for _, s := range doc.sections {
+		if s.name == pluralsSection {
+			continue
+		}
+		for _, e := range s.entries {
+			if isMetaKey(e.name) {
+				continue
+			}
+			key := joinKey(s.name, e.name)
+			name := identifier(key)
+			if name == "" {
+				return nil, errors.Errorf("no valid identifier for key %q", key)
+			} else if other, ok := names[name]; ok {
+				return nil, errors.Errorf("keys %q and %q have the same identifier %q", other, key, name)
+			}
+			names[name] = key
+			accessors = append(accessors, newAccessor(name, key, e.value))
+		}
+	}
+	sort.Slice(accessors, func(i, j int) bool { return accessors[i].Key < accessors[j].Key })
+
+	imports := []string{"unknwon.dev/i18n"}
+	for _, a := range accessors {
+		for _, p := range a.Params {
+			if strings.HasPrefix(p.Type, "time.") {
+				imports = []string{"time", "", "unknwon.dev/i18n"}
+			}
+		}
+	}
+
+	var buf bytes.Buffer
+	err := accessorsTemplate.Execute(&buf, map[string]interface{}{
+		"Source":    source,
+		"Package":   pkg,
+		"Imports":   imports,
+		"Accessors": accessors,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "execute template")
+	}
+
+	code, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, errors.Wrap(err, "format source")
+	}
+	return code, nil
+}
+
+var accessorsTemplate = template.Must(template.New("accessors").Funcs(template.FuncMap{
+	"quote": strconv.Quote,
+}).Parse(`// Code generated by "i18n generate" from {{.Source}}; DO NOT EDIT.
+
+package {{.Package}}
+
+import (
+{{range .Imports}}{{if .}}{{quote .}}{{end}}
+{{end}})
+{{range .Accessors}}
+// {{.Name}} translates {{quote .Key}}, e.g. {{quote .Message}}.
+func {{.Name}}(l *i18n.Locale{{range .Params}}, {{.Name}} {{.Type}}{{end}}) string {
+{{- if .Named}}
+	return l.TranslateNamed({{quote .Key}}, map[string]interface{}{ {{- range .Params}}
+		{{quote .Arg}}: {{.Name}},{{end}}
+	})
+{{- else}}
+	return l.Translate({{quote .Key}}{{range .Params}}, {{.Name}}{{end}})
+{{- end}}
+}
+{{end}}`))
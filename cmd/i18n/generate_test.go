@@ -0,0 +1,144 @@
+// Copyright 2026 Joe Chen. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIdentifier(t *testing.T) {
+	tests := []struct {
+		key  string
+		want string
+	}{
+		{key: "title", want: "Title"},
+		{key: "messages::hello", want: "MessagesHello"},
+		{key: "messages::hello_world", want: "MessagesHelloWorld"},
+		{key: "errors::not-found.page", want: "ErrorsNotFoundPage"},
+		{key: "404", want: "M404"},
+		{key: "::", want: ""},
+	}
+	for _, test := range tests {
+		t.Run(test.key, func(t *testing.T) {
+			assert.Equal(t, test.want, identifier(test.key))
+		})
+	}
+}
+
+func TestInferVerbTypes(t *testing.T) {
+	tests := []struct {
+		format string
+		want   argTypes
+	}{
+		{format: "No verbs 100%%", want: argTypes{}},
+		{format: "%s has %d files", want: argTypes{"1": "string", "2": "int"}},
+		{format: "%[2]s %[1]q %[3]t", want: argTypes{"1": "string", "2": "string", "3": "bool"}},
+		{format: "%-8.2f %x %c", want: argTypes{"1": "float64", "2": "interface{}", "3": "rune"}},
+		{format: "%*d %.*e", want: argTypes{"1": "int", "2": "int", "3": "int", "4": "float64"}},
+		{format: "%[1]d %[1]s", want: argTypes{"1": "interface{}"}},
+	}
+	for _, test := range tests {
+		t.Run(test.format, func(t *testing.T) {
+			got := make(argTypes)
+			inferVerbTypes(test.format, got)
+			assert.Equal(t, test.want, got)
+		})
+	}
+}
+
+func TestNewAccessor(t *testing.T) {
+	tests := []struct {
+		name    string
+		message string
+		want    []*param
+		named   bool
+	}{
+		{
+			name:    "no arguments",
+			message: "Welcome",
+		},
+		{
+			name:    "plural",
+			message: "%[1]d ${file, 1} changed, %[2]d deleted",
+			want: []*param{
+				{Name: "file", Type: "int"},
+				{Name: "arg2", Type: "int"},
+			},
+		},
+		{
+			name:    "unused index",
+			message: "${date:short, 2}",
+			want: []*param{
+				{Name: "arg1", Type: "interface{}"},
+				{Name: "arg2", Type: "time.Time"},
+			},
+		},
+		{
+			name:    "named",
+			message: "{name} shared ${file, count} ${list:and, files}",
+			want: []*param{
+				{Name: "name", Arg: "name", Type: "interface{}"},
+				{Name: "count", Arg: "count", Type: "int"},
+				{Name: "files", Arg: "files", Type: "[]string"},
+			},
+			named: true,
+		},
+		{
+			name:    "escaped braces",
+			message: "Use {{name}} for %s",
+			want: []*param{
+				{Name: "arg1", Type: "string"},
+			},
+		},
+		{
+			name:    "names differ in case",
+			message: "{Count} of ${file, count}",
+			want: []*param{
+				{Name: "count", Arg: "Count", Type: "interface{}"},
+				{Name: "count2", Arg: "count", Type: "int"},
+			},
+			named: true,
+		},
+		{
+			name:    "keyword",
+			message: "{type} ${package, 1}",
+			want: []*param{
+				{Name: "type_", Arg: "type", Type: "interface{}"},
+			},
+			named: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := newAccessor("Test", "test", test.message)
+			assert.Equal(t, test.want, got.Params)
+			assert.Equal(t, test.named, got.Named)
+		})
+	}
+}
+
+func TestGenerateAccessors(t *testing.T) {
+	doc, err := readDocument("testdata/generate/locale_en-US.ini", "ini")
+	require.Nil(t, err)
+
+	got, err := generateAccessors(doc, "msgs", "locale_en-US.ini")
+	require.Nil(t, err)
+
+	want, err := ioutil.ReadFile("testdata/generate/msgs.go")
+	require.Nil(t, err)
+	assert.Equal(t, string(want), string(got))
+
+	t.Run("same identifier", func(t *testing.T) {
+		doc := &document{}
+		doc.section("messages").entries = []*entry{{name: "hello_world"}, {name: "hello-world"}}
+		_, err := generateAccessors(doc, "msgs", "locale_en-US.ini")
+		assert.Equal(t, `keys "messages::hello_world" and "messages::hello-world" have the same identifier "MessagesHelloWorld"`, fmt.Sprintf("%v", err))
+	})
+}
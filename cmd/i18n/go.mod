@@ -0,0 +1,20 @@
+module unknwon.dev/i18n/cmd/i18n
+
+go 1.22.0
+
+require (
+	github.com/pkg/errors v0.9.1
+	github.com/stretchr/testify v1.7.0
+	golang.org/x/text v0.3.7
+	golang.org/x/tools v0.28.0
+	gopkg.in/ini.v1 v1.64.0
+	unknwon.dev/i18n v0.0.0-20261019072923-3b353d24a3bf
+)
+
+require (
+	github.com/davecgh/go-spew v1.1.0 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	golang.org/x/mod v0.22.0 // indirect
+	golang.org/x/sync v0.10.0 // indirect
+	gopkg.in/yaml.v3 v3.0.0-20200313102051-9f266ea9e77c // indirect
+)
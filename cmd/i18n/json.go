@@ -0,0 +1,236 @@
+// Copyright 2026 Joe Chen. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"strings"
+
+	"github.com/pkg/errors"
+	"gopkg.in/ini.v1"
+)
+
+// The JSON format is similar to ARB (Application Resource Bundle) that keys of
+// the default section are at the top level, and other sections are nested
+// objects, e.g.
+//
+//	{
+//	  "@@locale": "en-US",
+//	  "title": "Welcome",
+//	  "@title": {"description": "The title of the home page"},
+//	  "plurals": {
+//	    "file.one": "file",
+//	    "file.other": "files"
+//	  },
+//	  "messages": {
+//	    "hello": "Hello, %s!"
+//	  },
+//	  "@messages": {"description": "Messages of the home page"}
+//	}
+//
+// The comment of the default section is stored as "@@description".
+
+// jsonMeta is the metadata of an entry or a section.
+type jsonMeta struct {
+	Description string `json:"description"`
+}
+
+// readJSON reads the document from data of JSON.
+func readJSON(data []byte) (*document, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	if err := expectDelim(dec, '{'); err != nil {
+		return nil, err
+	}
+
+	doc := new(document)
+	comments := make(map[string]string) // Comments of sections and entries of the default section
+	err := readJSONObject(dec, func(name string) error {
+		switch {
+		case name == "@@locale":
+			return dec.Decode(&doc.lang)
+		case name == "@@description":
+			return dec.Decode(&doc.section(ini.DefaultSection).comment)
+		case strings.HasPrefix(name, "@"):
+			var meta jsonMeta
+			if err := dec.Decode(&meta); err != nil {
+				return errors.Wrapf(err, "decode %q", name)
+			}
+			comments[name[1:]] = meta.Description
+			return nil
+		}
+
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		switch v := tok.(type) {
+		case string:
+			s := doc.section(ini.DefaultSection)
+			s.entries = append(s.entries, &entry{name: name, value: v})
+			return nil
+		case json.Delim:
+			if v == '{' {
+				return readJSONSection(dec, doc.section(name))
+			}
+		}
+		return errors.Errorf("unexpected value of %q: %v", name, tok)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, s := range doc.sections {
+		if s.name == ini.DefaultSection {
+			for _, e := range s.entries {
+				e.comment = comments[e.name]
+			}
+			continue
+		}
+		s.comment = comments[s.name]
+	}
+	return doc, nil
+}
+
+// readJSONSection reads entries of the section from the object.
+func readJSONSection(dec *json.Decoder, s *section) error {
+	comments := make(map[string]string)
+	err := readJSONObject(dec, func(name string) error {
+		if strings.HasPrefix(name, "@") {
+			var meta jsonMeta
+			if err := dec.Decode(&meta); err != nil {
+				return errors.Wrapf(err, "decode %q", name)
+			}
+			comments[name[1:]] = meta.Description
+			return nil
+		}
+
+		var value string
+		if err := dec.Decode(&value); err != nil {
+			return errors.Wrapf(err, "decode %q", joinKey(s.name, name))
+		}
+		s.entries = append(s.entries, &entry{name: name, value: value})
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, e := range s.entries {
+		e.comment = comments[e.name]
+	}
+	return nil
+}
+
+// readJSONObject reads members of the object whose opening delimiter has been
+// read, and the member function must read the value of the member.
+func readJSONObject(dec *json.Decoder, member func(name string) error) error {
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		name, ok := tok.(string)
+		if !ok {
+			return errors.Errorf("unexpected token %v", tok)
+		}
+
+		if err = member(name); err != nil {
+			return err
+		}
+	}
+	return expectDelim(dec, '}')
+}
+
+func expectDelim(dec *json.Decoder, delim json.Delim) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if tok != delim {
+		return errors.Errorf("expect %q but got %v", delim, tok)
+	}
+	return nil
+}
+
+// jsonMember is a member of an object with the encoded value.
+type jsonMember struct {
+	name  string
+	value string
+}
+
+// encodeJSONObject encodes members as an object with the indentation.
+func encodeJSONObject(members []jsonMember, indent string) string {
+	if len(members) == 0 {
+		return "{}"
+	}
+
+	var buf strings.Builder
+	buf.WriteString("{\n")
+	for i, m := range members {
+		buf.WriteString(indent + "  " + quoteJSON(m.name) + ": " + m.value)
+		if i < len(members)-1 {
+			buf.WriteString(",")
+		}
+		buf.WriteString("\n")
+	}
+	buf.WriteString(indent + "}")
+	return buf.String()
+}
+
+// quoteJSON returns the JSON string of s without escaping HTML characters.
+func quoteJSON(s string) string {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	_ = enc.Encode(s)
+	return strings.TrimSuffix(buf.String(), "\n")
+}
+
+func jsonMetaMember(name, comment string) jsonMember {
+	return jsonMember{
+		name:  "@" + name,
+		value: `{"description": ` + quoteJSON(comment) + `}`,
+	}
+}
+
+// writeJSON writes the document as JSON.
+func writeJSON(w io.Writer, doc *document) error {
+	var members []jsonMember
+	if doc.lang != "" {
+		members = append(members, jsonMember{name: "@@locale", value: quoteJSON(doc.lang)})
+	}
+
+	for _, s := range doc.sections {
+		if s.name == ini.DefaultSection {
+			if s.comment != "" {
+				members = append(members, jsonMember{name: "@@description", value: quoteJSON(s.comment)})
+			}
+			for _, e := range s.entries {
+				members = append(members, jsonMember{name: e.name, value: quoteJSON(e.value)})
+				if e.comment != "" {
+					members = append(members, jsonMetaMember(e.name, e.comment))
+				}
+			}
+			continue
+		}
+
+		entries := make([]jsonMember, 0, len(s.entries))
+		for _, e := range s.entries {
+			entries = append(entries, jsonMember{name: e.name, value: quoteJSON(e.value)})
+			if e.comment != "" {
+				entries = append(entries, jsonMetaMember(e.name, e.comment))
+			}
+		}
+		members = append(members, jsonMember{name: s.name, value: encodeJSONObject(entries, "  ")})
+		if s.comment != "" {
+			members = append(members, jsonMetaMember(s.name, s.comment))
+		}
+	}
+
+	_, err := io.WriteString(w, encodeJSONObject(members, "")+"\n")
+	return err
+}
@@ -0,0 +1,194 @@
+// Copyright 2026 Joe Chen. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"gopkg.in/ini.v1"
+)
+
+func init() {
+	// Keep the original layout of locale files as much as possible instead of
+	// aligning all keys.
+	ini.PrettyFormat = false
+	ini.PrettyEqual = true
+}
+
+// splitKey splits the key of a message into the section and key name in the
+// locale file.
+func splitKey(key string) (section, name string) {
+	i := strings.Index(key, "::")
+	if i < 0 {
+		return ini.DefaultSection, key
+	}
+	return key[:i], key[i+2:]
+}
+
+// joinKey joins the section and key name in the locale file into the key of a
+// message.
+func joinKey(section, name string) string {
+	if section == ini.DefaultSection {
+		return name
+	}
+	return section + "::" + name
+}
+
+// pluralsSection is the reserved section to define all plurals.
+const pluralsSection = "plurals"
+
+// loadLocaleFile loads the locale file with the same options as the Store.
+// It returns an empty file if the file does not exist.
+func loadLocaleFile(path string) (*ini.File, error) {
+	return ini.LoadSources(
+		ini.LoadOptions{
+			Loose:                       true,
+			IgnoreInlineComment:         true,
+			UnescapeValueCommentSymbols: true,
+		},
+		path,
+	)
+}
+
+// sameFile returns true if both paths refer to the same file, e.g.
+// "./locale_en-US.ini" and "locale_en-US.ini". Paths are compared as-is when
+// any of the files does not exist.
+func sameFile(a, b string) bool {
+	fa, errA := os.Stat(a)
+	fb, errB := os.Stat(b)
+	if errA != nil || errB != nil {
+		return filepath.Clean(a) == filepath.Clean(b)
+	}
+	return os.SameFile(fa, fb)
+}
+
+// globFiles returns the sorted list of files that match the comma-separated
+// list of glob patterns.
+func globFiles(patterns string) ([]string, error) {
+	seen := make(map[string]bool)
+	var files []string
+	for _, pattern := range strings.Split(patterns, ",") {
+		pattern = strings.TrimSpace(pattern)
+		if pattern == "" {
+			continue
+		}
+
+		matches, err := filepath.Glob(pattern)
+		if err != nil {
+			return nil, errors.Wrapf(err, "glob %q", pattern)
+		}
+		for _, match := range matches {
+			if !seen[match] {
+				seen[match] = true
+				files = append(files, match)
+			}
+		}
+	}
+	sort.Strings(files)
+	return files, nil
+}
+
+var pluralPlaceholderRe = regexp.MustCompile(`\${([a-zA-Z]+),\s*(\d+|[a-zA-Z_]\w*)}`) // e.g. ${file, 1} => ["file", "1"]
+
+// pluralNouns returns the plural nouns defined in the "[plurals]" section, and
+// the nouns used by messages of the locale file.
+func pluralNouns(file *ini.File) (defined, used map[string]bool) {
+	defined = make(map[string]bool)
+	used = make(map[string]bool)
+	for _, s := range file.Sections() {
+		for _, k := range s.Keys() {
+			if s.Name() == pluralsSection {
+				if i := strings.Index(k.Name(), "."); i > 0 {
+					defined[k.Name()[:i]] = true
+				}
+				continue
+			}
+
+			for _, submatch := range pluralPlaceholderRe.FindAllStringSubmatch(k.Value(), -1) {
+				used[submatch[1]] = true
+			}
+		}
+	}
+	return defined, used
+}
+
+// langOf returns the language name of the locale file by its name, e.g.
+// "zh-CN" for "locale_zh-CN.ini" or "zh-CN.ini".
+func langOf(path string) string {
+	name := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	if i := strings.LastIndex(name, "_"); i >= 0 {
+		name = name[i+1:]
+	}
+	return name
+}
+
+// metaKeySuffix is the suffix of keys that define metadata of messages, e.g.
+// "hello@meta" for "hello".
+const metaKeySuffix = "@meta"
+
+// isMetaKey returns true if the key name defines metadata of a message.
+func isMetaKey(name string) bool {
+	return strings.HasSuffix(name, metaKeySuffix)
+}
+
+// localeMessages returns messages of the locale file by their keys, keys of
+// metadata are excluded.
+func localeMessages(file *ini.File) map[string]string {
+	messages := make(map[string]string)
+	for _, s := range file.Sections() {
+		if s.Name() == pluralsSection {
+			continue
+		}
+		for _, k := range s.Keys() {
+			if isMetaKey(k.Name()) {
+				continue
+			}
+			messages[joinKey(s.Name(), k.Name())] = k.Value()
+		}
+	}
+	return messages
+}
+
+// dynamicAnnotation is the comment line that marks keys as used with dynamic
+// keys (e.g. "status::" + name), which are never reported as unused. It applies
+// to all keys of a section when it is in the comment of the section, e.g.
+//
+//	; @dynamic
+//	closed = Closed
+const dynamicAnnotation = "@dynamic"
+
+// hasDynamicAnnotation returns true if the comment has the dynamic annotation.
+func hasDynamicAnnotation(comment string) bool {
+	for _, line := range strings.Split(comment, "\n") {
+		line = strings.TrimSpace(strings.TrimLeft(strings.TrimSpace(line), "#;"))
+		if line == dynamicAnnotation {
+			return true
+		}
+	}
+	return false
+}
+
+// dynamicKeys returns keys of messages of the locale file that are annotated as
+// used with dynamic keys.
+func dynamicKeys(file *ini.File) map[string]bool {
+	keys := make(map[string]bool)
+	for _, s := range file.Sections() {
+		if s.Name() == pluralsSection {
+			continue
+		}
+		all := hasDynamicAnnotation(s.Comment)
+		for _, k := range s.Keys() {
+			if all || hasDynamicAnnotation(k.Comment) {
+				keys[joinKey(s.Name(), k.Name())] = true
+			}
+		}
+	}
+	return keys
+}
@@ -0,0 +1,100 @@
+// Copyright 2026 Joe Chen. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+// Command i18n is the toolkit for managing translations of unknwon.dev/i18n.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+)
+
+var usage = `%[1]s is the toolkit for managing translations.
+
+Usage: %[1]s <command> [options]
+
+Commands:
+
+%[2]s
+Use "%[1]s <command> -h" for more information about a command.
+`
+
+// command is a subcommand of the toolkit.
+type command struct {
+	name string
+	desc string
+	run  func(args []string) error
+}
+
+// commands is the list of supported subcommands.
+var commands = []*command{
+	extractCommand,
+	checkCommand,
+	statsCommand,
+	convertCommand,
+	syncCommand,
+	generateCommand,
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		printUsage()
+		os.Exit(2)
+	}
+
+	name := os.Args[1]
+	for _, cmd := range commands {
+		if cmd.name != name {
+			continue
+		}
+
+		if err := cmd.run(os.Args[2:]); err != nil {
+			fatalf("%s: %v", cmd.name, err)
+		}
+		return
+	}
+
+	switch name {
+	case "-h", "-help", "--help", "help":
+		printUsage()
+		return
+	}
+	_, _ = fmt.Fprintf(os.Stderr, "unknown command %q\n\n", name)
+	printUsage()
+	os.Exit(2)
+}
+
+func printUsage() {
+	var buf strings.Builder
+	for _, cmd := range commands {
+		_, _ = fmt.Fprintf(&buf, "  %-10s %s\n", cmd.name, cmd.desc)
+	}
+	_, _ = fmt.Fprintf(os.Stderr, usage, os.Args[0], buf.String())
+}
+
+// newFlagSet returns a new flag set of the command that prints the usage with
+// the name of the program and defaults of options.
+func newFlagSet(name, usage string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ExitOnError)
+	fs.Usage = func() {
+		_, _ = fmt.Fprintf(os.Stderr, usage, os.Args[0])
+		fs.PrintDefaults()
+	}
+	return fs
+}
+
+func infof(format string, args ...interface{}) {
+	_, _ = fmt.Fprintf(os.Stderr, format+"\n", args...)
+}
+
+func warnf(format string, args ...interface{}) {
+	_, _ = fmt.Fprintf(os.Stderr, "warning: "+format+"\n", args...)
+}
+
+func fatalf(format string, args ...interface{}) {
+	infof("fatal: "+format, args...)
+	os.Exit(1)
+}
@@ -0,0 +1,458 @@
+// Copyright 2026 Joe Chen. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"golang.org/x/text/language"
+	"gopkg.in/ini.v1"
+
+	"unknwon.dev/i18n"
+	"unknwon.dev/i18n/internal/plural"
+)
+
+// In the PO (gettext) format, the section name is the "msgctxt" (omitted for
+// the default section), and the key name is the "msgid", e.g.
+//
+//	# Greeting on the home page
+//	msgctxt "messages"
+//	msgid "hello"
+//	msgstr "Hello, %s!"
+//
+// The comment of a section is kept as extracted comments (i.e. "#.") with the
+// prefix "section: " on the first entry of the section. A section without
+// entries is kept as an entry with an empty "msgid".
+//
+// Metadata of messages (see i18n.MessageMeta) are kept as comments for
+// translators rather than entries, i.e. the description and the context (with
+// the prefix "context: ") are extracted comments, and the maximum length is the
+// "max-length" flag, e.g.
+//
+//	# Shown on the home page
+//	#. Greeting to the signed in user
+//	#. context: home
+//	#, max-length:20
+//	msgctxt "messages"
+//	msgid "hello"
+//	msgstr "Hello, %s!"
+//
+// Plural nouns of the "[plurals]" section are entries with the "msgid_plural",
+// where the "msgid" and "msgid_plural" are the noun, and plural forms are
+// indexed in the order of the CLDR plural rule of the language as described
+// by the "Plural-Forms" header, e.g.
+//
+//	msgctxt "plurals"
+//	msgid "file"
+//	msgid_plural "file"
+//	msgstr[0] "file"
+//	msgstr[1] "files"
+
+const (
+	poSectionCommentPrefix = "section: "
+	poMaxLengthFlag        = "max-length:"
+)
+
+// poPluralForms returns plural forms of the CLDR plural rule of the language in
+// the order of indexes of the "Plural-Forms" header, or nil if no rule is found.
+// Indexes are mapped to the plural forms of the same integers when the header
+// is not empty.
+func poPluralForms(lang, header string) ([]plural.Form, error) {
+	tag, err := language.Parse(lang)
+	if err != nil {
+		return nil, nil
+	}
+	rule := plural.DefaultRules().Rule(tag)
+	if rule == nil {
+		return nil, nil
+	}
+
+	var forms []plural.Form
+	for _, form := range pluralFormOrder {
+		if _, ok := rule.PluralForms[form]; ok {
+			forms = append(forms, form)
+		}
+	}
+	if header == "" || header == rule.GettextPluralForms {
+		return forms, nil
+	}
+
+	nplurals, pluralFunc, err := plural.ParseGettextPluralForms(header)
+	if err != nil {
+		return nil, errors.Wrap(err, "parse Plural-Forms")
+	}
+	mapped := make([]plural.Form, nplurals)
+	seen := make(map[plural.Form]bool, len(forms))
+	for n := int64(0); n < 1000; n++ {
+		i := pluralFunc(n)
+		if mapped[i] != "" {
+			continue
+		}
+		ops, _ := plural.NewOperands(n)
+		if form := rule.PluralFormFunc(ops); !seen[form] {
+			mapped[i] = form
+			seen[form] = true
+		}
+	}
+
+	// Indexes that are not used by integers are mapped to the rest of forms in
+	// order, e.g. the "other" form of fractions.
+	for i := range mapped {
+		if mapped[i] != "" {
+			continue
+		}
+		for _, form := range forms {
+			if !seen[form] {
+				mapped[i] = form
+				seen[form] = true
+				break
+			}
+		}
+	}
+	return mapped, nil
+}
+
+// poEntry is an entry being read.
+type poEntry struct {
+	comments    []string
+	extracted   []string
+	flags       []string
+	msgctxt     *string
+	msgid       *string
+	msgidPlural *string
+	msgstr      *string
+	msgstrs     map[int]*string // Plural forms by indexes
+	last        *string         // The last field for continued lines
+}
+
+// readPO reads the document from data of PO.
+func readPO(data []byte) (*document, error) {
+	doc := new(document)
+	var pluralForms string // The "Plural-Forms" header
+	var forms []plural.Form
+	e := new(poEntry)
+	flush := func() error {
+		defer func() { e = new(poEntry) }()
+		if e.msgid == nil {
+			return nil
+		}
+
+		// The header entry
+		if *e.msgid == "" && e.msgctxt == nil {
+			for _, line := range strings.Split(derefString(e.msgstr), "\n") {
+				if strings.HasPrefix(line, "Language:") {
+					doc.lang = strings.TrimSpace(strings.TrimPrefix(line, "Language:"))
+				} else if strings.HasPrefix(line, "Plural-Forms:") {
+					pluralForms = strings.TrimSpace(strings.TrimPrefix(line, "Plural-Forms:"))
+				}
+			}
+			return nil
+		}
+
+		name := ini.DefaultSection
+		if e.msgctxt != nil {
+			name = *e.msgctxt
+		}
+		if e.msgidPlural != nil {
+			name = pluralsSection
+		}
+		s := doc.section(name)
+
+		meta := i18n.MessageMeta{Comment: strings.Join(e.comments, "\n")}
+		var descriptions []string
+		for _, line := range e.extracted {
+			switch {
+			case strings.HasPrefix(line, poSectionCommentPrefix):
+				if s.comment != "" {
+					s.comment += "\n"
+				}
+				s.comment += strings.TrimPrefix(line, poSectionCommentPrefix)
+			case strings.HasPrefix(line, contextNotePrefix):
+				meta.Context = strings.TrimPrefix(line, contextNotePrefix)
+			default:
+				descriptions = append(descriptions, line)
+			}
+		}
+		meta.Description = strings.Join(descriptions, " ")
+		for _, flag := range e.flags {
+			if strings.HasPrefix(flag, poMaxLengthFlag) {
+				meta.MaxLength, _ = strconv.Atoi(strings.TrimPrefix(flag, poMaxLengthFlag))
+			}
+		}
+		comment := metaComment(meta)
+
+		// The placeholder entry of a section without entries
+		if *e.msgid == "" {
+			return nil
+		}
+
+		if e.msgidPlural == nil {
+			s.entries = append(s.entries, &entry{
+				name:    *e.msgid,
+				value:   derefString(e.msgstr),
+				comment: comment,
+			})
+			return nil
+		}
+
+		if forms == nil {
+			var err error
+			forms, err = poPluralForms(doc.lang, pluralForms)
+			if err != nil {
+				return err
+			} else if forms == nil {
+				return errors.Errorf("no plural rule found for the language %q", doc.lang)
+			}
+		}
+		for i, form := range forms {
+			text := e.msgstrs[i]
+			if form == "" || text == nil || *text == "" {
+				continue
+			}
+			s.entries = append(s.entries, &entry{
+				name:    *e.msgid + "." + string(form),
+				value:   *text,
+				comment: comment,
+			})
+			comment = "" // Only on the first form
+		}
+		return nil
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	n := 0
+	for scanner.Scan() {
+		n++
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case line == "":
+			if err := flush(); err != nil {
+				return nil, errors.Wrapf(err, "line %d", n)
+			}
+
+		case strings.HasPrefix(line, "#"):
+			if e.msgstr != nil || e.msgstrs != nil {
+				if err := flush(); err != nil {
+					return nil, errors.Wrapf(err, "line %d", n)
+				}
+			}
+			switch {
+			case strings.HasPrefix(line, "#."):
+				e.extracted = append(e.extracted, strings.TrimSpace(line[2:]))
+			case strings.HasPrefix(line, "#,"):
+				for _, flag := range strings.Split(line[2:], ",") {
+					e.flags = append(e.flags, strings.TrimSpace(flag))
+				}
+			case line == "#" || strings.HasPrefix(line, "# "):
+				e.comments = append(e.comments, strings.TrimSpace(line[1:]))
+			}
+			// Other kinds of comments (e.g. references) are ignored.
+
+		case strings.HasPrefix(line, `"`):
+			if e.last == nil {
+				return nil, errors.Errorf("line %d: unexpected string", n)
+			}
+			s, err := strconv.Unquote(line)
+			if err != nil {
+				return nil, errors.Wrapf(err, "line %d: unquote", n)
+			}
+			*e.last += s
+
+		default:
+			fields := strings.SplitN(line, " ", 2)
+			if len(fields) != 2 {
+				return nil, errors.Errorf("line %d: unexpected %q", n, line)
+			}
+			s, err := strconv.Unquote(strings.TrimSpace(fields[1]))
+			if err != nil {
+				return nil, errors.Wrapf(err, "line %d: unquote", n)
+			}
+
+			keyword := fields[0]
+			if keyword == "msgctxt" || keyword == "msgid" {
+				if e.msgstr != nil || e.msgstrs != nil {
+					if err := flush(); err != nil {
+						return nil, errors.Wrapf(err, "line %d", n)
+					}
+				}
+			}
+
+			switch {
+			case keyword == "msgctxt":
+				e.msgctxt = &s
+			case keyword == "msgid":
+				e.msgid = &s
+			case keyword == "msgid_plural":
+				e.msgidPlural = &s
+			case keyword == "msgstr":
+				e.msgstr = &s
+			case strings.HasPrefix(keyword, "msgstr[") && strings.HasSuffix(keyword, "]"):
+				i, err := strconv.Atoi(keyword[len("msgstr[") : len(keyword)-1])
+				if err != nil || i < 0 {
+					return nil, errors.Errorf("line %d: invalid index of %q", n, keyword)
+				}
+				if e.msgstrs == nil {
+					e.msgstrs = make(map[int]*string)
+				}
+				e.msgstrs[i] = &s
+			default:
+				return nil, errors.Errorf("line %d: unsupported keyword %q", n, keyword)
+			}
+			e.last = &s
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if err := flush(); err != nil {
+		return nil, errors.Wrapf(err, "line %d", n)
+	}
+	return doc, nil
+}
+
+func derefString(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+var poEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`, "\t", `\t`, "\r", `\r`)
+
+// quotePO returns the quoted string of PO.
+func quotePO(s string) string {
+	return `"` + poEscaper.Replace(s) + `"`
+}
+
+// writePO writes the document as PO.
+func writePO(w io.Writer, doc *document) error {
+	forms, err := poPluralForms(doc.lang, "")
+	if err != nil {
+		return err
+	}
+
+	bw := bufio.NewWriter(w)
+	_, _ = fmt.Fprintln(bw, `msgid ""`)
+	_, _ = fmt.Fprintln(bw, `msgstr ""`)
+	if doc.lang != "" {
+		_, _ = fmt.Fprintln(bw, quotePO("Language: "+doc.lang+"\n"))
+	}
+	_, _ = fmt.Fprintln(bw, quotePO("MIME-Version: 1.0\n"))
+	_, _ = fmt.Fprintln(bw, quotePO("Content-Type: text/plain; charset=UTF-8\n"))
+	_, _ = fmt.Fprintln(bw, quotePO("Content-Transfer-Encoding: 8bit\n"))
+	if forms != nil {
+		rule := plural.DefaultRules().Rule(language.MustParse(doc.lang))
+		_, _ = fmt.Fprintln(bw, quotePO("Plural-Forms: "+rule.GettextPluralForms+"\n"))
+	}
+
+	for _, s := range doc.sections {
+		messages := s.messages()
+		if len(messages) == 0 {
+			_, _ = fmt.Fprintln(bw)
+			writePOComments(bw, "#. "+poSectionCommentPrefix, s.comment)
+			_, _ = fmt.Fprintln(bw, "msgctxt", quotePO(s.name))
+			_, _ = fmt.Fprintln(bw, `msgid ""`)
+			_, _ = fmt.Fprintln(bw, `msgstr ""`)
+			continue
+		}
+
+		entries := messages
+		var plurals map[string]map[plural.Form]*entry
+		if s.name == pluralsSection && forms != nil {
+			entries, plurals = groupPluralEntries(messages, forms)
+		}
+		for i, e := range entries {
+			_, _ = fmt.Fprintln(bw)
+			if i == 0 {
+				writePOComments(bw, "#. "+poSectionCommentPrefix, s.comment)
+			}
+
+			nounForms := plurals[e.name]
+			if nounForms == nil {
+				meta := s.meta(e)
+				writePOComments(bw, "# ", meta.Comment)
+				writePOComments(bw, "#. ", meta.Description)
+				writePOComments(bw, "#. "+contextNotePrefix, meta.Context)
+				if meta.MaxLength > 0 {
+					_, _ = fmt.Fprintf(bw, "#, %s%d\n", poMaxLengthFlag, meta.MaxLength)
+				}
+				if s.name != ini.DefaultSection {
+					_, _ = fmt.Fprintln(bw, "msgctxt", quotePO(s.name))
+				}
+				_, _ = fmt.Fprintln(bw, "msgid", quotePO(e.name))
+				_, _ = fmt.Fprintln(bw, "msgstr", quotePO(e.value))
+				continue
+			}
+
+			writePOComments(bw, "# ", e.comment)
+			_, _ = fmt.Fprintln(bw, "msgctxt", quotePO(s.name))
+			_, _ = fmt.Fprintln(bw, "msgid", quotePO(e.name))
+			_, _ = fmt.Fprintln(bw, "msgid_plural", quotePO(e.name))
+			for i, form := range forms {
+				var value string
+				if fe := nounForms[form]; fe != nil {
+					value = fe.value
+				}
+				_, _ = fmt.Fprintf(bw, "msgstr[%d] %s\n", i, quotePO(value))
+			}
+		}
+	}
+	return bw.Flush()
+}
+
+// writePOComments writes lines of the comment with the prefix.
+func writePOComments(w io.Writer, prefix, comment string) {
+	if comment == "" {
+		return
+	}
+	for _, line := range strings.Split(comment, "\n") {
+		_, _ = fmt.Fprintln(w, strings.TrimSpace(prefix+line))
+	}
+}
+
+// groupPluralEntries groups entries of plural forms by nouns. It returns the
+// entries where each noun is an entry named by the noun with comments of all
+// its forms, and entries of forms by nouns. Entries that are not any of the
+// forms are returned as-is.
+func groupPluralEntries(entries []*entry, forms []plural.Form) ([]*entry, map[string]map[plural.Form]*entry) {
+	valid := make(map[plural.Form]bool, len(forms))
+	for _, form := range forms {
+		valid[form] = true
+	}
+
+	var grouped []*entry
+	nouns := make(map[string]*entry)
+	plurals := make(map[string]map[plural.Form]*entry)
+	for _, e := range entries {
+		i := strings.Index(e.name, ".")
+		if i <= 0 || !valid[plural.Form(e.name[i+1:])] {
+			grouped = append(grouped, e)
+			continue
+		}
+
+		noun, form := e.name[:i], plural.Form(e.name[i+1:])
+		ne := nouns[noun]
+		if ne == nil {
+			ne = &entry{name: noun}
+			nouns[noun] = ne
+			plurals[noun] = make(map[plural.Form]*entry)
+			grouped = append(grouped, ne)
+		}
+		if e.comment != "" {
+			if ne.comment != "" {
+				ne.comment += "\n"
+			}
+			ne.comment += e.comment
+		}
+		plurals[noun][form] = e
+	}
+	return grouped, plurals
+}
@@ -0,0 +1,221 @@
+// Copyright 2026 Joe Chen. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/pkg/errors"
+	"golang.org/x/text/language"
+
+	"unknwon.dev/i18n"
+	"unknwon.dev/i18n/internal/plural"
+)
+
+var statsCommand = &command{
+	name: "stats",
+	desc: "report translation coverage of locales",
+	run:  runStats,
+}
+
+var statsUsage = `%[1]s stats reports translation coverage of locale files compared with the source
+locale file. The language of each locale file is decided by its name, e.g.
+"locale_zh-CN.ini" and "zh-CN.ini" are both "zh-CN".
+
+Usage: %[1]s stats [options]
+
+Options:
+
+`
+
+func runStats(args []string) error {
+	fs := newFlagSet("stats", statsUsage)
+	source := fs.String("source", "", "the source locale file that defines reference keys")
+	locales := fs.String("locales", "", `comma-separated list of glob patterns of locale files, e.g. "conf/locale/*.ini"`)
+	format := fs.String("format", "table", `the output format, one of "table", "json" and "badge" (Markdown)`)
+	_ = fs.Parse(args)
+
+	if *source == "" {
+		return errors.New("the source locale file is required")
+	}
+	files, err := globFiles(*locales)
+	if err != nil {
+		return errors.Wrap(err, "glob locale files")
+	} else if len(files) == 0 {
+		return errors.Errorf("no locale files found by %q", *locales)
+	}
+
+	stats, err := computeStats(*source, files)
+	if err != nil {
+		return err
+	}
+
+	switch *format {
+	case "table":
+		return writeStatsTable(os.Stdout, stats)
+	case "json":
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(stats)
+	case "badge":
+		return writeStatsBadges(os.Stdout, stats)
+	}
+	return errors.Errorf("unsupported format %q", *format)
+}
+
+// localeStats is the translation coverage of a locale.
+type localeStats struct {
+	Lang       string  `json:"lang"`
+	File       string  `json:"file"`
+	Total      int     `json:"total"`
+	Translated int     `json:"translated"`
+	Percentage float64 `json:"percentage"`
+	// Keys whose messages are identical to the source, which are likely
+	// untranslated.
+	Identical []string `json:"identical"`
+	// Plural nouns with forms required by the CLDR rule of the locale but not
+	// defined.
+	MissingPlurals map[string][]plural.Form `json:"missing_plurals"`
+}
+
+// pluralFormOrder is the order of plural forms as in CLDR.
+var pluralFormOrder = []plural.Form{plural.Zero, plural.One, plural.Two, plural.Few, plural.Many, plural.Other}
+
+// computeStats returns the translation coverage of locale files compared with
+// the source locale file.
+func computeStats(source string, files []string) ([]*localeStats, error) {
+	src, err := loadLocaleFile(source)
+	if err != nil {
+		return nil, errors.Wrapf(err, "load %q", source)
+	}
+	sourceMessages := localeMessages(src)
+	sourceNouns, _ := pluralNouns(src)
+
+	store := i18n.NewStore()
+	rules := plural.DefaultRules()
+	stats := make([]*localeStats, 0, len(files))
+	for _, path := range files {
+		isSource := sameFile(path, source)
+
+		// Loading by the Store reports errors in the same way as applications.
+		l, err := store.AddLocale(langOf(path), langOf(path), path)
+		if err != nil {
+			return nil, errors.Wrapf(err, "add locale %q", path)
+		}
+
+		file, err := loadLocaleFile(path)
+		if err != nil {
+			return nil, errors.Wrapf(err, "load %q", path)
+		}
+
+		st := &localeStats{
+			Lang:           l.Lang(),
+			File:           path,
+			Total:          len(sourceMessages),
+			Identical:      []string{},
+			MissingPlurals: make(map[string][]plural.Form),
+		}
+		messages := localeMessages(file)
+		for key, value := range sourceMessages {
+			translated, ok := messages[key]
+			if !ok || translated == "" {
+				continue
+			}
+			st.Translated++
+
+			if !isSource && translated == value {
+				st.Identical = append(st.Identical, key)
+			}
+		}
+		sort.Strings(st.Identical)
+		if st.Total > 0 {
+			st.Percentage = float64(st.Translated) * 100 / float64(st.Total)
+		}
+
+		rule := rules.Rule(language.Make(l.Lang()))
+		if rule != nil {
+			nouns, _ := pluralNouns(file)
+			for noun := range sourceNouns {
+				nouns[noun] = true
+			}
+
+			plurals := file.Section(pluralsSection)
+			for noun := range nouns {
+				for _, form := range pluralFormOrder {
+					if _, required := rule.PluralForms[form]; required && !plurals.HasKey(noun+"."+string(form)) {
+						st.MissingPlurals[noun] = append(st.MissingPlurals[noun], form)
+					}
+				}
+			}
+		}
+		stats = append(stats, st)
+	}
+	return stats, nil
+}
+
+// writeStatsTable writes the stats as a table.
+func writeStatsTable(w io.Writer, stats []*localeStats) error {
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	_, _ = fmt.Fprintln(tw, "LOCALE\tTRANSLATED\tPERCENTAGE\tIDENTICAL\tMISSING PLURALS")
+	for _, st := range stats {
+		nouns := make([]string, 0, len(st.MissingPlurals))
+		for noun := range st.MissingPlurals {
+			nouns = append(nouns, noun)
+		}
+		sort.Strings(nouns)
+
+		missing := make([]string, 0, len(nouns))
+		for _, noun := range nouns {
+			forms := make([]string, 0, len(st.MissingPlurals[noun]))
+			for _, form := range st.MissingPlurals[noun] {
+				forms = append(forms, string(form))
+			}
+			missing = append(missing, fmt.Sprintf("%s (%s)", noun, strings.Join(forms, ", ")))
+		}
+
+		_, _ = fmt.Fprintf(tw, "%s\t%d/%d\t%.1f%%\t%d\t%s\n",
+			st.Lang, st.Translated, st.Total, st.Percentage, len(st.Identical), strings.Join(missing, "; "))
+	}
+	return tw.Flush()
+}
+
+// badgeColor returns the color of the badge for the percentage.
+func badgeColor(percentage float64) string {
+	switch {
+	case percentage >= 100:
+		return "brightgreen"
+	case percentage >= 90:
+		return "green"
+	case percentage >= 75:
+		return "yellowgreen"
+	case percentage >= 50:
+		return "yellow"
+	case percentage >= 25:
+		return "orange"
+	}
+	return "red"
+}
+
+// badgeEscaper escapes text of static badges of shields.io.
+var badgeEscaper = strings.NewReplacer("-", "--", "_", "__", " ", "%20", "%", "%25")
+
+// writeStatsBadges writes the stats as Markdown badges of shields.io.
+func writeStatsBadges(w io.Writer, stats []*localeStats) error {
+	for _, st := range stats {
+		percentage := fmt.Sprintf("%d%%", int(st.Percentage))
+		_, err := fmt.Fprintf(w, "![%s](https://img.shields.io/badge/%s-%s-%s)\n",
+			st.Lang, badgeEscaper.Replace(st.Lang), badgeEscaper.Replace(percentage), badgeColor(st.Percentage))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+// Copyright 2026 Joe Chen. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"unknwon.dev/i18n/internal/plural"
+)
+
+func TestComputeStats(t *testing.T) {
+	files, err := globFiles("testdata/locales/*.ini")
+	require.Nil(t, err)
+
+	stats, err := computeStats("testdata/locales/locale_en-US.ini", files)
+	require.Nil(t, err)
+
+	want := []*localeStats{
+		{
+			Lang:           "en-US",
+			File:           "testdata/locales/locale_en-US.ini",
+			Total:          5,
+			Translated:     5,
+			Percentage:     100,
+			Identical:      []string{},
+			MissingPlurals: map[string][]plural.Form{},
+		},
+		{
+			Lang:       "zh-CN",
+			File:       "testdata/locales/locale_zh-CN.ini",
+			Total:      5,
+			Translated: 4,
+			Percentage: 80,
+			Identical:  []string{"messages::fallback"},
+			MissingPlurals: map[string][]plural.Form{
+				"dog": {plural.Other},
+			},
+		},
+	}
+	assert.Equal(t, want, stats)
+
+	t.Run("table", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.Nil(t, writeStatsTable(&buf, stats))
+		want := "LOCALE  TRANSLATED  PERCENTAGE  IDENTICAL  MISSING PLURALS\n" +
+			"en-US   5/5         100.0%      0          \n" +
+			"zh-CN   4/5         80.0%       1          dog (other)\n"
+		assert.Equal(t, want, buf.String())
+	})
+
+	t.Run("badge", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.Nil(t, writeStatsBadges(&buf, stats))
+		want := "![en-US](https://img.shields.io/badge/en--US-100%25-brightgreen)\n" +
+			"![zh-CN](https://img.shields.io/badge/zh--CN-80%25-yellowgreen)\n"
+		assert.Equal(t, want, buf.String())
+	})
+
+	t.Run("source with a different path", func(t *testing.T) {
+		source, err := filepath.Abs("testdata/locales/locale_en-US.ini")
+		require.Nil(t, err)
+		stats, err := computeStats(source, files)
+		require.Nil(t, err)
+		assert.Equal(t, []string{}, stats[0].Identical)
+	})
+}
+
+func TestLangOf(t *testing.T) {
+	assert.Equal(t, "zh-CN", langOf("conf/locale/locale_zh-CN.ini"))
+	assert.Equal(t, "en-US", langOf("en-US.ini"))
+}
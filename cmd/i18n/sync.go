@@ -0,0 +1,277 @@
+// Copyright 2026 Joe Chen. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"strings"
+
+	"github.com/pkg/errors"
+	"golang.org/x/text/language"
+
+	"unknwon.dev/i18n/internal/plural"
+)
+
+var syncCommand = &command{
+	name: "sync",
+	desc: "merge keys of the source locale into translated locales",
+	run:  runSync,
+}
+
+var syncUsage = `%[1]s sync updates locale files in place with keys of the source locale file. Missing
+keys are added with empty messages marked as needing translation, and missing
+plural nouns are added with forms required by the language of each locale file.
+Existing translations, comments and ordering are kept. Empty messages are still
+messages at runtime, and are reported by "%[1]s check" as untranslated keys.
+
+Usage: %[1]s sync [options]
+
+Options:
+
+`
+
+func runSync(args []string) error {
+	fs := newFlagSet("sync", syncUsage)
+	source := fs.String("source", "", "the source locale file that defines reference keys")
+	locales := fs.String("locales", "", `comma-separated list of glob patterns of locale files, e.g. "conf/locale/*.ini"`)
+	mark := fs.String("mark", "TODO: translate", `the comment to mark added keys as needing translation, or empty to not mark`)
+	prune := fs.Bool("prune", false, "whether to remove keys and plural nouns that are not in the source locale")
+	_ = fs.Parse(args)
+
+	if *source == "" {
+		return errors.New("the source locale file is required")
+	}
+	files, err := globFiles(*locales)
+	if err != nil {
+		return errors.Wrap(err, "glob locale files")
+	} else if len(files) == 0 {
+		return errors.Errorf("no locale files found by %q", *locales)
+	}
+
+	src, err := readDocument(*source, formatOf(*source))
+	if err != nil {
+		return errors.Wrap(err, "read source")
+	}
+
+	for _, path := range files {
+		if sameFile(path, *source) {
+			continue
+		}
+
+		added, removed, err := syncFile(path, src, *mark, *prune)
+		if err != nil {
+			return err
+		}
+		infof("%s: %d keys added, %d keys removed", path, len(added), len(removed))
+	}
+	return nil
+}
+
+// syncFile syncs the locale file with the source document, the file is only
+// written when changed.
+func syncFile(path string, src *document, mark string, prune bool) (added, removed []string, err error) {
+	name := formatOf(path)
+	doc, err := readDocument(path, name)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	lang := doc.lang
+	if lang == "" {
+		lang = langOf(path)
+	}
+	tag, err := language.Parse(lang)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "parse lang of %q", path)
+	}
+
+	added, removed = syncDocument(doc, src, plural.DefaultRules().Rule(tag), mark, prune)
+	if len(added) == 0 && len(removed) == 0 {
+		return nil, nil, nil
+	}
+
+	var buf bytes.Buffer
+	if err = formats[name].write(&buf, doc); err != nil {
+		return nil, nil, errors.Wrapf(err, "write %q", path)
+	}
+	return added, removed, ioutil.WriteFile(path, buf.Bytes(), 0644)
+}
+
+// syncDocument adds sections and entries of the source document that are
+// missing in the document, and removes ones that are not in the source
+// document if prune is true. Plural nouns are added with forms required by the
+// rule, or with the same forms as the source document if the rule is nil. It
+// returns keys of added and removed entries.
+func syncDocument(doc, src *document, rule *plural.Rule, mark string, prune bool) (added, removed []string) {
+	last := -1 // The index of the last synced section
+	for _, ss := range src.sections {
+		i := doc.indexOf(ss.name)
+		if i < 0 {
+			i = last + 1
+			doc.sections = append(doc.sections, nil)
+			copy(doc.sections[i+1:], doc.sections[i:])
+			doc.sections[i] = &section{name: ss.name, comment: ss.comment}
+		}
+		last = i
+
+		var wanted []*entry
+		if ss.name == pluralsSection {
+			wanted = pluralEntries(ss, rule)
+		} else {
+			// Metadata of messages is only for the source locale.
+			for _, e := range ss.entries {
+				if !isMetaKey(e.name) {
+					wanted = append(wanted, e)
+				}
+			}
+		}
+		added = append(added, syncSection(doc.sections[i], wanted, mark)...)
+
+		if prune {
+			removed = append(removed, pruneSection(doc.sections[i], ss)...)
+		}
+	}
+
+	if prune {
+		sections := doc.sections[:0]
+		for _, s := range doc.sections {
+			if src.indexOf(s.name) < 0 {
+				for _, e := range s.entries {
+					removed = append(removed, joinKey(s.name, e.name))
+				}
+				continue
+			}
+			sections = append(sections, s)
+		}
+		doc.sections = sections
+	}
+
+	if doc.sources != nil {
+		for key, value := range src.values() {
+			doc.sources[key] = value
+		}
+	}
+	return added, removed
+}
+
+// indexOf returns the index of the section with the given name, or -1 if not
+// exists.
+func (d *document) indexOf(name string) int {
+	for i, s := range d.sections {
+		if s.name == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// indexOf returns the index of the entry with the given name, or -1 if not
+// exists.
+func (s *section) indexOf(name string) int {
+	for i, e := range s.entries {
+		if e.name == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// pluralEntries returns entries of plural nouns of the "[plurals]" section
+// with forms required by the rule. Comments of forms that also exist in the
+// source section are kept.
+func pluralEntries(s *section, rule *plural.Rule) []*entry {
+	if rule == nil {
+		return s.entries
+	}
+
+	var nouns []string
+	seen := make(map[string]bool)
+	for _, e := range s.entries {
+		noun := pluralNoun(e.name)
+		if noun != "" && !seen[noun] {
+			seen[noun] = true
+			nouns = append(nouns, noun)
+		}
+	}
+
+	var entries []*entry
+	for _, noun := range nouns {
+		for _, form := range pluralFormOrder {
+			if _, required := rule.PluralForms[form]; !required {
+				continue
+			}
+
+			e := &entry{name: noun + "." + string(form)}
+			if i := s.indexOf(e.name); i >= 0 {
+				e.comment = s.entries[i].comment
+			}
+			entries = append(entries, e)
+		}
+	}
+	return entries
+}
+
+// pluralNoun returns the noun of the entry name of a plural form, e.g. "file"
+// for "file.one".
+func pluralNoun(name string) string {
+	i := strings.Index(name, ".")
+	if i <= 0 {
+		return ""
+	}
+	return name[:i]
+}
+
+// syncSection adds wanted entries that are missing in the section with empty
+// values, each added entry is placed after the entry that precedes it in the
+// wanted entries. It returns keys of added entries.
+func syncSection(s *section, wanted []*entry, mark string) (added []string) {
+	last := -1 // The index of the last synced entry
+	for _, w := range wanted {
+		i := s.indexOf(w.name)
+		if i < 0 {
+			comment := w.comment
+			if mark != "" {
+				comment = strings.TrimPrefix(comment+"\n"+mark, "\n")
+			}
+
+			i = last + 1
+			s.entries = append(s.entries, nil)
+			copy(s.entries[i+1:], s.entries[i:])
+			s.entries[i] = &entry{name: w.name, comment: comment}
+			added = append(added, joinKey(s.name, w.name))
+		}
+		last = i
+	}
+	return added
+}
+
+// pruneSection removes entries of the section that are not in the source
+// section. For the "[plurals]" section, forms of nouns that are in the source
+// section are all kept. Metadata of messages that are in the source section
+// are also kept. It returns keys of removed entries.
+func pruneSection(s, src *section) (removed []string) {
+	keep := func(name string) bool {
+		return src.indexOf(strings.TrimSuffix(name, metaKeySuffix)) >= 0
+	}
+	if s.name == pluralsSection {
+		nouns := make(map[string]bool)
+		for _, e := range src.entries {
+			nouns[pluralNoun(e.name)] = true
+		}
+		keep = func(name string) bool { return nouns[pluralNoun(name)] }
+	}
+
+	entries := s.entries[:0]
+	for _, e := range s.entries {
+		if !keep(e.name) {
+			removed = append(removed, joinKey(s.name, e.name))
+			continue
+		}
+		entries = append(entries, e)
+	}
+	s.entries = entries
+	return removed
+}
@@ -0,0 +1,149 @@
+// Copyright 2026 Joe Chen. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSyncFile(t *testing.T) {
+	src, err := readDocument("testdata/locales/locale_en-US.ini", "ini")
+	require.Nil(t, err)
+
+	path := filepath.Join(t.TempDir(), "locale_ru-RU.ini")
+	err = ioutil.WriteFile(path, []byte(`[plurals]
+file.one = файл
+file.other = файла
+cat.one = кот
+cat.other = кота
+
+; Messages
+[messages]
+; Greeting
+hello = Привет, %s!
+fallback = Запасной
+obsolete = Устаревший
+`), 0644)
+	require.Nil(t, err)
+
+	added, removed, err := syncFile(path, src, "TODO: translate", false)
+	require.Nil(t, err)
+	assert.Equal(t,
+		[]string{
+			"title",
+			"plurals::file.few",
+			"plurals::file.many",
+			"plurals::dog.one",
+			"plurals::dog.few",
+			"plurals::dog.many",
+			"plurals::dog.other",
+			"messages::goodbye",
+			"messages::wrapped",
+		},
+		added,
+	)
+	assert.Empty(t, removed)
+
+	got, err := ioutil.ReadFile(path)
+	require.Nil(t, err)
+	want := "# TODO: translate\n" +
+		"title = \n" +
+		"\n" +
+		"[plurals]\n" +
+		"file.one = файл\n" +
+		"# TODO: translate\n" +
+		"file.few = \n" +
+		"# TODO: translate\n" +
+		"file.many = \n" +
+		"file.other = файла\n" +
+		"# TODO: translate\n" +
+		"dog.one = \n" +
+		"# TODO: translate\n" +
+		"dog.few = \n" +
+		"# TODO: translate\n" +
+		"dog.many = \n" +
+		"# TODO: translate\n" +
+		"dog.other = \n" +
+		"cat.one = кот\n" +
+		"cat.other = кота\n" +
+		"\n" +
+		"# Messages\n" +
+		"[messages]\n" +
+		"# Greeting\n" +
+		"hello = Привет, %s!\n" +
+		"# TODO: translate\n" +
+		"goodbye = \n" +
+		"fallback = Запасной\n" +
+		"# TODO: translate\n" +
+		"wrapped = \n" +
+		"obsolete = Устаревший\n" +
+		"\n"
+	assert.Equal(t, want, string(got))
+
+	t.Run("prune", func(t *testing.T) {
+		added, removed, err := syncFile(path, src, "", true)
+		require.Nil(t, err)
+		assert.Empty(t, added)
+		assert.Equal(t, []string{"plurals::cat.one", "plurals::cat.other", "messages::obsolete"}, removed)
+
+		// Nothing to change
+		added, removed, err = syncFile(path, src, "", true)
+		require.Nil(t, err)
+		assert.Empty(t, added)
+		assert.Empty(t, removed)
+	})
+}
+
+func TestSyncDocument(t *testing.T) {
+	src := &document{lang: "en-US"}
+	s := src.section("messages")
+	s.comment = "Messages"
+	s.entries = []*entry{
+		{name: "hello", value: "Hello", comment: "Greeting"},
+		{name: "goodbye", value: "Goodbye"},
+	}
+
+	t.Run("new section", func(t *testing.T) {
+		doc := &document{lang: "zh-CN"}
+		doc.section("other").entries = []*entry{{name: "foo", value: "Foo"}}
+
+		added, removed := syncDocument(doc, src, nil, "", true)
+		assert.Equal(t, []string{"messages::hello", "messages::goodbye"}, added)
+		assert.Equal(t, []string{"other::foo"}, removed)
+
+		want := &document{
+			lang: "zh-CN",
+			sections: []*section{
+				{
+					name:    "messages",
+					comment: "Messages",
+					entries: []*entry{
+						{name: "hello", comment: "Greeting"},
+						{name: "goodbye"},
+					},
+				},
+			},
+		}
+		assert.Equal(t, want, doc)
+	})
+
+	t.Run("bilingual", func(t *testing.T) {
+		doc := &document{
+			lang:       "zh-CN",
+			sourceLang: "en-US",
+			sources:    map[string]string{"messages::hello": "Hi"},
+		}
+		doc.section("messages").entries = []*entry{{name: "hello", value: "你好"}}
+
+		added, _ := syncDocument(doc, src, nil, "", false)
+		assert.Equal(t, []string{"messages::goodbye"}, added)
+		assert.Equal(t, map[string]string{"messages::hello": "Hello", "messages::goodbye": "Goodbye"}, doc.sources)
+	})
+}
@@ -0,0 +1,11 @@
+package main
+
+import (
+	stdcontext "context"
+
+	"unknwon.dev/i18n"
+)
+
+func greet(ctx stdcontext.Context) string {
+	return i18n.T(ctx, "messages::hello", "Joe")
+}
@@ -0,0 +1,38 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"unknwon.dev/i18n"
+)
+
+const keyGoodbye = "messages::goodbye"
+
+type context struct {
+	locale *i18n.Locale
+}
+
+func (c *context) Tr(key string, args ...interface{}) string {
+	return c.locale.Translate(key, args...)
+}
+
+func main() {
+	s := i18n.NewStore()
+	l, _ := s.AddLocale("en-US", "English", "locale_en-US.ini")
+	fallback, _ := s.AddLocale("zh-CN", "简体中文", "locale_zh-CN.ini")
+
+	fmt.Println(l.Translate("messages::hello", "Joe"))
+	fmt.Println(l.Translate(keyGoodbye))
+	fmt.Println(l.TranslateWithFallback(fallback, "messages::"+"fallback"))
+	fmt.Println(l.TranslateNamed("title", nil))
+
+	c := &context{locale: l}
+	fmt.Println(c.Tr("messages::hello"))
+	fmt.Println(c.Tr("messages::wrapped"))
+
+	key := "messages::" + os.Args[1]
+	fmt.Println(l.Translate(key))
+
+	fmt.Println(l.Plural("dog", 2))
+}
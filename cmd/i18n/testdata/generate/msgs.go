@@ -0,0 +1,48 @@
+// Code generated by "i18n generate" from locale_en-US.ini; DO NOT EDIT.
+
+package msgs
+
+import (
+	"time"
+
+	"unknwon.dev/i18n"
+)
+
+// MessagesChanges translates "messages::changes", e.g. "%[1]d ${file, 1} changed, %[2]d deleted".
+func MessagesChanges(l *i18n.Locale, file int, arg2 int) string {
+	return l.Translate("messages::changes", file, arg2)
+}
+
+// MessagesHello translates "messages::hello", e.g. "Hello, %s!".
+func MessagesHello(l *i18n.Locale, arg1 string) string {
+	return l.Translate("messages::hello", arg1)
+}
+
+// MessagesMixed translates "messages::mixed", e.g. "%v ${type, 1} and %[1]s".
+func MessagesMixed(l *i18n.Locale, type_ interface{}) string {
+	return l.Translate("messages::mixed", type_)
+}
+
+// MessagesNamed translates "messages::named", e.g. "{name} has ${file, count} shared ${relative:long, ago}".
+func MessagesNamed(l *i18n.Locale, name interface{}, count int, ago time.Duration) string {
+	return l.TranslateNamed("messages::named", map[string]interface{}{
+		"name":  name,
+		"count": count,
+		"ago":   ago,
+	})
+}
+
+// MessagesRatio translates "messages::ratio", e.g. "%[2]*.[1]f%%".
+func MessagesRatio(l *i18n.Locale, arg1 float64, arg2 int) string {
+	return l.Translate("messages::ratio", arg1, arg2)
+}
+
+// MessagesScheduled translates "messages::scheduled", e.g. "Scheduled on ${date:medium, 1}, %[2]q".
+func MessagesScheduled(l *i18n.Locale, arg1 time.Time, arg2 string) string {
+	return l.Translate("messages::scheduled", arg1, arg2)
+}
+
+// Title translates "title", e.g. "Welcome".
+func Title(l *i18n.Locale) string {
+	return l.Translate("title")
+}
@@ -0,0 +1,196 @@
+// Copyright 2026 Joe Chen. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"encoding/xml"
+	"io"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"unknwon.dev/i18n"
+)
+
+// In the XLIFF 1.2 format, each section is a group, and each entry is a
+// translation unit whose ID is the key of the message, e.g.
+//
+//	<group id="messages">
+//	  <note>Messages of the home page</note>
+//	  <trans-unit id="messages::hello" resname="hello">
+//	    <source>Hello, %s!</source>
+//	    <target>你好，%s！</target>
+//	  </trans-unit>
+//	</group>
+//
+// The source text is taken from the source locale if given, otherwise the
+// message itself is the source text without a target.
+//
+// Metadata of messages (see i18n.MessageMeta) are kept on translation units
+// rather than as units, i.e. the description and the context (with the prefix
+// "context: ") are notes from the developer, and the maximum length is the
+// "maxwidth" in characters, e.g.
+//
+//	<trans-unit id="messages::hello" resname="hello" maxwidth="20" size-unit="char">
+//	  <source>Hello, %s!</source>
+//	  <note>Shown on the home page</note>
+//	  <note from="developer">Greeting to the signed in user</note>
+//	  <note from="developer">context: home</note>
+//	</trans-unit>
+
+// xliffDeveloper is the author of notes of metadata.
+const xliffDeveloper = "developer"
+
+type xliffDocument struct {
+	XMLName xml.Name  `xml:"urn:oasis:names:tc:xliff:document:1.2 xliff"`
+	Version string    `xml:"version,attr"`
+	File    xliffFile `xml:"file"`
+}
+
+type xliffFile struct {
+	Original       string       `xml:"original,attr"`
+	SourceLanguage string       `xml:"source-language,attr"`
+	TargetLanguage string       `xml:"target-language,attr,omitempty"`
+	Datatype       string       `xml:"datatype,attr"`
+	Groups         []xliffGroup `xml:"body>group"`
+}
+
+type xliffGroup struct {
+	ID    string      `xml:"id,attr"`
+	Note  string      `xml:"note,omitempty"`
+	Units []xliffUnit `xml:"trans-unit"`
+}
+
+type xliffUnit struct {
+	ID       string      `xml:"id,attr"`
+	Resname  string      `xml:"resname,attr"`
+	Maxwidth int         `xml:"maxwidth,attr,omitempty"`
+	SizeUnit string      `xml:"size-unit,attr,omitempty"`
+	Source   string      `xml:"source"`
+	Target   *string     `xml:"target"`
+	Notes    []xliffNote `xml:"note"`
+}
+
+type xliffNote struct {
+	From string `xml:"from,attr,omitempty"`
+	Text string `xml:",chardata"`
+}
+
+// readXLIFF reads the document from data of XLIFF, the target text is preferred
+// over the source text.
+func readXLIFF(data []byte) (*document, error) {
+	var x xliffDocument
+	if err := xml.Unmarshal(data, &x); err != nil {
+		return nil, errors.Wrap(err, "unmarshal")
+	}
+
+	doc := &document{lang: x.File.SourceLanguage}
+	if x.File.TargetLanguage != "" {
+		doc.lang = x.File.TargetLanguage
+		doc.sourceLang = x.File.SourceLanguage
+		doc.sources = make(map[string]string)
+	}
+	for _, g := range x.File.Groups {
+		s := doc.section(g.ID)
+		s.comment = g.Note
+		for _, u := range g.Units {
+			name := u.Resname
+			if name == "" {
+				_, name = splitKey(u.ID)
+			}
+
+			value := u.Source
+			if u.Target != nil {
+				value = *u.Target
+			}
+
+			meta := i18n.MessageMeta{MaxLength: u.Maxwidth}
+			var comments, descriptions []string
+			for _, n := range u.Notes {
+				switch {
+				case n.From != xliffDeveloper:
+					comments = append(comments, n.Text)
+				case strings.HasPrefix(n.Text, contextNotePrefix):
+					meta.Context = strings.TrimPrefix(n.Text, contextNotePrefix)
+				default:
+					descriptions = append(descriptions, n.Text)
+				}
+			}
+			meta.Comment = strings.Join(comments, "\n")
+			meta.Description = strings.Join(descriptions, " ")
+			s.entries = append(s.entries, &entry{name: name, value: value, comment: metaComment(meta)})
+			if doc.sources != nil {
+				doc.sources[joinKey(g.ID, name)] = u.Source
+			}
+		}
+	}
+	return doc, nil
+}
+
+// writeXLIFF writes the document as XLIFF.
+func writeXLIFF(w io.Writer, doc *document) error {
+	x := xliffDocument{
+		Version: "1.2",
+		File: xliffFile{
+			Original:       "i18n",
+			SourceLanguage: doc.lang,
+			Datatype:       "plaintext",
+		},
+	}
+	bilingual := doc.sources != nil
+	if bilingual {
+		x.File.SourceLanguage = doc.sourceLang
+		x.File.TargetLanguage = doc.lang
+	}
+
+	for _, s := range doc.sections {
+		g := xliffGroup{
+			ID:   s.name,
+			Note: s.comment,
+		}
+		for _, e := range s.messages() {
+			key := joinKey(s.name, e.name)
+			u := xliffUnit{
+				ID:      key,
+				Resname: e.name,
+				Source:  e.value,
+			}
+
+			meta := s.meta(e)
+			if meta.MaxLength > 0 {
+				u.Maxwidth = meta.MaxLength
+				u.SizeUnit = "char"
+			}
+			if meta.Comment != "" {
+				u.Notes = append(u.Notes, xliffNote{Text: meta.Comment})
+			}
+			if meta.Description != "" {
+				u.Notes = append(u.Notes, xliffNote{From: xliffDeveloper, Text: meta.Description})
+			}
+			if meta.Context != "" {
+				u.Notes = append(u.Notes, xliffNote{From: xliffDeveloper, Text: contextNotePrefix + meta.Context})
+			}
+			if bilingual {
+				value := e.value
+				u.Source = doc.sources[key]
+				u.Target = &value
+			}
+			g.Units = append(g.Units, u)
+		}
+		x.File.Groups = append(x.File.Groups, g)
+	}
+
+	_, err := io.WriteString(w, xml.Header)
+	if err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err = enc.Encode(x); err != nil {
+		return errors.Wrap(err, "encode")
+	}
+	_, err = io.WriteString(w, "\n")
+	return err
+}
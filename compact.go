@@ -0,0 +1,164 @@
+// Copyright 2026 Joe Chen. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package i18n
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"unknwon.dev/i18n/internal/plural"
+)
+
+// maxCompactExponent is the largest exponent of the power of 10 that has
+// compact decimal patterns, i.e. hundreds of trillions.
+const maxCompactExponent = 14
+
+// FormatCompact formats the number in the compact decimal form of the locale,
+// e.g. "1.2K" in English, "1,2 Mio." in German and "1.2万" in Chinese. The
+// value is an integer, a float or a decimal string. The style is "short" or
+// "long" (e.g. "1.2 thousand").
+func (l *Locale) FormatCompact(value interface{}, style string) string {
+	s, _, err := l.formatCompact(value, style)
+	if err != nil {
+		return fmt.Sprintf("<%v>", err)
+	}
+	return s
+}
+
+// formatCompact returns the compact decimal form of the number and the plural
+// operands that describe it, e.g. "1.2c6" for "1.2 million".
+func (l *Locale) formatCompact(value interface{}, style string) (string, *plural.Operands, error) {
+	if style != "short" && style != "long" {
+		return "", nil, fmt.Errorf("no such compact style: %s", style)
+	}
+
+	number, err := decimalString(value)
+	if err != nil {
+		return "", nil, err
+	}
+	sign := ""
+	if strings.HasPrefix(number, "-") {
+		sign, number = "-", number[1:]
+	}
+	f, err := strconv.ParseFloat(number, 64)
+	if err != nil {
+		return "", nil, err
+	}
+
+	integer := number
+	if i := strings.IndexByte(number, '.'); i >= 0 {
+		integer = number[:i]
+	}
+	exponent := len(strings.TrimLeft(integer, "0")) - 1
+	if exponent > maxCompactExponent {
+		exponent = maxCompactExponent
+	}
+
+	// Compact numbers are only grouped when having at least 2 digits before the
+	// first grouping separator, e.g. "1234" but "12,345".
+	numbers := *l.cldr.Numbers
+	if numbers.MinimumGroupingDigits < 2 {
+		numbers.MinimumGroupingDigits = 2
+	}
+
+	for {
+		patterns := l.cldr.Numbers.CompactPatterns(style, exponent)
+		zeros := countZeros(patterns[plural.Other])
+		if patterns == nil || zeros == 0 || patterns[plural.Other] == "0" {
+			// The number is too small or not compacted in the locale, only round it to
+			// show at most 2 significant digits for small numbers.
+			s := roundFloat(f, exponent < 1)
+			if i := strings.IndexByte(s, '.'); i < 0 && len(s) > exponent+1 && exponent < maxCompactExponent {
+				// Rounding has carried over to the next exponent, which may be
+				// compacted, e.g. "999.5" is "1K".
+				exponent = len(s) - 1
+				continue
+			}
+			ops, err := plural.NewOperands(s)
+			if err != nil {
+				return "", nil, err
+			}
+			return numbers.Format(sign + s), ops, nil
+		}
+
+		// Numbers with a single integer digit keep one fraction digit, e.g. "1.2K"
+		// but "12K".
+		divisor := exponent - zeros + 1
+		s := roundFloat(f/pow10(divisor), zeros == 1)
+		if i := strings.IndexByte(s, '.'); (i < 0 && len(s) > zeros) || i > zeros {
+			// Rounding has carried over to the next exponent, e.g. "999.96K" is "1M".
+			if exponent < maxCompactExponent {
+				exponent++
+				continue
+			}
+		}
+
+		scaled, err := plural.NewOperands(s)
+		if err != nil {
+			return "", nil, err
+		}
+		// Patterns of explicit numbers take precedence, e.g. "mille" for exactly
+		// 1000 in French.
+		pattern, ok := patterns[plural.Form(s)]
+		if !ok {
+			pattern, ok = patterns[l.pluralForm(scaled)]
+		}
+		if !ok {
+			pattern = patterns[plural.Other]
+		}
+
+		ops, err := plural.NewOperands(s + "c" + strconv.Itoa(divisor))
+		if err != nil {
+			return "", nil, err
+		}
+
+		// Some patterns do not show the number at all, e.g. "mille" in French.
+		if start := strings.IndexByte(pattern, '0'); start >= 0 {
+			pattern = pattern[:start] + numbers.Format(sign+s) + pattern[start+countZeros(pattern):]
+		}
+		return pattern, ops, nil
+	}
+}
+
+// countZeros returns the length of the first run of "0"s in the pattern.
+func countZeros(pattern string) int {
+	start := strings.IndexByte(pattern, '0')
+	if start < 0 {
+		return 0
+	}
+	end := start
+	for end < len(pattern) && pattern[end] == '0' {
+		end++
+	}
+	return end - start
+}
+
+// roundFloat rounds the float to an integer, or to one fraction digit when
+// withFraction is true. Trailing zeros are removed.
+func roundFloat(f float64, withFraction bool) string {
+	precision := 0
+	if withFraction {
+		precision = 1
+	}
+	s := strconv.FormatFloat(f, 'f', precision, 64)
+	if withFraction {
+		s = strings.TrimSuffix(strings.TrimRight(s, "0"), ".")
+	}
+	return s
+}
+
+func pow10(n int) float64 {
+	f := 1.0
+	for i := 0; i < n; i++ {
+		f *= 10
+	}
+	return f
+}
+
+// formatCompactArg formats a number argument in the compact decimal form.
+func formatCompactArg(l *Locale, style string, arg interface{}, _ []interface{}) string {
+	return l.FormatCompact(arg, style)
+}
@@ -0,0 +1,104 @@
+// Copyright 2026 Joe Chen. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package i18n
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLocale_FormatCompact(t *testing.T) {
+	s := NewStore()
+	locales := make(map[string]*Locale)
+	for _, lang := range []string{"en-US", "de-DE", "fr-FR", "es-ES", "zh-CN", "ja-JP"} {
+		l, err := s.AddLocale(lang, lang, []byte(``))
+		assert.Nil(t, err)
+		locales[lang] = l
+	}
+
+	tests := []struct {
+		lang  string
+		value interface{}
+		style string
+		want  string
+	}{
+		{lang: "en-US", value: 999, style: "short", want: "999"},
+		{lang: "en-US", value: 1.234, style: "short", want: "1.2"},
+		{lang: "en-US", value: 1234, style: "short", want: "1.2K"},
+		{lang: "en-US", value: 1234, style: "long", want: "1.2 thousand"},
+		{lang: "en-US", value: 12345, style: "short", want: "12K"},
+		{lang: "en-US", value: 999960, style: "short", want: "1M"},
+		{lang: "en-US", value: 999.5, style: "short", want: "1K"},
+		{lang: "en-US", value: 999.5, style: "long", want: "1 thousand"},
+		{lang: "en-US", value: 999_950, style: "short", want: "1M"},
+		{lang: "en-US", value: 999_950, style: "long", want: "1 million"},
+		{lang: "en-US", value: 0.96, style: "short", want: "1"},
+		{lang: "de-DE", value: 9999.5, style: "short", want: "10.000"},
+		{lang: "en-US", value: "1500000", style: "short", want: "1.5M"},
+		{lang: "en-US", value: -1200, style: "short", want: "-1.2K"},
+		{lang: "en-US", value: int64(1e18), style: "short", want: "1,000,000T"},
+		{lang: "de-DE", value: 1200000, style: "short", want: "1,2 Mio."},
+		{lang: "de-DE", value: 1234, style: "short", want: "1234"},
+		{lang: "fr-FR", value: 1000, style: "long", want: "mille"},
+		{lang: "fr-FR", value: 1500, style: "long", want: "1,5 millier"},
+		{lang: "fr-FR", value: 2000, style: "long", want: "2 mille"},
+		{lang: "fr-FR", value: 1200000, style: "long", want: "1,2 million"},
+		{lang: "es-ES", value: 2500000, style: "long", want: "2,5 millones"},
+		{lang: "zh-CN", value: 12000, style: "short", want: "1.2万"},
+		{lang: "ja-JP", value: 123456789, style: "short", want: "1.2億"},
+		{lang: "en-US", value: 1234, style: "medium", want: "<no such compact style: medium>"},
+		{lang: "en-US", value: true, style: "short", want: "<invalid type bool; expected number or string>"},
+	}
+	for _, test := range tests {
+		t.Run(test.lang+"/"+test.want, func(t *testing.T) {
+			got := locales[test.lang].FormatCompact(test.value, test.style)
+			assert.Equal(t, test.want, got)
+		})
+	}
+}
+
+func TestLocale_Translate_Compact(t *testing.T) {
+	s := NewStore()
+	en, err := s.AddLocale("en-US", "English", []byte(`
+[plurals]
+file.one = file
+file.other = files
+
+[messages]
+files = ${compact:long, 1} ${file, 1}
+`))
+	assert.Nil(t, err)
+
+	fr, err := s.AddLocale("fr-FR", "Français", []byte(`
+[plurals]
+file.one = fichier
+file.many = de fichiers
+file.other = fichiers
+
+[messages]
+files = ${compact:long, 1} ${file, 1}
+`))
+	assert.Nil(t, err)
+
+	tests := []struct {
+		name   string
+		locale *Locale
+		value  interface{}
+		want   string
+	}{
+		{name: "en one", locale: en, value: 1, want: "1 file"},
+		{name: "en compact", locale: en, value: 1200000, want: "1.2 million files"},
+		{name: "fr one", locale: fr, value: 1, want: "1 fichier"},
+		{name: "fr other", locale: fr, value: 2000, want: "2 mille fichiers"},
+		{name: "fr many", locale: fr, value: 1200000, want: "1,2 million de fichiers"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := test.locale.Translate("messages::files", test.value)
+			assert.Equal(t, test.want, got)
+		})
+	}
+}
@@ -0,0 +1,122 @@
+// Copyright 2026 Joe Chen. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package i18n
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"time"
+)
+
+type contextKey int
+
+const (
+	localeContextKey contextKey = iota
+	storeContextKey
+)
+
+// WithLocale returns a copy of the context with the locale attached.
+func WithLocale(ctx context.Context, l *Locale) context.Context {
+	return context.WithValue(ctx, localeContextKey, l)
+}
+
+// WithStore returns a copy of the context with the store attached, whose
+// default locale is used when no locale is attached to the context.
+func WithStore(ctx context.Context, s *Store) context.Context {
+	return context.WithValue(ctx, storeContextKey, s)
+}
+
+// LocaleFromContext returns the locale attached to the context, and reports
+// whether a locale is attached. Unlike LocaleFrom, it never falls back to
+// default locales.
+func LocaleFromContext(ctx context.Context) (*Locale, bool) {
+	l, ok := ctx.Value(localeContextKey).(*Locale)
+	return l, ok && l != nil
+}
+
+// LocaleFrom returns the locale attached to the context. When no locale is
+// attached, it returns the default locale of the store attached to the context
+// or the default store (see SetDefaultStore). It returns nil if none of them is
+// available.
+func LocaleFrom(ctx context.Context) *Locale {
+	if l, ok := LocaleFromContext(ctx); ok {
+		return l
+	}
+	if s, ok := ctx.Value(storeContextKey).(*Store); ok && s != nil {
+		if l := s.DefaultLocale(); l != nil {
+			return l
+		}
+	}
+	return defaultLocale()
+}
+
+// T translates the message of the given key with the locale of the context
+// (see LocaleFrom).
+func T(ctx context.Context, key string, args ...interface{}) string {
+	l := LocaleFrom(ctx)
+	if l == nil {
+		return fmt.Sprintf("<no locale for key: %s>", key)
+	}
+	return l.Translate(key, args...)
+}
+
+// TN translates the message of the given key with named arguments and the
+// locale of the context (see LocaleFrom).
+func TN(ctx context.Context, key string, args map[string]interface{}) string {
+	l := LocaleFrom(ctx)
+	if l == nil {
+		return fmt.Sprintf("<no locale for key: %s>", key)
+	}
+	return l.TranslateNamed(key, args)
+}
+
+// detachedContext is a context that keeps values of the parent context but is
+// never canceled and has no deadline.
+type detachedContext struct {
+	parent context.Context
+}
+
+func (detachedContext) Deadline() (deadline time.Time, ok bool) { return time.Time{}, false }
+func (detachedContext) Done() <-chan struct{}                   { return nil }
+func (detachedContext) Err() error                              { return nil }
+func (c detachedContext) Value(key interface{}) interface{}     { return c.parent.Value(key) }
+
+// Detach returns a new context that keeps values (e.g. the locale) of the given
+// context but is never canceled and has no deadline. It is useful to start
+// background jobs that outlive the request.
+func Detach(ctx context.Context) context.Context {
+	return detachedContext{parent: ctx}
+}
+
+// Go calls the function in a new goroutine with a detached context (see
+// Detach) of the given context.
+func Go(ctx context.Context, fn func(ctx context.Context)) {
+	ctx = Detach(ctx)
+	go fn(ctx)
+}
+
+var defaultStore atomic.Value // *Store
+
+// SetDefaultStore sets the default store whose default locale is used by
+// LocaleFrom when no locale or store is attached to the context.
+func SetDefaultStore(s *Store) {
+	defaultStore.Store(s)
+}
+
+// DefaultStore returns the default store, or nil if not set.
+func DefaultStore() *Store {
+	s, _ := defaultStore.Load().(*Store)
+	return s
+}
+
+// defaultLocale returns the default locale of the default store, or nil if not
+// available.
+func defaultLocale() *Locale {
+	if s := DefaultStore(); s != nil {
+		return s.DefaultLocale()
+	}
+	return nil
+}
@@ -0,0 +1,97 @@
+// Copyright 2026 Joe Chen. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package i18n
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLocaleFrom(t *testing.T) {
+	s, enUS, zhCN := newTestStore(t)
+	defer SetDefaultStore(nil)
+
+	ctx := context.Background()
+	assert.Nil(t, LocaleFrom(ctx))
+
+	SetDefaultStore(s)
+	assert.Equal(t, enUS, LocaleFrom(ctx))
+
+	other := NewStore()
+	_, err := other.AddLocale("fr-FR", "Français", []byte(``))
+	assert.Nil(t, err)
+	ctx = WithStore(ctx, other)
+	assert.Equal(t, "fr-FR", LocaleFrom(ctx).Lang())
+
+	ctx = WithLocale(ctx, zhCN)
+	assert.Equal(t, zhCN, LocaleFrom(ctx))
+
+	t.Run("empty store", func(t *testing.T) {
+		ctx := WithStore(context.Background(), NewStore())
+		assert.Equal(t, enUS, LocaleFrom(ctx))
+	})
+}
+
+func TestLocaleFromContext(t *testing.T) {
+	s, _, zhCN := newTestStore(t)
+	SetDefaultStore(s)
+	defer SetDefaultStore(nil)
+
+	_, ok := LocaleFromContext(WithStore(context.Background(), s))
+	assert.False(t, ok)
+
+	l, ok := LocaleFromContext(WithLocale(context.Background(), zhCN))
+	assert.True(t, ok)
+	assert.Equal(t, zhCN, l)
+
+	t.Run("nil locale", func(t *testing.T) {
+		_, ok := LocaleFromContext(WithLocale(context.Background(), nil))
+		assert.False(t, ok)
+	})
+}
+
+func TestT(t *testing.T) {
+	_, _, zhCN := newTestStore(t)
+
+	ctx := WithLocale(context.Background(), zhCN)
+	assert.Equal(t, "你好，Unknwon！", T(ctx, "messages::hello", "Unknwon"))
+	assert.Equal(t, "你好，Unknwon！", TN(ctx, "messages::named", map[string]interface{}{"name": "Unknwon"}))
+
+	t.Run("no locale", func(t *testing.T) {
+		ctx := context.Background()
+		assert.Equal(t, "<no locale for key: messages::hello>", T(ctx, "messages::hello", "Unknwon"))
+		assert.Equal(t, "<no locale for key: messages::named>", TN(ctx, "messages::named", nil))
+	})
+}
+
+func TestDetach(t *testing.T) {
+	_, _, zhCN := newTestStore(t)
+
+	ctx, cancel := context.WithTimeout(WithLocale(context.Background(), zhCN), time.Minute)
+	cancel()
+
+	detached := Detach(ctx)
+	assert.Equal(t, zhCN, LocaleFrom(detached))
+	assert.Nil(t, detached.Err())
+	assert.Nil(t, detached.Done())
+	_, ok := detached.Deadline()
+	assert.False(t, ok)
+}
+
+func TestGo(t *testing.T) {
+	_, _, zhCN := newTestStore(t)
+
+	ctx, cancel := context.WithCancel(WithLocale(context.Background(), zhCN))
+	got := make(chan string)
+	Go(ctx, func(ctx context.Context) {
+		// The job keeps running after the request is done.
+		cancel()
+		got <- T(ctx, "messages::hello", ctx.Err())
+	})
+	assert.Equal(t, "你好，%!s(<nil>)！", <-got)
+}
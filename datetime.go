@@ -0,0 +1,60 @@
+// Copyright 2026 Joe Chen. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package i18n
+
+import (
+	"fmt"
+	"time"
+)
+
+// FormatDate formats the date of the given time with the CLDR data of the
+// locale. The style is one of "full", "long", "medium" and "short", or a CLDR
+// skeleton of date fields (e.g. "yMMMd").
+func (l *Locale) FormatDate(t time.Time, style string) string {
+	return l.formatDateTime(t, style, true, false)
+}
+
+// FormatTime formats the time of day of the given time with the CLDR data of
+// the locale. The style is one of "full", "long", "medium" and "short", or a
+// CLDR skeleton of time fields (e.g. "Hm").
+func (l *Locale) FormatTime(t time.Time, style string) string {
+	return l.formatDateTime(t, style, false, true)
+}
+
+// FormatDateTime formats both the date and the time of day of the given time
+// with the CLDR data of the locale. The style is one of "full", "long",
+// "medium" and "short", or a CLDR skeleton of both date and time fields (e.g.
+// "yMMMdHm").
+func (l *Locale) FormatDateTime(t time.Time, style string) string {
+	return l.formatDateTime(t, style, true, true)
+}
+
+func (l *Locale) formatDateTime(t time.Time, style string, withDate, withTime bool) string {
+	pattern, ok := l.cldr.Calendar.Pattern(style, withDate, withTime)
+	if !ok {
+		return fmt.Sprintf("<no such date format: %s>", style)
+	}
+	return l.cldr.Calendar.Format(pattern, t)
+}
+
+// formatDateTimeArg returns a formatFunc to format a time.Time argument. The
+// time is converted to the first *time.Location in the list of arguments if
+// any.
+func formatDateTimeArg(withDate, withTime bool) formatFunc {
+	return func(l *Locale, style string, arg interface{}, args []interface{}) string {
+		t, ok := arg.(time.Time)
+		if !ok {
+			return fmt.Sprintf("<invalid type %T; expected time.Time>", arg)
+		}
+
+		for _, arg := range args {
+			if loc, ok := arg.(*time.Location); ok && loc != nil {
+				t = t.In(loc)
+				break
+			}
+		}
+		return l.formatDateTime(t, style, withDate, withTime)
+	}
+}
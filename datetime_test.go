@@ -0,0 +1,160 @@
+// Copyright 2026 Joe Chen. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package i18n
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLocale_FormatDate(t *testing.T) {
+	s := NewStore()
+	en, err := s.AddLocale("en-US", "English", []byte(``))
+	assert.Nil(t, err)
+	de, err := s.AddLocale("de-DE", "Deutsch", []byte(``))
+	assert.Nil(t, err)
+	zh, err := s.AddLocale("zh-CN", "简体中文", []byte(``))
+	assert.Nil(t, err)
+
+	tm := time.Date(2006, time.January, 2, 15, 4, 5, 0, time.UTC)
+	tests := []struct {
+		name   string
+		locale *Locale
+		format func(l *Locale) string
+		want   string
+	}{
+		{
+			name:   "en date",
+			locale: en,
+			format: func(l *Locale) string { return l.FormatDate(tm, "full") },
+			want:   "Monday, January 2, 2006",
+		},
+		{
+			name:   "en time",
+			locale: en,
+			format: func(l *Locale) string { return l.FormatTime(tm, "short") },
+			want:   "3:04 PM",
+		},
+		{
+			name:   "en date and time",
+			locale: en,
+			format: func(l *Locale) string { return l.FormatDateTime(tm, "medium") },
+			want:   "Jan 2, 2006, 3:04:05 PM",
+		},
+		{
+			name:   "de date and time",
+			locale: de,
+			format: func(l *Locale) string { return l.FormatDateTime(tm, "long") },
+			want:   "2. Januar 2006 um 15:04:05 UTC",
+		},
+		{
+			name:   "zh date",
+			locale: zh,
+			format: func(l *Locale) string { return l.FormatDate(tm, "full") },
+			want:   "2006年1月2日星期一",
+		},
+		{
+			name:   "skeleton",
+			locale: de,
+			format: func(l *Locale) string { return l.FormatDate(tm, "yMMMd") },
+			want:   "2. Jan. 2006",
+		},
+		{
+			name:   "skeleton of date and time",
+			locale: en,
+			format: func(l *Locale) string { return l.FormatDateTime(tm, "yMMMdHm") },
+			want:   "Jan 2, 2006, 15:04",
+		},
+		{
+			name:   "skeleton of date for time",
+			locale: en,
+			format: func(l *Locale) string { return l.FormatTime(tm, "yMMMd") },
+			want:   "<no such date format: yMMMd>",
+		},
+		{
+			name:   "no such date format",
+			locale: en,
+			format: func(l *Locale) string { return l.FormatDate(tm, "QQQQ") },
+			want:   "<no such date format: QQQQ>",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.want, test.format(test.locale))
+		})
+	}
+}
+
+func TestLocale_Translate_DateTime(t *testing.T) {
+	l, err := NewStore().AddLocale("en-US", "English", []byte(`
+[messages]
+updated = Updated on ${date:medium, 1}
+starts = %s starts at ${time:Hm, 2}
+both = ${datetime:short, 1} (%[2]d%%)
+unknown = Updated on ${calendar:medium, 1} by %[2]s
+era = Founded on ${date:GyMMMd, 1}
+`))
+	assert.Nil(t, err)
+
+	tm := time.Date(2006, time.January, 2, 15, 4, 5, 0, time.UTC)
+	tokyo := time.FixedZone("JST", 9*60*60)
+	tests := []struct {
+		name string
+		key  string
+		args []interface{}
+		want string
+	}{
+		{
+			name: "date",
+			key:  "messages::updated",
+			args: []interface{}{tm},
+			want: "Updated on Jan 2, 2006",
+		},
+		{
+			name: "with location",
+			key:  "messages::starts",
+			args: []interface{}{"Meeting", tm, tokyo},
+			want: "Meeting starts at 00:04",
+		},
+		{
+			name: "explicit argument indexes",
+			key:  "messages::both",
+			args: []interface{}{tm, 50},
+			want: "1/2/06, 3:04 PM (50%)",
+		},
+		{
+			name: "era",
+			key:  "messages::era",
+			args: []interface{}{tm},
+			want: "Founded on Jan 2, 2006 AD",
+		},
+		{
+			name: "no arg for index",
+			key:  "messages::starts",
+			args: []interface{}{"Meeting"},
+			want: "Meeting starts at <no arg for index 2>",
+		},
+		{
+			name: "invalid type",
+			key:  "messages::updated",
+			args: []interface{}{"yesterday"},
+			want: "Updated on <invalid type string; expected time.Time>",
+		},
+		{
+			name: "no such format",
+			key:  "messages::unknown",
+			args: []interface{}{tm, "Joe"},
+			want: "Updated on <no such format: calendar> by Joe",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := l.Translate(test.key, test.args...)
+			assert.Equal(t, test.want, got)
+		})
+	}
+}
@@ -0,0 +1,81 @@
+// Copyright 2026 Joe Chen. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package i18n
+
+import (
+	"github.com/pkg/errors"
+)
+
+// Error is an error with the key and arguments of the message, which is
+// translated to the language of the user when being rendered, e.g.
+//
+//	var ErrNotFound = i18n.NewError("errors::not_found")
+//
+//	return i18n.WrapError(err, "errors::not_found", id)
+//
+// Errors with the same key are considered the same by errors.Is regardless of
+// arguments.
+type Error struct {
+	Key  string
+	Args []interface{}
+	err  error
+}
+
+// NewError returns a new error with the key and arguments of the message.
+func NewError(key string, args ...interface{}) *Error {
+	return &Error{
+		Key:  key,
+		Args: args,
+	}
+}
+
+// WrapError returns a new error with the key and arguments of the message that
+// wraps the given error.
+func WrapError(err error, key string, args ...interface{}) *Error {
+	return &Error{
+		Key:  key,
+		Args: args,
+		err:  err,
+	}
+}
+
+// Localize returns the message of the error translated in the locale, the
+// wrapped error is not included. Arguments that are also *Error or LazyMessage
+// are localized in the same locale.
+func (e *Error) Localize(l *Locale) string {
+	return localize(l, e.Key, e.Args)
+}
+
+// Error returns the message of the error translated in the default locale of
+// the default store (see SetDefaultStore), followed by the message of the
+// wrapped error if any. The key is used when the message is not available.
+func (e *Error) Error() string {
+	msg := e.Localize(defaultLocale())
+	if e.err != nil {
+		msg += ": " + e.err.Error()
+	}
+	return msg
+}
+
+// Unwrap returns the wrapped error if any.
+func (e *Error) Unwrap() error {
+	return e.err
+}
+
+// Is returns true if the target is an *Error with the same key.
+func (e *Error) Is(target error) bool {
+	t, ok := target.(*Error)
+	return ok && t.Key == e.Key
+}
+
+// LocalizeError returns the message of the first *Error in the chain of the
+// error translated in the locale, or the message of the error if not found.
+func LocalizeError(err error, l *Locale) string {
+	var e *Error
+	if errors.As(err, &e) {
+		return e.Localize(l)
+	}
+	return err.Error()
+}
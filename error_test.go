@@ -0,0 +1,68 @@
+// Copyright 2026 Joe Chen. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package i18n
+
+import (
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestError_Localize(t *testing.T) {
+	_, enUS, zhCN := newTestStore(t)
+
+	err := NewError("errors::not_found", "i18n")
+	assert.Equal(t, `Repository "i18n" not found`, err.Localize(enUS))
+	assert.Equal(t, `仓库 "i18n" 不存在`, err.Localize(zhCN))
+
+	t.Run("localized arguments", func(t *testing.T) {
+		err := NewError("errors::reason", NewError("errors::internal"))
+		assert.Equal(t, "失败：出错了", err.Localize(zhCN))
+	})
+
+	t.Run("no message", func(t *testing.T) {
+		assert.Equal(t, "errors::unknown", NewError("errors::unknown").Localize(zhCN))
+		assert.Equal(t, "errors::not_found [i18n]", err.Localize(nil))
+	})
+}
+
+func TestError_Error(t *testing.T) {
+	s, _, _ := newTestStore(t)
+
+	err := WrapError(io.EOF, "errors::not_found", "i18n")
+	assert.Equal(t, "errors::not_found [i18n]: EOF", err.Error())
+
+	SetDefaultStore(s)
+	defer SetDefaultStore(nil)
+	assert.Equal(t, `Repository "i18n" not found: EOF`, err.Error())
+
+	err = NewError("errors::internal")
+	assert.Equal(t, "Something went wrong", err.Error())
+}
+
+func TestError_Is(t *testing.T) {
+	errNotFound := NewError("errors::not_found")
+
+	err := fmt.Errorf("get repository: %w", WrapError(io.EOF, "errors::not_found", "i18n"))
+	assert.True(t, errors.Is(err, errNotFound))
+	assert.True(t, errors.Is(err, io.EOF))
+	assert.False(t, errors.Is(err, NewError("errors::internal")))
+
+	var e *Error
+	assert.True(t, errors.As(err, &e))
+	assert.Equal(t, "errors::not_found", e.Key)
+	assert.Equal(t, []interface{}{"i18n"}, e.Args)
+}
+
+func TestLocalizeError(t *testing.T) {
+	_, _, zhCN := newTestStore(t)
+
+	err := errors.Wrap(NewError("errors::not_found", "i18n"), "get repository")
+	assert.Equal(t, `仓库 "i18n" 不存在`, LocalizeError(err, zhCN))
+	assert.Equal(t, "EOF", LocalizeError(io.EOF, zhCN))
+}
@@ -0,0 +1,182 @@
+// Copyright 2026 Joe Chen. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package i18n
+
+import (
+	"fmt"
+	"html/template"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Characters in the Unicode private use area are used as sentinels for the safe
+// HTML because they are neither escaped nor expected in messages.
+const (
+	sentinelStart = "\uE000"
+	sentinelEnd   = "\uE001"
+)
+
+// htmlSentinel returns the sentinel of the n-th safe HTML and records it to the
+// safe map.
+func htmlSentinel(n int, safe map[string]string, html template.HTML) string {
+	sentinel := sentinelStart + strconv.Itoa(n) + sentinelEnd
+	safe[sentinel] = string(html)
+	return sentinel
+}
+
+// escapeHTML escapes the text and then replaces sentinels with the safe HTML.
+func escapeHTML(text string, safe map[string]string) template.HTML {
+	if len(safe) == 0 {
+		return template.HTML(template.HTMLEscapeString(text))
+	}
+
+	replaces := make([]string, 0, len(safe)*2)
+	for sentinel, html := range safe {
+		replaces = append(replaces, sentinel, html)
+	}
+	return template.HTML(strings.NewReplacer(replaces...).Replace(template.HTMLEscapeString(text)))
+}
+
+// TagFunc renders the content of a tag placeholder in a rich text message, e.g.
+// "terms" of "<link>terms</link>". The content is already escaped.
+type TagFunc func(content template.HTML) template.HTML
+
+// htmlArg is an argument of a rich text message. It is formatted with the verb
+// as usual, and then written as the sentinel of the escaped text, so that it is
+// never rendered as tags. The text of a template.HTML argument is kept intact.
+type htmlArg struct {
+	arg  interface{}
+	safe map[string]string
+}
+
+func (a htmlArg) Format(f fmt.State, verb rune) {
+	text := fmt.Sprintf(formatDirective(f, verb), a.arg)
+	html := template.HTML(template.HTMLEscapeString(text))
+	if _, ok := a.arg.(template.HTML); ok && (verb == 's' || verb == 'v') {
+		html = template.HTML(text)
+	}
+	_, _ = io.WriteString(f, htmlSentinel(len(a.safe), a.safe, html))
+}
+
+// TranslateHTML translates the message with the supplied list of arguments as
+// rich text. Tag placeholders in the message, e.g. "<link>terms</link>" and
+// "<br/>", are rendered by the tag functions with the same name, and tags may be
+// nested. All text of the message, arguments and the output of plural and
+// format placeholders are escaped and never rendered as tags, while
+// template.HTML arguments are kept intact. Tags without a tag function are
+// rendered as escaped text.
+//
+// For example, with the message "Read the <link>terms</link>" and the tag
+// function of "link" that wraps the content with `<a href="/terms">` and `</a>`,
+// the result is `Read the <a href="/terms">terms</a>`.
+func (m *Message) TranslateHTML(tags map[string]TagFunc, args ...interface{}) template.HTML {
+	safe := make(map[string]string)
+	// Plural and format placeholders take arguments as-is, and their output is
+	// escaped instead.
+	safeArgs := make([]interface{}, len(args))
+	for i, arg := range args {
+		safeArgs[i] = htmlArg{arg: arg, safe: safe}
+	}
+	return renderRichText(m.translate(args, safeArgs, escapeFunc(safe)), tags, safe)
+}
+
+// TranslateNamedHTML is like TranslateHTML but with named arguments, see
+// Message.TranslateNamed.
+func (m *Message) TranslateNamedHTML(tags map[string]TagFunc, args map[string]interface{}) template.HTML {
+	safe := make(map[string]string)
+	// Plural and format placeholders take arguments as-is, and their output is
+	// escaped instead.
+	safeArgs := make(map[string]interface{}, len(args))
+	for name, arg := range args {
+		safeArgs[name] = htmlArg{arg: arg, safe: safe}
+	}
+	return renderRichText(m.translateNamed(args, safeArgs, escapeFunc(safe)), tags, safe)
+}
+
+// escapeFunc returns the function that escapes the output of plural and format
+// placeholders as the sentinel of the safe HTML, so that it is never rendered as
+// tags.
+func escapeFunc(safe map[string]string) func(string) string {
+	return func(text string) string {
+		return htmlSentinel(len(safe), safe, template.HTML(template.HTMLEscapeString(text)))
+	}
+}
+
+var tagRe = regexp.MustCompile(`<(/?)([a-zA-Z][\w-]*)\s*(/?)>`) // e.g. </link> => ["/", "link", ""]
+
+// richTextFrame is an open tag that is being rendered.
+type richTextFrame struct {
+	name    string
+	tag     string // The original text of the open tag
+	content strings.Builder
+}
+
+// renderRichText renders the translated text with tag functions, the text is
+// escaped and sentinels are replaced with the safe HTML.
+func renderRichText(text string, tags map[string]TagFunc, safe map[string]string) template.HTML {
+	stack := []*richTextFrame{{}}
+	write := func(html template.HTML) {
+		stack[len(stack)-1].content.WriteString(string(html))
+	}
+
+	last := 0
+	for _, loc := range tagRe.FindAllStringSubmatchIndex(text, -1) {
+		write(escapeHTML(text[last:loc[0]], safe))
+		last = loc[1]
+
+		tag := text[loc[0]:loc[1]]
+		closing := loc[3] > loc[2]
+		name := text[loc[4]:loc[5]]
+		selfClosing := loc[7] > loc[6]
+
+		f, ok := tags[name]
+		switch {
+		case !ok || (closing && selfClosing):
+			write(escapeHTML(tag, safe))
+		case selfClosing:
+			write(f(""))
+		case !closing:
+			stack = append(stack, &richTextFrame{name: name, tag: tag})
+		case len(stack) > 1 && stack[len(stack)-1].name == name:
+			top := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			write(f(template.HTML(top.content.String())))
+		default: // Unbalanced closing tag
+			write(escapeHTML(tag, safe))
+		}
+	}
+	write(escapeHTML(text[last:], safe))
+
+	// Unclosed tags are rendered as escaped text.
+	for len(stack) > 1 {
+		top := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		write(escapeHTML(top.tag, safe))
+		write(template.HTML(top.content.String()))
+	}
+	return template.HTML(stack[0].content.String())
+}
+
+// TranslateHTML uses the locale to translate the message of the given key as
+// rich text, see Message.TranslateHTML.
+func (l *Locale) TranslateHTML(key string, tags map[string]TagFunc, args ...interface{}) template.HTML {
+	m, ok := l.message(key)
+	if !ok {
+		return escapeHTML(fmt.Sprintf("<no such key: %s>", key), nil)
+	}
+	return m.TranslateHTML(tags, args...)
+}
+
+// TranslateNamedHTML uses the locale to translate the message of the given key
+// as rich text with named arguments, see Message.TranslateNamedHTML.
+func (l *Locale) TranslateNamedHTML(key string, tags map[string]TagFunc, args map[string]interface{}) template.HTML {
+	m, ok := l.message(key)
+	if !ok {
+		return escapeHTML(fmt.Sprintf("<no such key: %s>", key), nil)
+	}
+	return m.TranslateNamedHTML(tags, args)
+}
@@ -0,0 +1,175 @@
+// Copyright 2026 Joe Chen. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package i18n
+
+import (
+	"html/template"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLocale_TranslateHTML(t *testing.T) {
+	l, err := NewStore().AddLocale(
+		"en-US",
+		"English",
+		[]byte(`
+[plurals]
+file.one = file
+file.other = files
+
+[messages]
+terms = Read the <link>terms</link> & conditions
+nested = <b>Hello, <i>%s</i></b>!
+self-closing = Line 1<br/>Line 2
+plural = %[2]s changed <b>%[1]d ${file, 1}</b>
+unknown = Read the <script>alert(1)</script>
+unbalanced = </b>Hello, <b>%s
+raw = Read the <a href="/terms">terms</a>
+named = <b>{user}</b> changed {count} ${file, count}
+score = <b>%05.1f</b> by %v
+list = Shared with <b>${list:conjunction, 1}</b>
+unit = Used <b>${unit:digital-megabyte, 1}</b>
+reused = ${list:conjunction, 1} (%[1]v)
+`),
+	)
+	assert.Nil(t, err)
+
+	tags := map[string]TagFunc{
+		"link": func(content template.HTML) template.HTML {
+			return `<a href="/terms">` + content + `</a>`
+		},
+		"b": func(content template.HTML) template.HTML {
+			return "<strong>" + content + "</strong>"
+		},
+		"i": func(content template.HTML) template.HTML {
+			return "<em>" + content + "</em>"
+		},
+		"br": func(template.HTML) template.HTML {
+			return "<br>"
+		},
+	}
+
+	tests := []struct {
+		name string
+		key  string
+		args []interface{}
+		want template.HTML
+	}{
+		{
+			name: "tag",
+			key:  "messages::terms",
+			want: `Read the <a href="/terms">terms</a> &amp; conditions`,
+		},
+		{
+			name: "nested tags with string argument",
+			key:  "messages::nested",
+			args: []interface{}{"<Joe>"},
+			want: `<strong>Hello, <em>&lt;Joe&gt;</em></strong>!`,
+		},
+		{
+			name: "argument does not become a tag",
+			key:  "messages::nested",
+			args: []interface{}{"<link>Joe</link>"},
+			want: `<strong>Hello, <em>&lt;link&gt;Joe&lt;/link&gt;</em></strong>!`,
+		},
+		{
+			name: "safe argument",
+			key:  "messages::nested",
+			args: []interface{}{template.HTML(`<u>Joe</u>`)},
+			want: `<strong>Hello, <em><u>Joe</u></em></strong>!`,
+		},
+		{
+			name: "non-string argument",
+			key:  "messages::nested",
+			args: []interface{}{htmlStringer("<b>evil</b>")},
+			want: `<strong>Hello, <em>&lt;b&gt;evil&lt;/b&gt;</em></strong>!`,
+		},
+		{
+			name: "formatted arguments",
+			key:  "messages::score",
+			args: []interface{}{3.14159, htmlStringer("<i>Joe</i>")},
+			want: `<strong>003.1</strong> by &lt;i&gt;Joe&lt;/i&gt;`,
+		},
+		{
+			name: "list argument",
+			key:  "messages::list",
+			args: []interface{}{[]string{"<link>evil</link>", "<script>"}},
+			want: `Shared with <strong>&lt;link&gt;evil&lt;/link&gt; and &lt;script&gt;</strong>`,
+		},
+		{
+			name: "unit argument",
+			key:  "messages::unit",
+			args: []interface{}{"<link>5</link>"},
+			want: `Used <strong>&lt;invalid number &#34;&lt;link&gt;5&lt;/link&gt;&#34;&gt;</strong>`,
+		},
+		{
+			name: "argument of format placeholder and verb",
+			key:  "messages::reused",
+			args: []interface{}{[]string{"<link>evil</link>"}},
+			want: `&lt;link&gt;evil&lt;/link&gt; ([&lt;link&gt;evil&lt;/link&gt;])`,
+		},
+		{
+			name: "self-closing tag",
+			key:  "messages::self-closing",
+			want: `Line 1<br>Line 2`,
+		},
+		{
+			name: "plural",
+			key:  "messages::plural",
+			args: []interface{}{2, "Joe"},
+			want: `Joe changed <strong>2 files</strong>`,
+		},
+		{
+			name: "unknown tag",
+			key:  "messages::unknown",
+			want: `Read the &lt;script&gt;alert(1)&lt;/script&gt;`,
+		},
+		{
+			name: "unbalanced tags",
+			key:  "messages::unbalanced",
+			args: []interface{}{"Joe"},
+			want: `&lt;/b&gt;Hello, &lt;b&gt;Joe`,
+		},
+		{
+			name: "raw HTML",
+			key:  "messages::raw",
+			want: `Read the &lt;a href=&#34;/terms&#34;&gt;terms&lt;/a&gt;`,
+		},
+		{
+			name: "no such key",
+			key:  "messages::404",
+			want: `&lt;no such key: messages::404&gt;`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := l.TranslateHTML(test.key, tags, test.args...)
+			assert.Equal(t, test.want, got)
+		})
+	}
+
+	t.Run("named", func(t *testing.T) {
+		got := l.TranslateNamedHTML("messages::named", tags, map[string]interface{}{"user": "<Joe>", "count": 1})
+		assert.Equal(t, template.HTML(`<strong>&lt;Joe&gt;</strong> changed 1 file`), got)
+
+		got = l.TranslateNamedHTML("messages::named", tags, map[string]interface{}{"user": htmlStringer("<b>evil</b>"), "count": 2})
+		assert.Equal(t, template.HTML(`<strong>&lt;b&gt;evil&lt;/b&gt;</strong> changed 2 files`), got)
+	})
+
+	t.Run("named list argument", func(t *testing.T) {
+		l, err := NewStore().AddLocale("en-US", "English", []byte(`shared = Shared with <b>${list:conjunction, users}</b>`))
+		assert.Nil(t, err)
+
+		got := l.TranslateNamedHTML("shared", tags, map[string]interface{}{"users": []string{"<link>evil</link>", "<script>"}})
+		assert.Equal(t, template.HTML(`Shared with <strong>&lt;link&gt;evil&lt;/link&gt; and &lt;script&gt;</strong>`), got)
+	})
+}
+
+type htmlStringer string
+
+func (s htmlStringer) String() string {
+	return string(s)
+}
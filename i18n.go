@@ -7,22 +7,29 @@ package i18n
 import (
 	"fmt"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
+	"sync"
 
 	"github.com/pkg/errors"
 	"golang.org/x/text/language"
 	"gopkg.in/ini.v1"
 
+	"unknwon.dev/i18n/internal/cldr"
 	"unknwon.dev/i18n/internal/plural"
 )
 
-// Store contains a collection of locales and their descriptive names.
+// Store contains a collection of locales and their descriptive names. It is
+// safe for concurrent use.
 type Store struct {
+	mu      sync.RWMutex
 	langs   []string
 	descs   []string
 	locales map[string]*Locale
 	rules   plural.Rules
+
+	defaultLang string
 }
 
 // NewStore initializes and returns a new Store.
@@ -37,6 +44,9 @@ func NewStore() *Store {
 // was successfully added, false if a locale with the same language name has
 // already existed.
 func (s *Store) add(l *Locale) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
 	if _, ok := s.locales[l.Lang()]; ok {
 		return false
 	}
@@ -51,26 +61,37 @@ func (s *Store) add(l *Locale) bool {
 // AddLocale adds a locale with given language name and description that is
 // loaded from the list of sources. Please refer to INI documentation regarding
 // what is considered as a valid data source:
-// https://ini.unknwon.io/docs/howto/load_data_sources.
+// https://ini.unknwon.io/docs/howto/load_data_sources. Sources can also be
+// Source (e.g. SQLSource), whose entries take precedence over INI data sources.
 func (s *Store) AddLocale(lang, desc string, source interface{}, others ...interface{}) (*Locale, error) {
+	l, err := s.loadLocale(lang, desc, source, others...)
+	if err != nil {
+		return nil, err
+	}
+	if !s.add(l) {
+		return nil, errors.Errorf("duplicated locales for %q", lang)
+	}
+	return l, nil
+}
+
+// loadLocale loads a locale with given language name and description from the
+// list of sources.
+func (s *Store) loadLocale(lang, desc string, source interface{}, others ...interface{}) (*Locale, error) {
 	tag, err := language.Parse(lang)
 	if err != nil {
 		return nil, errors.Wrap(err, "parse lang")
 	}
 
-	file, err := ini.LoadSources(
-		ini.LoadOptions{
-			IgnoreInlineComment:         true,
-			UnescapeValueCommentSymbols: true,
-		},
-		source,
-		others...,
-	)
+	file, err := loadFile(lang, source, others...)
 	if err != nil {
 		return nil, errors.Wrap(err, "load sources")
 	}
-	file.BlockMode = false // We only read from the file
+	return s.compileLocale(tag, desc, file)
+}
 
+// compileLocale compiles a locale with given language tag and description from
+// the locale file.
+func (s *Store) compileLocale(tag language.Tag, desc string, file *ini.File) (*Locale, error) {
 	rule := s.rules[tag]
 	if rule == nil {
 		base, confidence := tag.Base()
@@ -83,16 +104,128 @@ func (s *Store) AddLocale(lang, desc string, source interface{}, others ...inter
 	if err != nil {
 		return nil, errors.Wrap(err, "new locale")
 	}
-	if !s.add(l) {
-		return nil, errors.Errorf("duplicated locales for %q", lang)
-	}
 	return l, nil
 }
 
+// loadFile loads the locale file of the language from the list of sources,
+// where entries of Source sources take precedence over INI data sources.
+func loadFile(lang string, source interface{}, others ...interface{}) (*ini.File, error) {
+	var sources []Source
+	var data []interface{}
+	for _, src := range append([]interface{}{source}, others...) {
+		if s, ok := src.(Source); ok {
+			sources = append(sources, s)
+		} else {
+			data = append(data, src)
+		}
+	}
+
+	opts := ini.LoadOptions{
+		IgnoreInlineComment:         true,
+		UnescapeValueCommentSymbols: true,
+	}
+	file := ini.Empty(opts)
+	if len(data) > 0 {
+		var err error
+		file, err = ini.LoadSources(opts, data[0], data[1:]...)
+		if err != nil {
+			return nil, err
+		}
+	}
+	file.BlockMode = false // We only read from the file
+
+	for _, src := range sources {
+		err := addSourceEntries(file, lang, src)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return file, nil
+}
+
 var ErrLocalNotFound = errors.New("locale not found")
 
+// RemoveLocale removes the locale with the given language name from the store.
+// The default locale is reset to the first added locale if it is the one being
+// removed. Locales that are already obtained from the store remain usable.
+func (s *Store) RemoveLocale(lang string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.locales[lang]; !ok {
+		return ErrLocalNotFound
+	}
+
+	i := s.indexOf(lang)
+	s.langs = append(s.langs[:i:i], s.langs[i+1:]...)
+	s.descs = append(s.descs[:i:i], s.descs[i+1:]...)
+	delete(s.locales, lang)
+	if s.defaultLang == lang {
+		s.defaultLang = ""
+	}
+	return nil
+}
+
+// ReplaceLocale replaces the locale with given language name by the one with
+// the description that is loaded from the list of sources, and the locale keeps
+// its position in the store. The store is left unchanged if the new locale
+// fails to load. Locales that are already obtained from the store, including
+// pseudo and overlay locales derived from the replaced one, keep translating
+// with old messages.
+func (s *Store) ReplaceLocale(lang, desc string, source interface{}, others ...interface{}) (*Locale, error) {
+	l, err := s.loadLocale(lang, desc, source, others...)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.replace(l); err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+// replace replaces the locale with the same language name in the store.
+func (s *Store) replace(l *Locale) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.locales[l.Lang()]; !ok {
+		return ErrLocalNotFound
+	}
+
+	i := s.indexOf(l.Lang())
+	s.descs[i] = l.Description()
+	s.locales[l.Lang()] = l
+	return nil
+}
+
+// indexOf returns the index of the language name in the order of locales being
+// added, or -1 if not found. It must be called with the lock held.
+func (s *Store) indexOf(lang string) int {
+	for i := range s.langs {
+		if s.langs[i] == lang {
+			return i
+		}
+	}
+	return -1
+}
+
+// Locales returns language names and descriptions of all locales in the order
+// of being added.
+func (s *Store) Locales() (langs, descs []string) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	langs = append([]string(nil), s.langs...)
+	descs = append([]string(nil), s.descs...)
+	return langs, descs
+}
+
 // Locale returns the locale with the given language name.
 func (s *Store) Locale(lang string) (*Locale, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
 	l, ok := s.locales[lang]
 	if !ok {
 		return nil, ErrLocalNotFound
@@ -100,25 +233,160 @@ func (s *Store) Locale(lang string) (*Locale, error) {
 	return l, nil
 }
 
+// SetDefaultLocale sets the locale with the given language name as the default
+// locale of the store.
+func (s *Store) SetDefaultLocale(lang string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.locales[lang]; !ok {
+		return ErrLocalNotFound
+	}
+	s.defaultLang = lang
+	return nil
+}
+
+// DefaultLocale returns the default locale of the store, which is the first
+// added locale unless set by SetDefaultLocale. It returns nil if the store has
+// no locales.
+func (s *Store) DefaultLocale() *Locale {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.defaultLocale()
+}
+
+// defaultLocale returns the default locale of the store. It must be called with
+// the lock held.
+func (s *Store) defaultLocale() *Locale {
+	if s.defaultLang != "" {
+		return s.locales[s.defaultLang]
+	}
+	if len(s.langs) == 0 {
+		return nil
+	}
+	return s.locales[s.langs[0]]
+}
+
+// Match returns the locale that best matches the list of languages in the form
+// of the "Accept-Language" HTTP header, e.g. "zh-CN,zh;q=0.9,en;q=0.8". It
+// returns the default locale if none of locales matches.
+func (s *Store) Match(acceptLanguage string) *Locale {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if len(s.langs) == 0 {
+		return nil
+	}
+
+	tags, _, err := language.ParseAcceptLanguage(acceptLanguage)
+	if err != nil || len(tags) == 0 {
+		return s.defaultLocale()
+	}
+
+	supported := make([]language.Tag, len(s.langs))
+	for i, lang := range s.langs {
+		supported[i] = s.locales[lang].tag
+	}
+	_, index, confidence := language.NewMatcher(supported).Match(tags...)
+	if confidence == language.No {
+		return s.defaultLocale()
+	}
+	return s.locales[s.langs[index]]
+}
+
 type pluralPlaceholder struct {
 	name  string
 	forms map[plural.Form]string
+	// The style of the compact placeholder with the same index if any, the
+	// plural form is then decided by the compact decimal form of the argument.
+	compact string
+}
+
+// formatFunc formats the argument of a format placeholder with the given style
+// in the locale. The full list of arguments is also supplied for looking up
+// additional options (e.g. *time.Location).
+type formatFunc func(l *Locale, style string, arg interface{}, args []interface{}) string
+
+// formatFuncs is the list of supported kinds of format placeholders.
+var formatFuncs = map[string]formatFunc{
+	"date":     formatDateTimeArg(true, false),
+	"time":     formatDateTimeArg(false, true),
+	"datetime": formatDateTimeArg(true, true),
+	"relative": formatRelativeTimeArg,
+	"list":     formatListArg,
+	"unit":     formatUnitArg,
+	"compact":  formatCompactArg,
+}
+
+type formatPlaceholder struct {
+	name   string
+	kind   string
+	style  string
+	index  int
+	format formatFunc
+}
+
+// namedPlaceholder is a placeholder that refers to an argument by name, e.g.
+// "{count}", "${file, count}" and "${date:medium, created}".
+type namedPlaceholder struct {
+	name string // The original text of the placeholder
+	arg  string // The name of the argument
+	// Exactly one of the following is set for plural and format placeholders,
+	// the value of the argument is printed as-is otherwise.
+	plural *pluralPlaceholder
+	format *formatPlaceholder
 }
 
 // Message represents a message in a locale.
 type Message struct {
+	locale       *Locale
+	raw          string // The original text as defined in the locale file
 	pluralRule   *plural.Rule
 	format       string
 	placeholders map[int]*pluralPlaceholder
+	formatters   []*formatPlaceholder
+	named        []*namedPlaceholder
+	// The number of arguments consumed by format verbs, or -1 if any verb uses
+	// explicit argument indexes. It is only used when the message has format
+	// placeholders.
+	argc int
+	// The metadata for translators and tooling, nil if none.
+	meta *MessageMeta
 }
 
 // Translate translates the message with the supplied list of arguments.
+//
+// Besides plural placeholders (e.g. "${file, 1}"), the message may contain
+// format placeholders in the form of "${<kind>:<style>, <index>}":
+//   - "${date:medium, 1}", "${time:short, 1}" and "${datetime:full, 1}" format
+//     a time.Time with the CLDR style (i.e. "full", "long", "medium" and
+//     "short") or skeleton (e.g. "yMMMd") of the locale. The time is converted
+//     to the first *time.Location in the arguments if any.
+//   - "${relative:long, 1}" formats a time.Duration or a time.Time relative to
+//     now, see Locale.FormatRelativeTime for the styles.
+//   - "${list:conjunction, 1}" joins a []string, see Locale.FormatList for the
+//     styles.
+//   - "${unit:digital-megabyte, 1}" formats a number with the unit in the
+//     "short" width, or in the given width, e.g. "${unit:duration-hour:long, 1}".
+//     See Locale.FormatUnit for the supported values.
+//   - "${compact:short, 1}" and "${compact:long, 1}" format a number in the
+//     compact decimal form, e.g. "1.2K" and "1.2 thousand". Plural placeholders
+//     with the same index (e.g. "${file, 1}") use the compact decimal form to
+//     decide the plural form, e.g. "1,2 million de fichiers" in French.
 func (m *Message) Translate(args ...interface{}) string {
+	return m.translate(args, args, nil)
+}
+
+// translate translates the message with the list of arguments for plural and
+// format placeholders, and the list of arguments for format verbs. The output
+// of each plural and format placeholder is passed through the wrap function if
+// not nil, e.g. to escape it for rich text.
+func (m *Message) translate(args, verbArgs []interface{}, wrap func(string) string) string {
 	if len(args) == 0 {
 		return m.format
 	}
-	if len(m.placeholders) == 0 {
-		return fmt.Sprintf(m.format, args...)
+	if len(m.placeholders) == 0 && len(m.formatters) == 0 {
+		return fmt.Sprintf(m.format, verbArgs...)
 	}
 
 	// NOTE: strings.NewReplacer makes >3x more allocations and 5x slower than strings.Replace.
@@ -134,35 +402,240 @@ func (m *Message) Translate(args ...interface{}) string {
 			continue
 		}
 
-		ops, err := plural.NewOperands(args[index-1])
-		if err != nil {
-			format = strings.Replace(format, placeholder.name, fmt.Sprintf("<%v>", err), 1)
+		value := m.pluralize(placeholder, args[index-1])
+		if wrap != nil {
+			value = wrap(value)
+		}
+		format = strings.Replace(format, placeholder.name, value, 1)
+	}
+
+	for _, formatter := range m.formatters {
+		var value string
+		if len(args) < formatter.index {
+			value = fmt.Sprintf("<no arg for index %d>", formatter.index)
+		} else {
+			value = formatter.format(m.locale, formatter.style, args[formatter.index-1], args)
+		}
+		if wrap != nil {
+			value = wrap(value)
+		}
+		format = strings.Replace(format, formatter.name, strings.ReplaceAll(value, "%", "%%"), 1)
+	}
+
+	// Arguments that are only used by format placeholders would otherwise be
+	// reported as "%!(EXTRA ...)".
+	if len(m.formatters) > 0 && m.argc >= 0 && m.argc < len(verbArgs) {
+		verbArgs = verbArgs[:m.argc]
+	}
+	return fmt.Sprintf(format, verbArgs...)
+}
+
+// pluralize returns the plural form of the placeholder for the argument.
+func (m *Message) pluralize(placeholder *pluralPlaceholder, arg interface{}) string {
+	var ops *plural.Operands
+	var err error
+	if placeholder.compact != "" {
+		_, ops, err = m.locale.formatCompact(arg, placeholder.compact)
+	} else {
+		ops, err = plural.NewOperands(arg)
+	}
+	if err != nil {
+		return fmt.Sprintf("<%v>", err)
+	}
+
+	form := plural.Other
+	if m.pluralRule != nil {
+		form = m.pluralRule.PluralFormFunc(ops)
+	}
+	return placeholder.forms[form]
+}
+
+// Format returns the original text of the message as defined in the locale
+// file, e.g. "I have %[1]d ${file, 1}".
+func (m *Message) Format() string {
+	return m.raw
+}
+
+// Placeholder is a placeholder in the text of a message.
+type Placeholder struct {
+	// The original text of the placeholder, e.g. "${file, 1}".
+	Text string
+	// The kind of the placeholder, i.e. "plural", "named" (e.g. "{name}") or the
+	// kind of the format placeholder (e.g. "date").
+	Kind string
+	// The noun of the plural placeholder.
+	Noun string
+	// The style of the format placeholder.
+	Style string
+	// The index (e.g. "1") or the name of the argument.
+	Arg string
+}
+
+// Placeholders returns placeholders in the text of the message in the order of
+// their first appearances, fmt verbs are not included.
+func (m *Message) Placeholders() []Placeholder {
+	type found struct {
+		pos int
+		Placeholder
+	}
+	var all []found
+	for _, loc := range placeholderRe.FindAllStringSubmatchIndex(m.raw, -1) {
+		all = append(all, found{loc[0], Placeholder{
+			Text: m.raw[loc[0]:loc[1]],
+			Kind: "plural",
+			Noun: m.raw[loc[2]:loc[3]],
+			Arg:  m.raw[loc[4]:loc[5]],
+		}})
+	}
+	for _, loc := range formatPlaceholderRe.FindAllStringSubmatchIndex(m.raw, -1) {
+		all = append(all, found{loc[0], Placeholder{
+			Text:  m.raw[loc[0]:loc[1]],
+			Kind:  m.raw[loc[2]:loc[3]],
+			Style: strings.TrimSpace(m.raw[loc[4]:loc[5]]),
+			Arg:   m.raw[loc[6]:loc[7]],
+		}})
+	}
+	for _, loc := range namedPlaceholderRe.FindAllStringSubmatchIndex(m.raw, -1) {
+		if loc[2] < 0 { // Escaped braces
 			continue
 		}
+		all = append(all, found{loc[0], Placeholder{
+			Text: m.raw[loc[0]:loc[1]],
+			Kind: "named",
+			Arg:  m.raw[loc[2]:loc[3]],
+		}})
+	}
+	sort.Slice(all, func(i, j int) bool { return all[i].pos < all[j].pos })
+
+	placeholders := make([]Placeholder, 0, len(all))
+	seen := make(map[string]bool, len(all))
+	for _, f := range all {
+		if !seen[f.Text] {
+			seen[f.Text] = true
+			placeholders = append(placeholders, f.Placeholder)
+		}
+	}
+	return placeholders
+}
 
-		form := plural.Other
-		if m.pluralRule != nil {
-			form = m.pluralRule.PluralFormFunc(ops)
+// countArgs returns the number of arguments consumed by the verbs of the
+// format, or -1 if any verb uses explicit argument indexes.
+func countArgs(format string) int {
+	count := 0
+	for i := 0; i < len(format); i++ {
+		if format[i] != '%' {
+			continue
+		}
+		i++
+		if i < len(format) && format[i] == '%' {
+			continue
+		}
+
+	verb:
+		for ; i < len(format); i++ {
+			switch c := format[i]; {
+			case c == '[':
+				return -1
+			case c == '*':
+				count++
+			case strings.IndexByte("+-# 0.", c) >= 0, '0' <= c && c <= '9':
+			default:
+				count++
+				break verb
+			}
 		}
-		format = strings.Replace(format, placeholder.name, placeholder.forms[form], 1)
 	}
-	return fmt.Sprintf(format, args...)
+	return count
 }
 
 // Locale represents a locale with target language and a collection of messages.
 type Locale struct {
-	tag      language.Tag
-	desc     string
-	messages map[string]*Message
+	tag        language.Tag
+	desc       string
+	pluralRule *plural.Rule
+	cldr       *cldr.Locale
+	plurals    map[string]map[plural.Form]string
+	messages   map[string]*Message
+	sections   []string // Names of sections that have messages in the order of the file
+	// The locale to look up messages that are not overridden, only set for
+	// overlay locales.
+	base *Locale
 }
 
-var placeholderRe = regexp.MustCompile(`\${([a-zA-z]+),\s*(\d+)}`) // e.g. ${file, 1} => ["file", "1"]
+// message returns the message with the given key, which is looked up from the
+// base locale if not found.
+func (l *Locale) message(key string) (*Message, bool) {
+	for ; l != nil; l = l.base {
+		if m, ok := l.messages[key]; ok {
+			return m, true
+		}
+	}
+	return nil, false
+}
+
+var (
+	placeholderRe       = regexp.MustCompile(`\${([a-zA-z]+),\s*(\d+|[a-zA-Z_]\w*)}`)       // e.g. ${file, 1} => ["file", "1"]
+	formatPlaceholderRe = regexp.MustCompile(`\${([a-z]+):([^,}]+),\s*(\d+|[a-zA-Z_]\w*)}`) // e.g. ${date:medium, 1} => ["date", "medium", "1"]
+	namedPlaceholderRe  = regexp.MustCompile(`{{|}}|{([a-zA-Z_]\w*)}`)                      // e.g. {count} => ["count"], and escaped braces "{{" and "}}" => [""]
+)
+
+// isArgName returns true if the argument reference of a placeholder is a name
+// rather than an index.
+func isArgName(ref string) bool {
+	return ref[0] < '0' || ref[0] > '9'
+}
+
+// pluralsSection is the reserved section to define all plurals.
+const pluralsSection = "plurals"
 
 // newLocale creates a new Locale with given language tag, description and the
 // raw locale file. The "[plurals]" section is reserved to define all plurals.
 func newLocale(tag language.Tag, desc string, rule *plural.Rule, file *ini.File) (*Locale, error) {
-	const pluralsSection = "plurals"
-	s := file.Section(pluralsSection)
+	l := &Locale{
+		tag:        tag,
+		desc:       desc,
+		pluralRule: rule,
+		cldr:       cldr.Lookup(tag),
+		plurals:    newPluralForms(file.Section(pluralsSection)),
+		messages:   make(map[string]*Message),
+	}
+	if err := l.addMessages(file); err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+// addMessages compiles and adds messages of all sections of the locale file,
+// except the "[plurals]" section.
+func (l *Locale) addMessages(file *ini.File) error {
+	for _, s := range file.Sections() {
+		if s.Name() == pluralsSection {
+			continue
+		}
+
+		for _, k := range s.Keys() {
+			if isMetaKey(k.Name()) {
+				continue
+			}
+			if len(l.sections) == 0 || l.sections[len(l.sections)-1] != s.Name() {
+				l.sections = append(l.sections, s.Name())
+			}
+
+			m, err := newMessage(l, k.String())
+			if err != nil {
+				return err
+			}
+
+			key := strings.TrimPrefix(s.Name()+"::"+k.Name(), ini.DefaultSection+"::")
+			m.meta = newMessageMeta(s, k)
+			l.messages[key] = m
+		}
+	}
+	return nil
+}
+
+// newPluralForms returns plural forms of nouns defined in the section.
+func newPluralForms(s *ini.Section) map[string]map[plural.Form]string {
 	keys := s.Keys()
 	pluralForms := make(map[string]map[plural.Form]string, len(keys))
 	for _, k := range s.Keys() {
@@ -184,60 +657,130 @@ func newLocale(tag language.Tag, desc string, rule *plural.Rule, file *ini.File)
 			p[plural.Form(form)] = k.String()
 		}
 	}
+	return pluralForms
+}
 
-	messages := make(map[string]*Message)
-	for _, s := range file.Sections() {
-		if s.Name() == pluralsSection {
-			continue
+// newMessage compiles the raw text of a message in the locale, plural nouns are
+// resolved from plurals of the locale.
+func newMessage(l *Locale, raw string) (*Message, error) {
+	// NOTE: Majority of messages do not need to deal with plurals, thus it makes
+	//  sense to leave them with a nil map to save some memory space.
+	var placeholders map[int]*pluralPlaceholder
+	var formatters []*formatPlaceholder
+	var named []*namedPlaceholder
+
+	format := raw
+	if strings.Contains(format, "${") {
+		matches := placeholderRe.FindAllStringSubmatch(format, -1)
+		replaces := make([]string, 0, len(matches)*2)
+		placeholders = make(map[int]*pluralPlaceholder, len(matches))
+		namedPlurals := make(map[string]*pluralPlaceholder)
+		for _, submatch := range matches {
+			placeholder := submatch[0]
+			noun := submatch[1]
+
+			var index int
+			if !isArgName(submatch[2]) {
+				index, _ = strconv.Atoi(submatch[2])
+				if index < 1 {
+					return nil, errors.Errorf("the smallest index is 1 but got %d for %q", index, placeholder)
+				}
+			}
+
+			forms, ok := l.plurals[noun]
+			if !ok {
+				replaces = append(replaces, placeholder, fmt.Sprintf("<no such plural: %s>", noun))
+				continue
+			}
+
+			// Named placeholders are kept as-is and only resolved by TranslateNamed.
+			if index == 0 {
+				p := &pluralPlaceholder{
+					name:  placeholder,
+					forms: forms,
+				}
+				namedPlurals[submatch[2]] = p
+				named = append(named, &namedPlaceholder{
+					name:   placeholder,
+					arg:    submatch[2],
+					plural: p,
+				})
+				continue
+			}
+
+			name := fmt.Sprintf("${%d}", index)
+			replaces = append(replaces, placeholder, name)
+			placeholders[index] = &pluralPlaceholder{
+				name:  name,
+				forms: forms,
+			}
 		}
 
-		for _, k := range s.Keys() {
-			// NOTE: Majority of messages do not need to deal with plurals, thus it makes
-			//  sense to leave them with a nil map to save some memory space.
-			var placeholders map[int]*pluralPlaceholder
-
-			format := k.String()
-			if strings.Contains(format, "${") {
-				matches := placeholderRe.FindAllStringSubmatch(format, -1)
-				replaces := make([]string, 0, len(matches)*2)
-				placeholders = make(map[int]*pluralPlaceholder, len(matches))
-				for _, submatch := range matches {
-					placeholder := submatch[0]
-					noun := submatch[1]
-					index, _ := strconv.Atoi(submatch[2])
-					if index < 1 {
-						return nil, errors.Errorf("the smallest index is 1 but got %d for %q", index, placeholder)
-					}
-
-					forms, ok := pluralForms[noun]
-					if !ok {
-						replaces = append(replaces, placeholder, fmt.Sprintf("<no such plural: %s>", noun))
-						continue
-					}
-
-					name := fmt.Sprintf("${%d}", index)
-					replaces = append(replaces, placeholder, name)
-					placeholders[index] = &pluralPlaceholder{
-						name:  name,
-						forms: forms,
-					}
+		for _, submatch := range formatPlaceholderRe.FindAllStringSubmatch(format, -1) {
+			placeholder := submatch[0]
+			kind := submatch[1]
+
+			f, ok := formatFuncs[kind]
+			if !ok {
+				replaces = append(replaces, placeholder, fmt.Sprintf("<no such format: %s>", kind))
+				continue
+			}
+
+			formatter := &formatPlaceholder{
+				name:   placeholder,
+				kind:   kind,
+				style:  strings.TrimSpace(submatch[2]),
+				format: f,
+			}
+
+			if isArgName(submatch[3]) {
+				named = append(named, &namedPlaceholder{
+					name:   placeholder,
+					arg:    submatch[3],
+					format: formatter,
+				})
+				if p, ok := namedPlurals[submatch[3]]; ok && kind == "compact" {
+					p.compact = formatter.style
 				}
-				format = strings.NewReplacer(replaces...).Replace(format)
+				continue
 			}
 
-			key := strings.TrimPrefix(s.Name()+"::"+k.Name(), ini.DefaultSection+"::")
-			messages[key] = &Message{
-				pluralRule:   rule,
-				format:       format,
-				placeholders: placeholders,
+			formatter.index, _ = strconv.Atoi(submatch[3])
+			if formatter.index < 1 {
+				return nil, errors.Errorf("the smallest index is 1 but got %d for %q", formatter.index, placeholder)
+			}
+			formatters = append(formatters, formatter)
+
+			if p, ok := placeholders[formatter.index]; ok && kind == "compact" {
+				p.compact = formatter.style
+			}
+		}
+		format = strings.NewReplacer(replaces...).Replace(format)
+	}
+
+	if strings.Contains(format, "{") {
+		seen := make(map[string]bool)
+		for _, submatch := range namedPlaceholderRe.FindAllStringSubmatch(format, -1) {
+			if submatch[1] == "" || seen[submatch[0]] {
+				continue
 			}
+			seen[submatch[0]] = true
+			named = append(named, &namedPlaceholder{
+				name: submatch[0],
+				arg:  submatch[1],
+			})
 		}
 	}
 
-	return &Locale{
-		tag:      tag,
-		desc:     desc,
-		messages: messages,
+	return &Message{
+		locale:       l,
+		raw:          raw,
+		pluralRule:   l.pluralRule,
+		format:       format,
+		placeholders: placeholders,
+		formatters:   formatters,
+		named:        named,
+		argc:         countArgs(format),
 	}, nil
 }
 
@@ -251,6 +794,104 @@ func (l *Locale) Description() string {
 	return l.desc
 }
 
+// Keys returns the sorted list of keys of all messages in the locale.
+func (l *Locale) Keys() []string {
+	seen := make(map[string]bool, len(l.messages))
+	keys := make([]string, 0, len(l.messages))
+	for ; l != nil; l = l.base {
+		for key := range l.messages {
+			if !seen[key] {
+				seen[key] = true
+				keys = append(keys, key)
+			}
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Has returns true if the locale has the message with the given key.
+func (l *Locale) Has(key string) bool {
+	_, ok := l.message(key)
+	return ok
+}
+
+// Sections returns names of sections that have messages in the order of the
+// locale file. The default section is named "DEFAULT", and the "[plurals]"
+// section is excluded.
+func (l *Locale) Sections() []string {
+	if l.base == nil {
+		return append([]string(nil), l.sections...)
+	}
+
+	// Sections of the base locale come first.
+	sections := l.base.Sections()
+	seen := make(map[string]bool, len(sections))
+	for _, name := range sections {
+		seen[name] = true
+	}
+	for _, name := range l.sections {
+		if !seen[name] {
+			sections = append(sections, name)
+		}
+	}
+	return sections
+}
+
+// Message returns the message with the given key, and false if not found in
+// the locale or its base locale (see Store.Overlay), in the same way as Has.
+func (l *Locale) Message(key string) (*Message, bool) {
+	return l.message(key)
+}
+
+// rtlScripts is the set of scripts that are written from right to left.
+var rtlScripts = map[string]bool{
+	"Adlm": true,
+	"Arab": true,
+	"Hebr": true,
+	"Mand": true,
+	"Mend": true,
+	"Nkoo": true,
+	"Rohg": true,
+	"Samr": true,
+	"Syrc": true,
+	"Thaa": true,
+	"Yezi": true,
+}
+
+// Dir returns the text direction of the locale, i.e. "rtl" for languages
+// written from right to left, and "ltr" otherwise.
+func (l *Locale) Dir() string {
+	script, _ := l.tag.Script()
+	if rtlScripts[script.String()] {
+		return "rtl"
+	}
+	return "ltr"
+}
+
+// Plural returns the plural form of the noun defined in the "[plurals]" section
+// for the given number, e.g. "files" for ("file", 2) in English.
+func (l *Locale) Plural(noun string, n interface{}) string {
+	forms, ok := l.plurals[noun]
+	if !ok {
+		return fmt.Sprintf("<no such plural: %s>", noun)
+	}
+
+	ops, err := plural.NewOperands(n)
+	if err != nil {
+		return fmt.Sprintf("<%v>", err)
+	}
+	return forms[l.pluralForm(ops)]
+}
+
+// pluralForm returns the plural form of the operands in the locale.
+func (l *Locale) pluralForm(ops *plural.Operands) plural.Form {
+	if l.pluralRule == nil {
+		return plural.Other
+	}
+	return l.pluralRule.PluralFormFunc(ops)
+}
+
 // Translate uses the locale to translate the message of the given key.
 func (l *Locale) Translate(key string, args ...interface{}) string {
 	return l.TranslateWithFallback(nil, key, args...)
@@ -260,7 +901,7 @@ func (l *Locale) Translate(key string, args ...interface{}) string {
 // key. It attempts to use the `fallback` to translate if the given key does not
 // exist in the locale.
 func (l *Locale) TranslateWithFallback(fallback *Locale, key string, args ...interface{}) string {
-	m, ok := l.messages[key]
+	m, ok := l.message(key)
 	if !ok {
 		if fallback != nil {
 			return fallback.Translate(key, args...)
@@ -269,3 +910,13 @@ func (l *Locale) TranslateWithFallback(fallback *Locale, key string, args ...int
 	}
 	return m.Translate(args...)
 }
+
+// TranslateNamed uses the locale to translate the message of the given key
+// with named arguments, see Message.TranslateNamed.
+func (l *Locale) TranslateNamed(key string, args map[string]interface{}) string {
+	m, ok := l.message(key)
+	if !ok {
+		return fmt.Sprintf("<no such key: %s>", key)
+	}
+	return m.TranslateNamed(args)
+}
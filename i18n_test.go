@@ -2,6 +2,7 @@ package i18n
 
 import (
 	"fmt"
+	"sync"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -47,6 +48,172 @@ func TestStore_Locale(t *testing.T) {
 	})
 }
 
+func TestStore_Locales(t *testing.T) {
+	s := NewStore()
+	langs, descs := s.Locales()
+	assert.Empty(t, langs)
+	assert.Empty(t, descs)
+
+	_, err := s.AddLocale("zh-CN", "简体中文", []byte(``))
+	assert.Nil(t, err)
+	_, err = s.AddLocale("en-US", "English", []byte(``))
+	assert.Nil(t, err)
+
+	langs, descs = s.Locales()
+	assert.Equal(t, []string{"zh-CN", "en-US"}, langs)
+	assert.Equal(t, []string{"简体中文", "English"}, descs)
+
+	// Modifying returned slices does not affect the store
+	langs[0] = "fr-FR"
+	langs, _ = s.Locales()
+	assert.Equal(t, "zh-CN", langs[0])
+}
+
+func TestStore_DefaultLocale(t *testing.T) {
+	s := NewStore()
+	assert.Nil(t, s.DefaultLocale())
+
+	enUS, err := s.AddLocale("en-US", "English", []byte(``))
+	assert.Nil(t, err)
+	zhCN, err := s.AddLocale("zh-CN", "简体中文", []byte(``))
+	assert.Nil(t, err)
+
+	// The first added locale is the default
+	assert.Equal(t, enUS, s.DefaultLocale())
+
+	err = s.SetDefaultLocale("zh-CN")
+	assert.Nil(t, err)
+	assert.Equal(t, zhCN, s.DefaultLocale())
+
+	t.Run("non-existent locale", func(t *testing.T) {
+		err := s.SetDefaultLocale("fr-FR")
+		assert.Equal(t, ErrLocalNotFound, err)
+		assert.Equal(t, zhCN, s.DefaultLocale())
+	})
+}
+
+func TestStore_RemoveLocale(t *testing.T) {
+	s := NewStore()
+	for _, lang := range []string{"en-US", "zh-CN", "fr-FR"} {
+		_, err := s.AddLocale(lang, lang, []byte(``))
+		assert.Nil(t, err)
+	}
+	zhCN, err := s.Locale("zh-CN")
+	assert.Nil(t, err)
+	assert.Nil(t, s.SetDefaultLocale("zh-CN"))
+
+	err = s.RemoveLocale("zh-CN")
+	assert.Nil(t, err)
+
+	langs, descs := s.Locales()
+	assert.Equal(t, []string{"en-US", "fr-FR"}, langs)
+	assert.Equal(t, []string{"en-US", "fr-FR"}, descs)
+	_, err = s.Locale("zh-CN")
+	assert.Equal(t, ErrLocalNotFound, err)
+	assert.Equal(t, "en-US", s.DefaultLocale().Lang())
+	assert.Equal(t, "zh-CN", zhCN.Lang())
+
+	// The removed locale can be added again
+	_, err = s.AddLocale("zh-CN", "简体中文", []byte(``))
+	assert.Nil(t, err)
+	langs, _ = s.Locales()
+	assert.Equal(t, []string{"en-US", "fr-FR", "zh-CN"}, langs)
+
+	t.Run("non-existent locale", func(t *testing.T) {
+		err := s.RemoveLocale("de-DE")
+		assert.Equal(t, ErrLocalNotFound, err)
+	})
+}
+
+func TestStore_ReplaceLocale(t *testing.T) {
+	s := NewStore()
+	old, err := s.AddLocale("en-US", "English", []byte(`hello = Helo`))
+	assert.Nil(t, err)
+	_, err = s.AddLocale("zh-CN", "简体中文", []byte(``))
+	assert.Nil(t, err)
+
+	l, err := s.ReplaceLocale("en-US", "English (US)", []byte(`hello = Hello`))
+	assert.Nil(t, err)
+	assert.Equal(t, "Hello", l.Translate("hello"))
+	assert.Equal(t, "Helo", old.Translate("hello"))
+
+	got, err := s.Locale("en-US")
+	assert.Nil(t, err)
+	assert.Equal(t, l, got)
+	assert.Equal(t, l, s.DefaultLocale())
+
+	langs, descs := s.Locales()
+	assert.Equal(t, []string{"en-US", "zh-CN"}, langs)
+	assert.Equal(t, []string{"English (US)", "简体中文"}, descs)
+
+	t.Run("non-existent locale", func(t *testing.T) {
+		_, err := s.ReplaceLocale("fr-FR", "Français", []byte(``))
+		assert.Equal(t, ErrLocalNotFound, err)
+	})
+
+	t.Run("bad locale", func(t *testing.T) {
+		_, err := s.ReplaceLocale("en-US", "English", []byte(`
+test1 = I have %[1]d ${cat, 0}
+`))
+		got := fmt.Sprintf("%v", err)
+		want := `new locale: the smallest index is 1 but got 0 for "${cat, 0}"`
+		assert.Equal(t, want, got)
+
+		l, err := s.Locale("en-US")
+		assert.Nil(t, err)
+		assert.Equal(t, "Hello", l.Translate("hello"))
+	})
+
+	t.Run("concurrent translations", func(t *testing.T) {
+		var wg sync.WaitGroup
+		for i := 0; i < 4; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for j := 0; j < 100; j++ {
+					got := s.Match("en").Translate("hello")
+					assert.Contains(t, []string{"Hello", "Hi"}, got)
+				}
+			}()
+		}
+		for i := 0; i < 100; i++ {
+			_, err := s.ReplaceLocale("en-US", "English", []byte(`hello = Hi`))
+			assert.Nil(t, err)
+		}
+		wg.Wait()
+	})
+}
+
+func TestStore_Match(t *testing.T) {
+	s := NewStore()
+	assert.Nil(t, s.Match("en-US"))
+
+	enUS, err := s.AddLocale("en-US", "English", []byte(``))
+	assert.Nil(t, err)
+	zhCN, err := s.AddLocale("zh-CN", "简体中文", []byte(``))
+	assert.Nil(t, err)
+	zhTW, err := s.AddLocale("zh-TW", "繁體中文", []byte(``))
+	assert.Nil(t, err)
+
+	tests := []struct {
+		acceptLanguage string
+		want           *Locale
+	}{
+		{acceptLanguage: "zh-CN,zh;q=0.9,en;q=0.8", want: zhCN},
+		{acceptLanguage: "en;q=0.8,zh-TW", want: zhTW},
+		{acceptLanguage: "zh", want: zhCN},
+		{acceptLanguage: "en-GB", want: enUS},
+		{acceptLanguage: "fr-FR", want: enUS},
+		{acceptLanguage: "", want: enUS},
+		{acceptLanguage: "!!!", want: enUS},
+	}
+	for _, test := range tests {
+		t.Run(test.acceptLanguage, func(t *testing.T) {
+			assert.Equal(t, test.want.Lang(), s.Match(test.acceptLanguage).Lang())
+		})
+	}
+}
+
 var sampleSource = []byte(`
 [plurals]
 file.one = file
@@ -67,6 +234,43 @@ test5 = My name is %s
 test6 = I have %[1]d ${dog, 1}
 `)
 
+// newTestStore returns a new store with locales "en-US" and "zh-CN" that are
+// shared by tests of contexts, errors and lazy messages.
+func newTestStore(t *testing.T) (s *Store, enUS, zhCN *Locale) {
+	s = NewStore()
+	enUS, err := s.AddLocale("en-US", "English", []byte(`
+[messages]
+hello = Hello, %s!
+named = Hello, {name}!
+
+[errors]
+not_found = Repository %q not found
+internal = Something went wrong
+reason = Failed: %s
+
+[notifications]
+welcome = Welcome, %s!
+invited = You are invited by %s: %s
+`))
+	assert.Nil(t, err)
+	zhCN, err = s.AddLocale("zh-CN", "简体中文", []byte(`
+[messages]
+hello = 你好，%s！
+named = 你好，{name}！
+
+[errors]
+not_found = 仓库 %q 不存在
+internal = 出错了
+reason = 失败：%s
+
+[notifications]
+welcome = 欢迎，%s！
+invited = %s 邀请了你：%s
+`))
+	assert.Nil(t, err)
+	return s, enUS, zhCN
+}
+
 func TestLocale_Translate(t *testing.T) {
 	l, err := NewStore().AddLocale(
 		"en-US",
@@ -138,9 +342,11 @@ file.other = 文件
 
 [messages]
 test1 = 我变更了 %[1]d 个${file, 1}并删除了 %[2]d 个${file, 2}
+test5 = 
 `),
 	)
 	assert.Nil(t, err)
+	assert.True(t, l2.Has("messages::test5"))
 
 	tests := []struct {
 		name string
@@ -160,6 +366,12 @@ test1 = 我变更了 %[1]d 个${file, 1}并删除了 %[2]d 个${file, 2}
 			args: nil,
 			want: `I have a dream`,
 		},
+		{
+			name: "empty message",
+			key:  "messages::test5",
+			args: nil,
+			want: ``,
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
@@ -169,6 +381,158 @@ test1 = 我变更了 %[1]d 个${file, 1}并删除了 %[2]d 个${file, 2}
 	}
 }
 
+func TestLocale_Plural(t *testing.T) {
+	l, err := NewStore().AddLocale(
+		"en-US",
+		"English",
+		sampleSource,
+	)
+	assert.Nil(t, err)
+
+	tests := []struct {
+		name string
+		noun string
+		n    interface{}
+		want string
+	}{
+		{name: "one", noun: "file", n: 1, want: "file"},
+		{name: "other", noun: "file", n: 2, want: "files"},
+		{name: "no such plural", noun: "cat", n: 1, want: "<no such plural: cat>"},
+		{name: "invalid number", noun: "file", n: "one", want: `<strconv.ParseFloat: parsing "one": invalid syntax>`},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.want, l.Plural(test.noun, test.n))
+		})
+	}
+}
+
+func TestLocale_Dir(t *testing.T) {
+	tests := []struct {
+		lang string
+		want string
+	}{
+		{lang: "en-US", want: "ltr"},
+		{lang: "zh-CN", want: "ltr"},
+		{lang: "ar", want: "rtl"},
+		{lang: "he-IL", want: "rtl"},
+		{lang: "fa", want: "rtl"},
+	}
+	for _, test := range tests {
+		t.Run(test.lang, func(t *testing.T) {
+			l, err := NewStore().AddLocale(test.lang, test.lang, []byte(``))
+			assert.Nil(t, err)
+			assert.Equal(t, test.want, l.Dir())
+		})
+	}
+}
+
+func TestLocale_Keys(t *testing.T) {
+	s := NewStore()
+	l, err := s.AddLocale("en-US", "English", []byte(`
+title = Welcome
+
+[plurals]
+file.one = file
+file.other = files
+
+[messages]
+hello = Hello
+hello@meta = {"description": "Greeting"}
+goodbye = Goodbye
+
+[empty]
+
+[errors]
+not_found = Not found
+`))
+	assert.Nil(t, err)
+
+	assert.Equal(t, []string{"errors::not_found", "messages::goodbye", "messages::hello", "title"}, l.Keys())
+	assert.Equal(t, []string{"DEFAULT", "messages", "errors"}, l.Sections())
+
+	assert.True(t, l.Has("messages::hello"))
+	assert.False(t, l.Has("messages::hello@meta"))
+	assert.False(t, l.Has("plurals::file.one"))
+
+	m, ok := l.Message("messages::hello")
+	assert.True(t, ok)
+	assert.Equal(t, "Hello", m.Format())
+
+	_, ok = l.Message("messages::unknown")
+	assert.False(t, ok)
+}
+
+func TestMessage_Placeholders(t *testing.T) {
+	s := NewStore()
+	l, err := s.AddLocale("en-US", "English", []byte(`
+[plurals]
+file.one = file
+file.other = files
+
+[messages]
+plain = Hello, %s!
+mixed = %[1]d ${file, 1} created on ${date:medium, 2} by {name}, ${file, 1} in total
+missing = ${dog, 1} ${unknown:style, count}
+`))
+	assert.Nil(t, err)
+
+	tests := []struct {
+		key        string
+		wantFormat string
+		want       []Placeholder
+	}{
+		{
+			key:        "messages::plain",
+			wantFormat: "Hello, %s!",
+			want:       []Placeholder{},
+		},
+		{
+			key:        "messages::mixed",
+			wantFormat: "%[1]d ${file, 1} created on ${date:medium, 2} by {name}, ${file, 1} in total",
+			want: []Placeholder{
+				{Text: "${file, 1}", Kind: "plural", Noun: "file", Arg: "1"},
+				{Text: "${date:medium, 2}", Kind: "date", Style: "medium", Arg: "2"},
+				{Text: "{name}", Kind: "named", Arg: "name"},
+			},
+		},
+		{
+			key:        "messages::missing",
+			wantFormat: "${dog, 1} ${unknown:style, count}",
+			want: []Placeholder{
+				{Text: "${dog, 1}", Kind: "plural", Noun: "dog", Arg: "1"},
+				{Text: "${unknown:style, count}", Kind: "unknown", Style: "style", Arg: "count"},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.key, func(t *testing.T) {
+			m, ok := l.Message(test.key)
+			assert.True(t, ok)
+			assert.Equal(t, test.wantFormat, m.Format())
+			assert.Equal(t, test.want, m.Placeholders())
+		})
+	}
+}
+
+func TestCountArgs(t *testing.T) {
+	tests := []struct {
+		format string
+		want   int
+	}{
+		{format: "I have a dream", want: 0},
+		{format: "My name is %s", want: 1},
+		{format: "%d%% of %s", want: 2},
+		{format: "%-8s|%*d|%.2f", want: 4},
+		{format: "I have %[1]d ${dog, 1}", want: -1},
+	}
+	for _, test := range tests {
+		t.Run(test.format, func(t *testing.T) {
+			assert.Equal(t, test.want, countArgs(test.format))
+		})
+	}
+}
+
 func BenchmarkLocale_Translate(b *testing.B) {
 	l, err := NewStore().AddLocale(
 		"en-US",
@@ -0,0 +1,9 @@
+module unknwon.dev/i18n/i18ngrpc
+
+go 1.16
+
+require (
+	github.com/stretchr/testify v1.7.0
+	google.golang.org/grpc v1.40.0
+	unknwon.dev/i18n v0.0.0-20261019072204-c59c77c98a52
+)
@@ -0,0 +1,121 @@
+// Copyright 2026 Joe Chen. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+// Package i18ngrpc provides gRPC interceptors to propagate locales of callers
+// through metadata.
+package i18ngrpc
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"unknwon.dev/i18n"
+)
+
+// MetadataKey is the default metadata key of the language of the caller, which
+// has the same format as the "Accept-Language" HTTP header.
+const MetadataKey = "accept-language"
+
+func metadataKeys(keys []string) []string {
+	if len(keys) == 0 {
+		return []string{MetadataKey}
+	}
+	return keys
+}
+
+// resolveLocale returns the locale of the context resolved from the first
+// metadata key that has values, or nil if none of the keys presents.
+func resolveLocale(ctx context.Context, s *i18n.Store, keys []string) *i18n.Locale {
+	md, _ := metadata.FromIncomingContext(ctx)
+	for _, key := range keys {
+		if values := md.Get(key); len(values) > 0 {
+			return s.Match(strings.Join(values, ","))
+		}
+	}
+	return nil
+}
+
+// withLocale returns a copy of the context with the resolved locale attached.
+// Only the store is attached when the caller sent no language, so that the
+// default locale of the store is used by the handler but not forwarded to
+// outgoing RPCs.
+func withLocale(ctx context.Context, s *i18n.Store, keys []string) context.Context {
+	l := resolveLocale(ctx, s, keys)
+	if l == nil {
+		return i18n.WithStore(ctx, s)
+	}
+	return i18n.WithLocale(ctx, l)
+}
+
+// UnaryServerInterceptor returns a server interceptor for unary RPCs that
+// attaches the locale of the caller to the context (see i18n.LocaleFrom). The
+// locale is resolved from the store by values of the first metadata key that
+// presents, or the default locale of the store if none (which is not forwarded
+// by client interceptors). Metadata keys default to MetadataKey.
+func UnaryServerInterceptor(s *i18n.Store, keys ...string) grpc.UnaryServerInterceptor {
+	keys = metadataKeys(keys)
+	return func(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		return handler(withLocale(ctx, s, keys), req)
+	}
+}
+
+// serverStream is a grpc.ServerStream with the overridden context.
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
+
+// StreamServerInterceptor returns a server interceptor for streaming RPCs that
+// works in the same way as UnaryServerInterceptor.
+func StreamServerInterceptor(s *i18n.Store, keys ...string) grpc.StreamServerInterceptor {
+	keys = metadataKeys(keys)
+	return func(srv interface{}, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &serverStream{ServerStream: ss, ctx: withLocale(ss.Context(), s, keys)})
+	}
+}
+
+// outgoingContext returns a copy of the context with the language of the locale
+// attached to it (see i18n.LocaleFromContext) appended to outgoing metadata.
+// Default locales are not forwarded, so that the callee resolves its own. Keys
+// that are already set are not overwritten.
+func outgoingContext(ctx context.Context, keys []string) context.Context {
+	l, ok := i18n.LocaleFromContext(ctx)
+	if !ok {
+		return ctx
+	}
+
+	md, _ := metadata.FromOutgoingContext(ctx)
+	for _, key := range keys {
+		if len(md.Get(key)) == 0 {
+			ctx = metadata.AppendToOutgoingContext(ctx, key, l.Lang())
+		}
+	}
+	return ctx
+}
+
+// UnaryClientInterceptor returns a client interceptor for unary RPCs that
+// forwards the language of the locale attached to the context (see
+// i18n.WithLocale) through metadata. Metadata keys default to MetadataKey.
+func UnaryClientInterceptor(keys ...string) grpc.UnaryClientInterceptor {
+	keys = metadataKeys(keys)
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		return invoker(outgoingContext(ctx, keys), method, req, reply, cc, opts...)
+	}
+}
+
+// StreamClientInterceptor returns a client interceptor for streaming RPCs that
+// works in the same way as UnaryClientInterceptor.
+func StreamClientInterceptor(keys ...string) grpc.StreamClientInterceptor {
+	keys = metadataKeys(keys)
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		return streamer(outgoingContext(ctx, keys), desc, cc, method, opts...)
+	}
+}
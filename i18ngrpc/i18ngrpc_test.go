@@ -0,0 +1,209 @@
+// Copyright 2026 Joe Chen. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package i18ngrpc
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/test/bufconn"
+
+	"unknwon.dev/i18n"
+)
+
+// recorder records languages of locales attached to contexts of RPCs.
+type recorder struct {
+	langs chan string
+}
+
+func (r *recorder) record(ctx context.Context) {
+	lang := ""
+	if l := i18n.LocaleFrom(ctx); l != nil {
+		lang = l.Lang()
+	}
+	r.langs <- lang
+}
+
+func (r *recorder) unary(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	r.record(ctx)
+	return handler(ctx, req)
+}
+
+func (r *recorder) stream(srv interface{}, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	r.record(ss.Context())
+	return handler(srv, ss)
+}
+
+// newTestClient starts a server of the health service with interceptors, and
+// returns a client connected to it.
+func newTestClient(t *testing.T, s *i18n.Store, serverKeys []string, clientOpts ...grpc.DialOption) (healthpb.HealthClient, *recorder) {
+	r := &recorder{langs: make(chan string, 1)}
+	server := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(UnaryServerInterceptor(s, serverKeys...), r.unary),
+		grpc.ChainStreamInterceptor(StreamServerInterceptor(s, serverKeys...), r.stream),
+	)
+	healthpb.RegisterHealthServer(server, health.NewServer())
+
+	listener := bufconn.Listen(1024 * 1024)
+	go func() { _ = server.Serve(listener) }()
+	t.Cleanup(server.Stop)
+
+	opts := append([]grpc.DialOption{
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+			return listener.Dial()
+		}),
+		grpc.WithInsecure(),
+	}, clientOpts...)
+	conn, err := grpc.DialContext(context.Background(), "bufnet", opts...)
+	require.Nil(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+	return healthpb.NewHealthClient(conn), r
+}
+
+func newTestStore(t *testing.T) *i18n.Store {
+	s := i18n.NewStore()
+	_, err := s.AddLocale("en-US", "English", []byte(``))
+	require.Nil(t, err)
+	_, err = s.AddLocale("zh-CN", "简体中文", []byte(``))
+	require.Nil(t, err)
+	return s
+}
+
+func TestServerInterceptors(t *testing.T) {
+	s := newTestStore(t)
+	client, r := newTestClient(t, s, nil)
+
+	tests := []struct {
+		name string
+		md   metadata.MD
+		want string
+	}{
+		{
+			name: "accept-language",
+			md:   metadata.Pairs("accept-language", "zh-CN,zh;q=0.9,en;q=0.8"),
+			want: "zh-CN",
+		},
+		{
+			name: "no match",
+			md:   metadata.Pairs("accept-language", "fr-FR"),
+			want: "en-US",
+		},
+		{
+			name: "no metadata",
+			want: "en-US",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx := metadata.NewOutgoingContext(context.Background(), test.md)
+			_, err := client.Check(ctx, &healthpb.HealthCheckRequest{})
+			require.Nil(t, err)
+			assert.Equal(t, test.want, <-r.langs)
+
+			stream, err := client.Watch(ctx, &healthpb.HealthCheckRequest{})
+			require.Nil(t, err)
+			_, err = stream.Recv()
+			require.Nil(t, err)
+			assert.Equal(t, test.want, <-r.langs)
+		})
+	}
+
+	t.Run("custom metadata keys", func(t *testing.T) {
+		client, r := newTestClient(t, s, []string{"x-lang", "accept-language"})
+
+		md := metadata.Pairs("accept-language", "en-US", "X-Lang", "zh-CN")
+		ctx := metadata.NewOutgoingContext(context.Background(), md)
+		_, err := client.Check(ctx, &healthpb.HealthCheckRequest{})
+		require.Nil(t, err)
+		assert.Equal(t, "zh-CN", <-r.langs)
+	})
+}
+
+func TestClientInterceptors(t *testing.T) {
+	s := newTestStore(t)
+	zhCN, err := s.Locale("zh-CN")
+	require.Nil(t, err)
+
+	client, r := newTestClient(t, s, nil,
+		grpc.WithUnaryInterceptor(UnaryClientInterceptor()),
+		grpc.WithStreamInterceptor(StreamClientInterceptor()),
+	)
+
+	ctx := i18n.WithLocale(context.Background(), zhCN)
+	_, err = client.Check(ctx, &healthpb.HealthCheckRequest{})
+	require.Nil(t, err)
+	assert.Equal(t, "zh-CN", <-r.langs)
+
+	stream, err := client.Watch(ctx, &healthpb.HealthCheckRequest{})
+	require.Nil(t, err)
+	_, err = stream.Recv()
+	require.Nil(t, err)
+	assert.Equal(t, "zh-CN", <-r.langs)
+
+	t.Run("explicit metadata", func(t *testing.T) {
+		ctx := metadata.AppendToOutgoingContext(ctx, "accept-language", "en-US")
+		_, err = client.Check(ctx, &healthpb.HealthCheckRequest{})
+		require.Nil(t, err)
+		assert.Equal(t, "en-US", <-r.langs)
+	})
+
+	t.Run("no locale", func(t *testing.T) {
+		_, err = client.Check(context.Background(), &healthpb.HealthCheckRequest{})
+		require.Nil(t, err)
+		assert.Equal(t, "en-US", <-r.langs)
+	})
+
+	t.Run("default locale", func(t *testing.T) {
+		other := i18n.NewStore()
+		_, err := other.AddLocale("zh-CN", "简体中文", []byte(``))
+		require.Nil(t, err)
+		i18n.SetDefaultStore(other)
+		defer i18n.SetDefaultStore(nil)
+
+		// The default locale of the caller is not forwarded.
+		_, err = client.Check(context.Background(), &healthpb.HealthCheckRequest{})
+		require.Nil(t, err)
+		assert.Equal(t, "en-US", <-r.langs)
+	})
+}
+
+func TestServerToClient(t *testing.T) {
+	s := newTestStore(t)
+
+	// hop returns outgoing metadata of a call made by the handler of an RPC
+	// received with the incoming metadata.
+	hop := func(md metadata.MD) (string, metadata.MD) {
+		var lang string
+		var outgoing metadata.MD
+		ctx := metadata.NewIncomingContext(context.Background(), md)
+		_, err := UnaryServerInterceptor(s)(ctx, nil, &grpc.UnaryServerInfo{}, func(ctx context.Context, _ interface{}) (interface{}, error) {
+			lang = i18n.LocaleFrom(ctx).Lang()
+			invoker := func(ctx context.Context, _ string, _, _ interface{}, _ *grpc.ClientConn, _ ...grpc.CallOption) error {
+				outgoing, _ = metadata.FromOutgoingContext(ctx)
+				return nil
+			}
+			return nil, UnaryClientInterceptor()(ctx, "/test", nil, nil, nil, invoker)
+		})
+		require.Nil(t, err)
+		return lang, outgoing
+	}
+
+	lang, outgoing := hop(metadata.Pairs("accept-language", "zh-CN"))
+	assert.Equal(t, "zh-CN", lang)
+	assert.Equal(t, []string{"zh-CN"}, outgoing.Get(MetadataKey))
+
+	t.Run("no metadata", func(t *testing.T) {
+		lang, outgoing := hop(nil)
+		assert.Equal(t, "en-US", lang)
+		assert.Empty(t, outgoing.Get(MetadataKey))
+	})
+}
@@ -0,0 +1,75 @@
+// Copyright 2026 Joe Chen. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package cldr
+
+import (
+	"golang.org/x/text/language"
+
+	"unknwon.dev/i18n/internal/plural"
+)
+
+// Locale contains the CLDR data of a locale.
+type Locale struct {
+	Calendar *Calendar
+	Numbers  *Numbers
+	// The relative time data keyed by "<unit>" for the long width and
+	// "<unit>-<width>" for others, e.g. "day" and "day-short".
+	RelativeTimes map[string]*RelativeTime
+	// The list patterns keyed by "<type>" for the long width and
+	// "<type>-<width>" for others, e.g. "standard" and "or-short".
+	ListPatterns map[string]*ListPattern
+	// The unit patterns by plural forms keyed by the width and then the unit,
+	// e.g. "short" and "digital-megabyte".
+	Units map[string]map[string]map[plural.Form]string
+}
+
+// Styles is a set of patterns for the four CLDR format styles.
+type Styles struct {
+	Full   string
+	Long   string
+	Medium string
+	Short  string
+}
+
+// Get returns the pattern of the given style name, i.e. one of "full", "long",
+// "medium" and "short". It returns false if the style name is unknown.
+func (s Styles) Get(style string) (string, bool) {
+	switch style {
+	case "full":
+		return s.Full, true
+	case "long":
+		return s.Long, true
+	case "medium":
+		return s.Medium, true
+	case "short":
+		return s.Short, true
+	}
+	return "", false
+}
+
+// fallbackLocale is the locale to use when no data is available for a
+// language.
+const fallbackLocale = "en"
+
+// Lookup returns the closest matching locale data for the language tag. It
+// falls back to English when no data could be found.
+func Lookup(tag language.Tag) *Locale {
+	t := tag
+	for {
+		if l := locales[t.String()]; l != nil {
+			return l
+		}
+		t = t.Parent()
+		if t.IsRoot() {
+			break
+		}
+	}
+
+	base, _ := tag.Base()
+	if l := locales[base.String()]; l != nil {
+		return l
+	}
+	return locales[fallbackLocale]
+}
@@ -0,0 +1,64 @@
+// Copyright 2026 Joe Chen. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package cldr
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+)
+
+func TestLookup(t *testing.T) {
+	tests := []struct {
+		name string
+		tag  language.Tag
+		want *Locale
+	}{
+		{
+			name: "exact match",
+			tag:  language.German,
+			want: locales["de"],
+		},
+		{
+			name: "inexact match",
+			tag:  language.AmericanEnglish,
+			want: locales["en"],
+		},
+		{
+			name: "zh-Hans",
+			tag:  language.SimplifiedChinese,
+			want: locales["zh"],
+		},
+		{
+			name: "pt-PT",
+			tag:  language.EuropeanPortuguese,
+			want: locales["pt"],
+		},
+		{
+			name: "fallback",
+			tag:  language.Swahili,
+			want: locales["en"],
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := Lookup(test.tag)
+			assert.Equal(t, test.want, got)
+		})
+	}
+}
+
+func TestStyles_Get(t *testing.T) {
+	s := Styles{Full: "a", Long: "b", Medium: "c", Short: "d"}
+	for style, want := range map[string]string{"full": "a", "long": "b", "medium": "c", "short": "d"} {
+		got, ok := s.Get(style)
+		assert.True(t, ok)
+		assert.Equal(t, want, got)
+	}
+
+	_, ok := s.Get("yMMMd")
+	assert.False(t, ok)
+}
@@ -0,0 +1,6 @@
+#!/bin/sh
+OUT=..
+go build -o codegen &&
+  ./codegen -cout $OUT/data_gen.go && \
+  gofmt -w=true $OUT/data_gen.go && \
+  rm codegen
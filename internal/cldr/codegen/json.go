@@ -0,0 +1,433 @@
+// Copyright 2026 Joe Chen. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Styles is the set of patterns for the four CLDR format styles.
+type Styles struct {
+	Full   string `json:"full"`
+	Long   string `json:"long"`
+	Medium string `json:"medium"`
+	Short  string `json:"short"`
+}
+
+// nameWidths is the names of a calendar field in different widths.
+type nameWidths struct {
+	Abbreviated map[string]string `json:"abbreviated"`
+	Wide        map[string]string `json:"wide"`
+}
+
+// Gregorian is the Gregorian calendar data in ca-gregorian.json.
+type Gregorian struct {
+	Months struct {
+		Format     nameWidths `json:"format"`
+		StandAlone nameWidths `json:"stand-alone"`
+	} `json:"months"`
+	Days struct {
+		Format     nameWidths `json:"format"`
+		StandAlone nameWidths `json:"stand-alone"`
+	} `json:"days"`
+	DayPeriods struct {
+		Format struct {
+			Abbreviated map[string]string `json:"abbreviated"`
+		} `json:"format"`
+	} `json:"dayPeriods"`
+	Eras struct {
+		Names       map[string]string `json:"eraNames"`
+		Abbreviated map[string]string `json:"eraAbbr"`
+		Narrow      map[string]string `json:"eraNarrow"`
+	} `json:"eras"`
+	DateFormats     Styles `json:"dateFormats"`
+	TimeFormats     Styles `json:"timeFormats"`
+	DateTimeFormats struct {
+		Styles
+		AvailableFormats map[string]string `json:"availableFormats"`
+	} `json:"dateTimeFormats"`
+}
+
+var dayKeys = []string{"sun", "mon", "tue", "wed", "thu", "fri", "sat"}
+
+// MonthsAbbreviated returns abbreviated month names in the order of months.
+func (g *Gregorian) MonthsAbbreviated() []string {
+	return monthList(g.Months.Format.Abbreviated)
+}
+
+// MonthsWide returns wide month names in the order of months.
+func (g *Gregorian) MonthsWide() []string {
+	return monthList(g.Months.Format.Wide)
+}
+
+// MonthsStandAloneAbbreviated returns abbreviated stand-alone month names in
+// the order of months.
+func (g *Gregorian) MonthsStandAloneAbbreviated() []string {
+	return monthList(standAlone(g.Months.StandAlone.Abbreviated, g.Months.Format.Abbreviated))
+}
+
+// MonthsStandAloneWide returns wide stand-alone month names in the order of
+// months.
+func (g *Gregorian) MonthsStandAloneWide() []string {
+	return monthList(standAlone(g.Months.StandAlone.Wide, g.Months.Format.Wide))
+}
+
+func monthList(months map[string]string) []string {
+	list := make([]string, 12)
+	for i := range list {
+		list[i] = months[fmt.Sprint(i+1)]
+	}
+	return list
+}
+
+// DaysAbbreviated returns abbreviated day names starting from Sunday.
+func (g *Gregorian) DaysAbbreviated() []string {
+	return dayList(g.Days.Format.Abbreviated)
+}
+
+// DaysWide returns wide day names starting from Sunday.
+func (g *Gregorian) DaysWide() []string {
+	return dayList(g.Days.Format.Wide)
+}
+
+// DaysStandAloneAbbreviated returns abbreviated stand-alone day names starting
+// from Sunday.
+func (g *Gregorian) DaysStandAloneAbbreviated() []string {
+	return dayList(standAlone(g.Days.StandAlone.Abbreviated, g.Days.Format.Abbreviated))
+}
+
+// DaysStandAloneWide returns wide stand-alone day names starting from Sunday.
+func (g *Gregorian) DaysStandAloneWide() []string {
+	return dayList(standAlone(g.Days.StandAlone.Wide, g.Days.Format.Wide))
+}
+
+func dayList(days map[string]string) []string {
+	list := make([]string, len(dayKeys))
+	for i, key := range dayKeys {
+		list[i] = days[key]
+	}
+	return list
+}
+
+// standAlone returns the stand-alone names, or the format names if the
+// stand-alone context is absent.
+func standAlone(names, format map[string]string) map[string]string {
+	if len(names) == 0 {
+		return format
+	}
+	return names
+}
+
+// Periods returns the names of AM and PM.
+func (g *Gregorian) Periods() []string {
+	return []string{
+		g.DayPeriods.Format.Abbreviated["am"],
+		g.DayPeriods.Format.Abbreviated["pm"],
+	}
+}
+
+// ErasAbbreviated returns abbreviated names of BC and AD.
+func (g *Gregorian) ErasAbbreviated() []string {
+	return eraList(g.Eras.Abbreviated)
+}
+
+// ErasWide returns wide names of BC and AD.
+func (g *Gregorian) ErasWide() []string {
+	return eraList(g.Eras.Names)
+}
+
+// ErasNarrow returns narrow names of BC and AD.
+func (g *Gregorian) ErasNarrow() []string {
+	return eraList(g.Eras.Narrow)
+}
+
+func eraList(eras map[string]string) []string {
+	return []string{eras["0"], eras["1"]}
+}
+
+// Numbers is the number formatting data in numbers.json.
+type Numbers struct {
+	MinimumGroupingDigits string `json:"minimumGroupingDigits"`
+	Symbols               struct {
+		Decimal   string `json:"decimal"`
+		Group     string `json:"group"`
+		MinusSign string `json:"minusSign"`
+	} `json:"symbols-numberSystem-latn"`
+	DecimalFormats map[string]struct {
+		DecimalFormat map[string]string `json:"decimalFormat"`
+	} `json:"decimalFormats-numberSystem-latn"`
+}
+
+// CompactPattern is the compact decimal patterns of an exponent of the power
+// of 10 in numbers.json.
+type CompactPattern struct {
+	Exponent int
+	Patterns map[string]string
+}
+
+// compactWidth returns the compact decimal patterns of the width, i.e. "short"
+// or "long", ordered by exponents.
+func (n *Numbers) compactWidth(width string) ([]*CompactPattern, error) {
+	exponents := make(map[int]*CompactPattern)
+	for key, pattern := range n.DecimalFormats[width].DecimalFormat {
+		fields := strings.SplitN(key, "-count-", 2)
+		if len(fields) != 2 || strings.Trim(fields[0], "0") != "1" {
+			return nil, errors.Errorf("unexpected key %q", key)
+		}
+
+		exponent := len(fields[0]) - 1
+		cp, ok := exponents[exponent]
+		if !ok {
+			cp = &CompactPattern{
+				Exponent: exponent,
+				Patterns: make(map[string]string),
+			}
+			exponents[exponent] = cp
+		}
+		cp.Patterns[fields[1]] = pattern
+	}
+
+	list := make([]*CompactPattern, 0, len(exponents))
+	for _, cp := range exponents {
+		list = append(list, cp)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Exponent < list[j].Exponent
+	})
+	return list, nil
+}
+
+// Compact returns the compact decimal patterns of both "short" and "long"
+// widths.
+func (n *Numbers) Compact() (map[string][]*CompactPattern, error) {
+	compact := make(map[string][]*CompactPattern, 2)
+	for _, width := range []string{"short", "long"} {
+		list, err := n.compactWidth(width)
+		if err != nil {
+			return nil, errors.Wrapf(err, "width %q", width)
+		}
+		compact[width] = list
+	}
+	return compact, nil
+}
+
+// RelativeTime is the relative time data of a field in dateFields.json.
+type RelativeTime struct {
+	Name     string
+	Relative map[int]string
+	Future   map[string]string
+	Past     map[string]string
+}
+
+const (
+	relativeTypePrefix  = "relative-type-"
+	relativeFutureKey   = "relativeTime-type-future"
+	relativePastKey     = "relativeTime-type-past"
+	relativeCountPrefix = "relativeTimePattern-count-"
+)
+
+func newRelativeTime(name string, raw map[string]json.RawMessage) (*RelativeTime, error) {
+	rt := &RelativeTime{
+		Name:     name,
+		Relative: make(map[int]string),
+		Future:   make(map[string]string),
+		Past:     make(map[string]string),
+	}
+	for key, value := range raw {
+		switch {
+		case strings.HasPrefix(key, relativeTypePrefix):
+			offset, err := strconv.Atoi(strings.TrimPrefix(key, relativeTypePrefix))
+			if err != nil {
+				return nil, errors.Wrapf(err, "parse offset of %q", key)
+			}
+			var s string
+			if err = json.Unmarshal(value, &s); err != nil {
+				return nil, errors.Wrapf(err, "unmarshal %q", key)
+			}
+			rt.Relative[offset] = s
+
+		case key == relativeFutureKey, key == relativePastKey:
+			var patterns map[string]string
+			if err := json.Unmarshal(value, &patterns); err != nil {
+				return nil, errors.Wrapf(err, "unmarshal %q", key)
+			}
+			dst := rt.Future
+			if key == relativePastKey {
+				dst = rt.Past
+			}
+			for count, pattern := range patterns {
+				dst[strings.TrimPrefix(count, relativeCountPrefix)] = pattern
+			}
+		}
+	}
+	return rt, nil
+}
+
+// ListPattern is a list pattern in listPatterns.json.
+type ListPattern struct {
+	Name   string
+	Start  string `json:"start"`
+	Middle string `json:"middle"`
+	End    string `json:"end"`
+	Two    string `json:"2"`
+}
+
+const listPatternPrefix = "listPattern-type-"
+
+// Unit is the patterns of a unit in units.json.
+type Unit struct {
+	Name     string
+	Patterns map[string]string
+}
+
+// UnitWidth is the list of units in a width in units.json.
+type UnitWidth struct {
+	Name  string
+	Units []*Unit
+}
+
+const unitPatternPrefix = "unitPattern-count-"
+
+// Locale is the collection of CLDR data of a locale.
+type Locale struct {
+	Name          string
+	Gregorian     *Gregorian
+	Numbers       *Numbers
+	RelativeTimes []*RelativeTime
+	ListPatterns  []*ListPattern
+	UnitWidths    []*UnitWidth
+}
+
+// loadLocale loads CLDR data of the named locale from the directory that has
+// the same layout as the "main" directory of the cldr-json packages.
+func loadLocale(dir, name string) (*Locale, error) {
+	var gregorianFile struct {
+		Main map[string]struct {
+			Dates struct {
+				Calendars struct {
+					Gregorian *Gregorian `json:"gregorian"`
+				} `json:"calendars"`
+			} `json:"dates"`
+		} `json:"main"`
+	}
+	err := unmarshalFile(filepath.Join(dir, name, "ca-gregorian.json"), &gregorianFile)
+	if err != nil {
+		return nil, err
+	}
+
+	var numbersFile struct {
+		Main map[string]struct {
+			Numbers *Numbers `json:"numbers"`
+		} `json:"main"`
+	}
+	err = unmarshalFile(filepath.Join(dir, name, "numbers.json"), &numbersFile)
+	if err != nil {
+		return nil, err
+	}
+
+	var dateFieldsFile struct {
+		Main map[string]struct {
+			Dates struct {
+				Fields map[string]map[string]json.RawMessage `json:"fields"`
+			} `json:"dates"`
+		} `json:"main"`
+	}
+	err = unmarshalFile(filepath.Join(dir, name, "dateFields.json"), &dateFieldsFile)
+	if err != nil {
+		return nil, err
+	}
+
+	var listPatternsFile struct {
+		Main map[string]struct {
+			ListPatterns map[string]*ListPattern `json:"listPatterns"`
+		} `json:"main"`
+	}
+	err = unmarshalFile(filepath.Join(dir, name, "listPatterns.json"), &listPatternsFile)
+	if err != nil {
+		return nil, err
+	}
+
+	var unitsFile struct {
+		Main map[string]struct {
+			Units map[string]map[string]map[string]string `json:"units"`
+		} `json:"main"`
+	}
+	err = unmarshalFile(filepath.Join(dir, name, "units.json"), &unitsFile)
+	if err != nil {
+		return nil, err
+	}
+
+	l := &Locale{
+		Name:      name,
+		Gregorian: gregorianFile.Main[name].Dates.Calendars.Gregorian,
+		Numbers:   numbersFile.Main[name].Numbers,
+	}
+	if l.Gregorian == nil {
+		return nil, errors.Errorf("no Gregorian calendar found for %q", name)
+	} else if len(l.Gregorian.Eras.Names) == 0 || len(l.Gregorian.Eras.Abbreviated) == 0 || len(l.Gregorian.Eras.Narrow) == 0 {
+		return nil, errors.Errorf("no eras found for %q", name)
+	} else if l.Numbers == nil {
+		return nil, errors.Errorf("no numbers found for %q", name)
+	}
+
+	for field, raw := range dateFieldsFile.Main[name].Dates.Fields {
+		rt, err := newRelativeTime(field, raw)
+		if err != nil {
+			return nil, errors.Wrapf(err, "field %q", field)
+		}
+		l.RelativeTimes = append(l.RelativeTimes, rt)
+	}
+	sort.Slice(l.RelativeTimes, func(i, j int) bool {
+		return l.RelativeTimes[i].Name < l.RelativeTimes[j].Name
+	})
+
+	for key, lp := range listPatternsFile.Main[name].ListPatterns {
+		lp.Name = strings.TrimPrefix(key, listPatternPrefix)
+		l.ListPatterns = append(l.ListPatterns, lp)
+	}
+	sort.Slice(l.ListPatterns, func(i, j int) bool {
+		return l.ListPatterns[i].Name < l.ListPatterns[j].Name
+	})
+
+	for width, units := range unitsFile.Main[name].Units {
+		uw := &UnitWidth{Name: width}
+		for unit, raw := range units {
+			u := &Unit{
+				Name:     unit,
+				Patterns: make(map[string]string),
+			}
+			for key, pattern := range raw {
+				if strings.HasPrefix(key, unitPatternPrefix) {
+					u.Patterns[strings.TrimPrefix(key, unitPatternPrefix)] = pattern
+				}
+			}
+			uw.Units = append(uw.Units, u)
+		}
+		sort.Slice(uw.Units, func(i, j int) bool {
+			return uw.Units[i].Name < uw.Units[j].Name
+		})
+		l.UnitWidths = append(l.UnitWidths, uw)
+	}
+	sort.Slice(l.UnitWidths, func(i, j int) bool {
+		return l.UnitWidths[i].Name < l.UnitWidths[j].Name
+	})
+	return l, nil
+}
+
+func unmarshalFile(name string, v interface{}) error {
+	buf, err := ioutil.ReadFile(name)
+	if err != nil {
+		return errors.Wrap(err, "read file")
+	}
+	return errors.Wrapf(json.Unmarshal(buf, v), "unmarshal %q", name)
+}
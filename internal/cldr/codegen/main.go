@@ -0,0 +1,142 @@
+// Copyright 2026 Joe Chen. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sort"
+	"text/template"
+)
+
+var usage = `%[1]s generates Go code to support formatting with CLDR locale data.
+
+Usage: %[1]s [options]
+
+Options:
+
+`
+
+func main() {
+	flag.Usage = func() {
+		_, _ = fmt.Fprintf(os.Stderr, usage, os.Args[0])
+		flag.PrintDefaults()
+	}
+	var in, cout string
+	flag.StringVar(&in, "i", "data/main", "the input directory containing CLDR JSON data of locales")
+	flag.StringVar(&cout, "cout", "", "the code output file")
+	flag.Parse()
+
+	infos, err := ioutil.ReadDir(in)
+	if err != nil {
+		fatalf("failed to read directory: %s", err)
+	}
+
+	var locales []*Locale
+	for _, info := range infos {
+		if !info.IsDir() {
+			continue
+		}
+
+		l, err := loadLocale(in, info.Name())
+		if err != nil {
+			fatalf("failed to load locale %q: %s", info.Name(), err)
+		}
+		locales = append(locales, l)
+	}
+	sort.Slice(locales, func(i, j int) bool {
+		return locales[i].Name < locales[j].Name
+	})
+	infof("parsed %d locales", len(locales))
+
+	if cout != "" {
+		file := openWritableFile(cout)
+		if err := codeTemplate.Execute(file, locales); err != nil {
+			fatalf("unable to execute code template because %s", err)
+		} else {
+			infof("generated %s", cout)
+		}
+	} else {
+		infof("not generating code file (use -cout)")
+	}
+}
+
+func openWritableFile(name string) *os.File {
+	file, err := os.OpenFile(name, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0644)
+	if err != nil {
+		fatalf("failed to write file %s because %s", name, err)
+	}
+	return file
+}
+
+var codeTemplate = template.Must(template.New("cldr").Parse(`
+// Copyright 2026 Joe Chen. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+//
+// This file is generated by codegen/generate.sh; DO NOT EDIT.
+
+package cldr
+
+import "unknwon.dev/i18n/internal/plural"
+
+var locales = map[string]*Locale{ {{range .}}
+	{{printf "%q" .Name}}: {
+		Calendar: &Calendar{ {{with .Gregorian}}
+			MonthsAbbreviated: [12]string{ {{range .MonthsAbbreviated}}{{printf "%q" .}}, {{end}} },
+			MonthsWide: [12]string{ {{range .MonthsWide}}{{printf "%q" .}}, {{end}} },
+			DaysAbbreviated: [7]string{ {{range .DaysAbbreviated}}{{printf "%q" .}}, {{end}} },
+			DaysWide: [7]string{ {{range .DaysWide}}{{printf "%q" .}}, {{end}} },
+			MonthsStandAloneAbbreviated: [12]string{ {{range .MonthsStandAloneAbbreviated}}{{printf "%q" .}}, {{end}} },
+			MonthsStandAloneWide: [12]string{ {{range .MonthsStandAloneWide}}{{printf "%q" .}}, {{end}} },
+			DaysStandAloneAbbreviated: [7]string{ {{range .DaysStandAloneAbbreviated}}{{printf "%q" .}}, {{end}} },
+			DaysStandAloneWide: [7]string{ {{range .DaysStandAloneWide}}{{printf "%q" .}}, {{end}} },
+			DayPeriods: [2]string{ {{range .Periods}}{{printf "%q" .}}, {{end}} },
+			ErasAbbreviated: [2]string{ {{range .ErasAbbreviated}}{{printf "%q" .}}, {{end}} },
+			ErasWide: [2]string{ {{range .ErasWide}}{{printf "%q" .}}, {{end}} },
+			ErasNarrow: [2]string{ {{range .ErasNarrow}}{{printf "%q" .}}, {{end}} },
+			DateFormats: Styles{ {{with .DateFormats}}Full: {{printf "%q" .Full}}, Long: {{printf "%q" .Long}}, Medium: {{printf "%q" .Medium}}, Short: {{printf "%q" .Short}}{{end}} },
+			TimeFormats: Styles{ {{with .TimeFormats}}Full: {{printf "%q" .Full}}, Long: {{printf "%q" .Long}}, Medium: {{printf "%q" .Medium}}, Short: {{printf "%q" .Short}}{{end}} },
+			DateTimeFormats: Styles{ {{with .DateTimeFormats}}Full: {{printf "%q" .Full}}, Long: {{printf "%q" .Long}}, Medium: {{printf "%q" .Medium}}, Short: {{printf "%q" .Short}}{{end}} },
+			AvailableFormats: {{printf "%#v" .DateTimeFormats.AvailableFormats}},
+		{{end}} },
+		Numbers: &Numbers{ {{with .Numbers}}
+			Symbols: Symbols{ {{with .Symbols}}Decimal: {{printf "%q" .Decimal}}, Group: {{printf "%q" .Group}}, MinusSign: {{printf "%q" .MinusSign}}{{end}} },
+			MinimumGroupingDigits: {{.MinimumGroupingDigits}},
+			Compact: map[string]map[int]map[plural.Form]string{ {{range $width, $list := .Compact}}
+				{{printf "%q" $width}}: { {{range $list}}
+					{{.Exponent}}: { {{range $k, $v := .Patterns}}{{printf "%q" $k}}: {{printf "%q" $v}}, {{end}} },{{end}}
+				},{{end}}
+			},
+		{{end}} },
+		RelativeTimes: map[string]*RelativeTime{ {{range .RelativeTimes}}
+			{{printf "%q" .Name}}: {
+				Relative: {{printf "%#v" .Relative}},
+				Future: map[plural.Form]string{ {{range $k, $v := .Future}}{{printf "%q" $k}}: {{printf "%q" $v}}, {{end}} },
+				Past: map[plural.Form]string{ {{range $k, $v := .Past}}{{printf "%q" $k}}: {{printf "%q" $v}}, {{end}} },
+			},{{end}}
+		},
+		ListPatterns: map[string]*ListPattern{ {{range .ListPatterns}}
+			{{printf "%q" .Name}}: {Start: {{printf "%q" .Start}}, Middle: {{printf "%q" .Middle}}, End: {{printf "%q" .End}}, Two: {{printf "%q" .Two}}},{{end}}
+		},
+		Units: map[string]map[string]map[plural.Form]string{ {{range .UnitWidths}}
+			{{printf "%q" .Name}}: { {{range .Units}}
+				{{printf "%q" .Name}}: { {{range $k, $v := .Patterns}}{{printf "%q" $k}}: {{printf "%q" $v}}, {{end}} },{{end}}
+			},{{end}}
+		},
+	},{{end}}
+}
+`))
+
+func infof(format string, args ...interface{}) {
+	_, _ = fmt.Fprintf(os.Stderr, format+"\n", args...)
+}
+
+func fatalf(format string, args ...interface{}) {
+	infof("fatal: "+format+"\n", args...)
+	os.Exit(1)
+}
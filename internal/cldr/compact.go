@@ -0,0 +1,21 @@
+// Copyright 2026 Joe Chen. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package cldr
+
+import (
+	"unknwon.dev/i18n/internal/plural"
+)
+
+// CompactPatterns returns the compact decimal patterns for numbers of the
+// exponent (e.g. 6 for millions) in the width (i.e. "short" or "long") by
+// plural forms, see https://unicode.org/reports/tr35/tr35-numbers.html#Compact_Number_Formats.
+// In every pattern, the run of "0"s is the placeholder of the number, and its
+// length is the number of integer digits to show, e.g. "00K" for 10000 is
+// "10K". A pattern of "0" means the number is not compacted. Patterns may also
+// be keyed by an explicit number (e.g. "1") instead of a plural form. It
+// returns nil if no data is found.
+func (n *Numbers) CompactPatterns(width string, exponent int) map[plural.Form]string {
+	return n.Compact[width][exponent]
+}
@@ -0,0 +1,2862 @@
+// Copyright 2026 Joe Chen. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+//
+// This file is generated by codegen/generate.sh; DO NOT EDIT.
+
+package cldr
+
+import "unknwon.dev/i18n/internal/plural"
+
+var locales = map[string]*Locale{
+	"de": {
+		Calendar: &Calendar{
+			MonthsAbbreviated:           [12]string{"Jan.", "Feb.", "März", "Apr.", "Mai", "Juni", "Juli", "Aug.", "Sept.", "Okt.", "Nov.", "Dez."},
+			MonthsWide:                  [12]string{"Januar", "Februar", "März", "April", "Mai", "Juni", "Juli", "August", "September", "Oktober", "November", "Dezember"},
+			DaysAbbreviated:             [7]string{"So.", "Mo.", "Di.", "Mi.", "Do.", "Fr.", "Sa."},
+			DaysWide:                    [7]string{"Sonntag", "Montag", "Dienstag", "Mittwoch", "Donnerstag", "Freitag", "Samstag"},
+			MonthsStandAloneAbbreviated: [12]string{"Jan.", "Feb.", "März", "Apr.", "Mai", "Juni", "Juli", "Aug.", "Sept.", "Okt.", "Nov.", "Dez."},
+			MonthsStandAloneWide:        [12]string{"Januar", "Februar", "März", "April", "Mai", "Juni", "Juli", "August", "September", "Oktober", "November", "Dezember"},
+			DaysStandAloneAbbreviated:   [7]string{"So.", "Mo.", "Di.", "Mi.", "Do.", "Fr.", "Sa."},
+			DaysStandAloneWide:          [7]string{"Sonntag", "Montag", "Dienstag", "Mittwoch", "Donnerstag", "Freitag", "Samstag"},
+			DayPeriods:                  [2]string{"AM", "PM"},
+			ErasAbbreviated:             [2]string{"v. Chr.", "n. Chr."},
+			ErasWide:                    [2]string{"v. Chr.", "n. Chr."},
+			ErasNarrow:                  [2]string{"v. Chr.", "n. Chr."},
+			DateFormats:                 Styles{Full: "EEEE, d. MMMM y", Long: "d. MMMM y", Medium: "dd.MM.y", Short: "dd.MM.yy"},
+			TimeFormats:                 Styles{Full: "HH:mm:ss zzzz", Long: "HH:mm:ss z", Medium: "HH:mm:ss", Short: "HH:mm"},
+			DateTimeFormats:             Styles{Full: "{1} 'um' {0}", Long: "{1} 'um' {0}", Medium: "{1}, {0}", Short: "{1}, {0}"},
+			AvailableFormats:            map[string]string{"E": "E", "EEEEd": "EEEE, d.", "Ed": "E, d.", "H": "HH 'Uhr'", "Hm": "HH:mm", "Hms": "HH:mm:ss", "M": "M", "MEd": "E, d.M.", "MMM": "MMM", "MMMEd": "E, d. MMM", "MMMM": "MMMM", "MMMMd": "d. MMMM", "MMMd": "d. MMM", "Md": "d.M.", "d": "d", "h": "h 'Uhr' a", "hm": "h:mm a", "hms": "h:mm:ss a", "ms": "mm:ss", "y": "y", "yM": "M/y", "yMEd": "E, d.M.y", "yMMM": "MMM y", "yMMMEd": "E, d. MMM y", "yMMMM": "MMMM y", "yMMMMEEEEd": "EEEE, d. MMMM y", "yMMMMd": "d. MMMM y", "yMMMd": "d. MMM y", "yMd": "d.M.y"},
+		},
+		Numbers: &Numbers{
+			Symbols:               Symbols{Decimal: ",", Group: ".", MinusSign: "-"},
+			MinimumGroupingDigits: 1,
+			Compact: map[string]map[int]map[plural.Form]string{
+				"long": {
+					3:  {"one": "0 Tausend", "other": "0 Tausend"},
+					4:  {"other": "00 Tausend"},
+					5:  {"other": "000 Tausend"},
+					6:  {"one": "0 Million", "other": "0 Millionen"},
+					7:  {"other": "00 Millionen"},
+					8:  {"other": "000 Millionen"},
+					9:  {"one": "0 Milliarde", "other": "0 Milliarden"},
+					10: {"other": "00 Milliarden"},
+					11: {"other": "000 Milliarden"},
+					12: {"one": "0 Billion", "other": "0 Billionen"},
+					13: {"other": "00 Billionen"},
+					14: {"other": "000 Billionen"},
+				},
+				"short": {
+					3:  {"other": "0"},
+					4:  {"other": "0"},
+					5:  {"other": "0"},
+					6:  {"one": "0 Mio.", "other": "0 Mio."},
+					7:  {"other": "00 Mio."},
+					8:  {"other": "000 Mio."},
+					9:  {"one": "0 Mrd.", "other": "0 Mrd."},
+					10: {"other": "00 Mrd."},
+					11: {"other": "000 Mrd."},
+					12: {"one": "0 Bio.", "other": "0 Bio."},
+					13: {"other": "00 Bio."},
+					14: {"other": "000 Bio."},
+				},
+			},
+		},
+		RelativeTimes: map[string]*RelativeTime{
+			"day": {
+				Relative: map[int]string{-2: "vorgestern", -1: "gestern", 0: "heute", 1: "morgen", 2: "übermorgen"},
+				Future:   map[plural.Form]string{"one": "in {0} Tag", "other": "in {0} Tagen"},
+				Past:     map[plural.Form]string{"one": "vor {0} Tag", "other": "vor {0} Tagen"},
+			},
+			"day-narrow": {
+				Relative: map[int]string{-2: "vorgestern", -1: "gestern", 0: "heute", 1: "morgen", 2: "übermorgen"},
+				Future:   map[plural.Form]string{"one": "in {0} Tag", "other": "in {0} Tagen"},
+				Past:     map[plural.Form]string{"one": "vor {0} Tag", "other": "vor {0} Tagen"},
+			},
+			"day-short": {
+				Relative: map[int]string{-2: "vorgestern", -1: "gestern", 0: "heute", 1: "morgen", 2: "übermorgen"},
+				Future:   map[plural.Form]string{"one": "in {0} Tag", "other": "in {0} Tagen"},
+				Past:     map[plural.Form]string{"one": "vor {0} Tag", "other": "vor {0} Tagen"},
+			},
+			"hour": {
+				Relative: map[int]string{0: "in dieser Stunde"},
+				Future:   map[plural.Form]string{"one": "in {0} Stunde", "other": "in {0} Stunden"},
+				Past:     map[plural.Form]string{"one": "vor {0} Stunde", "other": "vor {0} Stunden"},
+			},
+			"hour-narrow": {
+				Relative: map[int]string{0: "in dieser Stunde"},
+				Future:   map[plural.Form]string{"one": "in {0} Std.", "other": "in {0} Std."},
+				Past:     map[plural.Form]string{"one": "vor {0} Std.", "other": "vor {0} Std."},
+			},
+			"hour-short": {
+				Relative: map[int]string{0: "in dieser Stunde"},
+				Future:   map[plural.Form]string{"one": "in {0} Std.", "other": "in {0} Std."},
+				Past:     map[plural.Form]string{"one": "vor {0} Std.", "other": "vor {0} Std."},
+			},
+			"minute": {
+				Relative: map[int]string{0: "in dieser Minute"},
+				Future:   map[plural.Form]string{"one": "in {0} Minute", "other": "in {0} Minuten"},
+				Past:     map[plural.Form]string{"one": "vor {0} Minute", "other": "vor {0} Minuten"},
+			},
+			"minute-narrow": {
+				Relative: map[int]string{0: "in dieser Minute"},
+				Future:   map[plural.Form]string{"one": "in {0} m", "other": "in {0} m"},
+				Past:     map[plural.Form]string{"one": "vor {0} m", "other": "vor {0} m"},
+			},
+			"minute-short": {
+				Relative: map[int]string{0: "in dieser Minute"},
+				Future:   map[plural.Form]string{"one": "in {0} Min.", "other": "in {0} Min."},
+				Past:     map[plural.Form]string{"one": "vor {0} Min.", "other": "vor {0} Min."},
+			},
+			"month": {
+				Relative: map[int]string{-1: "letzten Monat", 0: "diesen Monat", 1: "nächsten Monat"},
+				Future:   map[plural.Form]string{"one": "in {0} Monat", "other": "in {0} Monaten"},
+				Past:     map[plural.Form]string{"one": "vor {0} Monat", "other": "vor {0} Monaten"},
+			},
+			"month-narrow": {
+				Relative: map[int]string{-1: "letzten Monat", 0: "diesen Monat", 1: "nächsten Monat"},
+				Future:   map[plural.Form]string{"one": "in {0} Monat", "other": "in {0} Monaten"},
+				Past:     map[plural.Form]string{"one": "vor {0} Monat", "other": "vor {0} Monaten"},
+			},
+			"month-short": {
+				Relative: map[int]string{-1: "letzten Monat", 0: "diesen Monat", 1: "nächsten Monat"},
+				Future:   map[plural.Form]string{"one": "in {0} Monat", "other": "in {0} Monaten"},
+				Past:     map[plural.Form]string{"one": "vor {0} Monat", "other": "vor {0} Monaten"},
+			},
+			"quarter": {
+				Relative: map[int]string{-1: "letztes Quartal", 0: "dieses Quartal", 1: "nächstes Quartal"},
+				Future:   map[plural.Form]string{"one": "in {0} Quartal", "other": "in {0} Quartalen"},
+				Past:     map[plural.Form]string{"one": "vor {0} Quartal", "other": "vor {0} Quartalen"},
+			},
+			"quarter-narrow": {
+				Relative: map[int]string{-1: "letztes Quartal", 0: "dieses Quartal", 1: "nächstes Quartal"},
+				Future:   map[plural.Form]string{"one": "in {0} Q", "other": "in {0} Q"},
+				Past:     map[plural.Form]string{"one": "vor {0} Q", "other": "vor {0} Q"},
+			},
+			"quarter-short": {
+				Relative: map[int]string{-1: "letztes Quartal", 0: "dieses Quartal", 1: "nächstes Quartal"},
+				Future:   map[plural.Form]string{"one": "in {0} Quart.", "other": "in {0} Quart."},
+				Past:     map[plural.Form]string{"one": "vor {0} Quart.", "other": "vor {0} Quart."},
+			},
+			"second": {
+				Relative: map[int]string{0: "jetzt"},
+				Future:   map[plural.Form]string{"one": "in {0} Sekunde", "other": "in {0} Sekunden"},
+				Past:     map[plural.Form]string{"one": "vor {0} Sekunde", "other": "vor {0} Sekunden"},
+			},
+			"second-narrow": {
+				Relative: map[int]string{0: "jetzt"},
+				Future:   map[plural.Form]string{"one": "in {0} s", "other": "in {0} s"},
+				Past:     map[plural.Form]string{"one": "vor {0} s", "other": "vor {0} s"},
+			},
+			"second-short": {
+				Relative: map[int]string{0: "jetzt"},
+				Future:   map[plural.Form]string{"one": "in {0} Sek.", "other": "in {0} Sek."},
+				Past:     map[plural.Form]string{"one": "vor {0} Sek.", "other": "vor {0} Sek."},
+			},
+			"week": {
+				Relative: map[int]string{-1: "letzte Woche", 0: "diese Woche", 1: "nächste Woche"},
+				Future:   map[plural.Form]string{"one": "in {0} Woche", "other": "in {0} Wochen"},
+				Past:     map[plural.Form]string{"one": "vor {0} Woche", "other": "vor {0} Wochen"},
+			},
+			"week-narrow": {
+				Relative: map[int]string{-1: "letzte Woche", 0: "diese Woche", 1: "nächste Woche"},
+				Future:   map[plural.Form]string{"one": "in {0} Wo.", "other": "in {0} Wo."},
+				Past:     map[plural.Form]string{"one": "vor {0} Wo.", "other": "vor {0} Wo."},
+			},
+			"week-short": {
+				Relative: map[int]string{-1: "letzte Woche", 0: "diese Woche", 1: "nächste Woche"},
+				Future:   map[plural.Form]string{"one": "in {0} Woche", "other": "in {0} Wochen"},
+				Past:     map[plural.Form]string{"one": "vor {0} Woche", "other": "vor {0} Wochen"},
+			},
+			"year": {
+				Relative: map[int]string{-1: "letztes Jahr", 0: "dieses Jahr", 1: "nächstes Jahr"},
+				Future:   map[plural.Form]string{"one": "in {0} Jahr", "other": "in {0} Jahren"},
+				Past:     map[plural.Form]string{"one": "vor {0} Jahr", "other": "vor {0} Jahren"},
+			},
+			"year-narrow": {
+				Relative: map[int]string{-1: "letztes Jahr", 0: "dieses Jahr", 1: "nächstes Jahr"},
+				Future:   map[plural.Form]string{"one": "in {0} Jahr", "other": "in {0} Jahren"},
+				Past:     map[plural.Form]string{"one": "vor {0} Jahr", "other": "vor {0} Jahren"},
+			},
+			"year-short": {
+				Relative: map[int]string{-1: "letztes Jahr", 0: "dieses Jahr", 1: "nächstes Jahr"},
+				Future:   map[plural.Form]string{"one": "in {0} Jahr", "other": "in {0} Jahren"},
+				Past:     map[plural.Form]string{"one": "vor {0} Jahr", "other": "vor {0} Jahren"},
+			},
+		},
+		ListPatterns: map[string]*ListPattern{
+			"or":              {Start: "{0}, {1}", Middle: "{0}, {1}", End: "{0} oder {1}", Two: "{0} oder {1}"},
+			"or-narrow":       {Start: "{0}, {1}", Middle: "{0}, {1}", End: "{0} oder {1}", Two: "{0} oder {1}"},
+			"or-short":        {Start: "{0}, {1}", Middle: "{0}, {1}", End: "{0} oder {1}", Two: "{0} oder {1}"},
+			"standard":        {Start: "{0}, {1}", Middle: "{0}, {1}", End: "{0} und {1}", Two: "{0} und {1}"},
+			"standard-narrow": {Start: "{0}, {1}", Middle: "{0}, {1}", End: "{0} und {1}", Two: "{0} und {1}"},
+			"standard-short":  {Start: "{0}, {1}", Middle: "{0}, {1}", End: "{0} und {1}", Two: "{0} und {1}"},
+			"unit":            {Start: "{0}, {1}", Middle: "{0}, {1}", End: "{0} und {1}", Two: "{0}, {1}"},
+			"unit-narrow":     {Start: "{0}, {1}", Middle: "{0}, {1}", End: "{0} und {1}", Two: "{0}, {1}"},
+			"unit-short":      {Start: "{0}, {1}", Middle: "{0}, {1}", End: "{0} und {1}", Two: "{0}, {1}"},
+		},
+		Units: map[string]map[string]map[plural.Form]string{
+			"long": {
+				"concentr-percent":         {"one": "{0} Prozent", "other": "{0} Prozent"},
+				"digital-bit":              {"one": "{0} Bit", "other": "{0} Bit"},
+				"digital-byte":             {"one": "{0} Byte", "other": "{0} Byte"},
+				"digital-gigabyte":         {"one": "{0} Gigabyte", "other": "{0} Gigabyte"},
+				"digital-kilobyte":         {"one": "{0} Kilobyte", "other": "{0} Kilobyte"},
+				"digital-megabyte":         {"one": "{0} Megabyte", "other": "{0} Megabyte"},
+				"digital-petabyte":         {"one": "{0} Petabyte", "other": "{0} Petabyte"},
+				"digital-terabyte":         {"one": "{0} Terabyte", "other": "{0} Terabyte"},
+				"duration-day":             {"one": "{0} Tag", "other": "{0} Tage"},
+				"duration-hour":            {"one": "{0} Stunde", "other": "{0} Stunden"},
+				"duration-millisecond":     {"one": "{0} Millisekunde", "other": "{0} Millisekunden"},
+				"duration-minute":          {"one": "{0} Minute", "other": "{0} Minuten"},
+				"duration-month":           {"one": "{0} Monat", "other": "{0} Monate"},
+				"duration-second":          {"one": "{0} Sekunde", "other": "{0} Sekunden"},
+				"duration-week":            {"one": "{0} Woche", "other": "{0} Wochen"},
+				"duration-year":            {"one": "{0} Jahr", "other": "{0} Jahre"},
+				"length-centimeter":        {"one": "{0} Zentimeter", "other": "{0} Zentimeter"},
+				"length-foot":              {"one": "{0} Fuß", "other": "{0} Fuß"},
+				"length-inch":              {"one": "{0} Zoll", "other": "{0} Zoll"},
+				"length-kilometer":         {"one": "{0} Kilometer", "other": "{0} Kilometer"},
+				"length-meter":             {"one": "{0} Meter", "other": "{0} Meter"},
+				"length-mile":              {"one": "{0} Meile", "other": "{0} Meilen"},
+				"length-millimeter":        {"one": "{0} Millimeter", "other": "{0} Millimeter"},
+				"mass-gram":                {"one": "{0} Gramm", "other": "{0} Gramm"},
+				"mass-kilogram":            {"one": "{0} Kilogramm", "other": "{0} Kilogramm"},
+				"mass-pound":               {"one": "{0} Pfund", "other": "{0} Pfund"},
+				"speed-kilometer-per-hour": {"one": "{0} Kilometer pro Stunde", "other": "{0} Kilometer pro Stunde"},
+				"temperature-celsius":      {"one": "{0} Grad Celsius", "other": "{0} Grad Celsius"},
+				"temperature-fahrenheit":   {"one": "{0} Grad Fahrenheit", "other": "{0} Grad Fahrenheit"},
+				"volume-liter":             {"one": "{0} Liter", "other": "{0} Liter"},
+			},
+			"narrow": {
+				"concentr-percent":         {"one": "{0} %", "other": "{0} %"},
+				"digital-bit":              {"one": "{0} b", "other": "{0} b"},
+				"digital-byte":             {"one": "{0} B", "other": "{0} B"},
+				"digital-gigabyte":         {"one": "{0} GB", "other": "{0} GB"},
+				"digital-kilobyte":         {"one": "{0} kB", "other": "{0} kB"},
+				"digital-megabyte":         {"one": "{0} MB", "other": "{0} MB"},
+				"digital-petabyte":         {"one": "{0} PB", "other": "{0} PB"},
+				"digital-terabyte":         {"one": "{0} TB", "other": "{0} TB"},
+				"duration-day":             {"one": "{0} T", "other": "{0} T"},
+				"duration-hour":            {"one": "{0} Std.", "other": "{0} Std."},
+				"duration-millisecond":     {"one": "{0} ms", "other": "{0} ms"},
+				"duration-minute":          {"one": "{0} Min.", "other": "{0} Min."},
+				"duration-month":           {"one": "{0} M", "other": "{0} M"},
+				"duration-second":          {"one": "{0} Sek.", "other": "{0} Sek."},
+				"duration-week":            {"one": "{0} W", "other": "{0} W"},
+				"duration-year":            {"one": "{0} J", "other": "{0} J"},
+				"length-centimeter":        {"one": "{0} cm", "other": "{0} cm"},
+				"length-foot":              {"one": "{0} ft", "other": "{0} ft"},
+				"length-inch":              {"one": "{0} in", "other": "{0} in"},
+				"length-kilometer":         {"one": "{0} km", "other": "{0} km"},
+				"length-meter":             {"one": "{0} m", "other": "{0} m"},
+				"length-mile":              {"one": "{0} mi", "other": "{0} mi"},
+				"length-millimeter":        {"one": "{0} mm", "other": "{0} mm"},
+				"mass-gram":                {"one": "{0} g", "other": "{0} g"},
+				"mass-kilogram":            {"one": "{0} kg", "other": "{0} kg"},
+				"mass-pound":               {"one": "{0} lb", "other": "{0} lb"},
+				"speed-kilometer-per-hour": {"one": "{0} km/h", "other": "{0} km/h"},
+				"temperature-celsius":      {"one": "{0} °C", "other": "{0} °C"},
+				"temperature-fahrenheit":   {"one": "{0}°F", "other": "{0}°F"},
+				"volume-liter":             {"one": "{0} l", "other": "{0} l"},
+			},
+			"short": {
+				"concentr-percent":         {"one": "{0} %", "other": "{0} %"},
+				"digital-bit":              {"one": "{0} Bit", "other": "{0} Bit"},
+				"digital-byte":             {"one": "{0} Byte", "other": "{0} Byte"},
+				"digital-gigabyte":         {"one": "{0} GB", "other": "{0} GB"},
+				"digital-kilobyte":         {"one": "{0} kB", "other": "{0} kB"},
+				"digital-megabyte":         {"one": "{0} MB", "other": "{0} MB"},
+				"digital-petabyte":         {"one": "{0} PB", "other": "{0} PB"},
+				"digital-terabyte":         {"one": "{0} TB", "other": "{0} TB"},
+				"duration-day":             {"one": "{0} Tg.", "other": "{0} Tg."},
+				"duration-hour":            {"one": "{0} Std.", "other": "{0} Std."},
+				"duration-millisecond":     {"one": "{0} ms", "other": "{0} ms"},
+				"duration-minute":          {"one": "{0} Min.", "other": "{0} Min."},
+				"duration-month":           {"one": "{0} Mon.", "other": "{0} Mon."},
+				"duration-second":          {"one": "{0} Sek.", "other": "{0} Sek."},
+				"duration-week":            {"one": "{0} Wo.", "other": "{0} Wo."},
+				"duration-year":            {"one": "{0} J", "other": "{0} J"},
+				"length-centimeter":        {"one": "{0} cm", "other": "{0} cm"},
+				"length-foot":              {"one": "{0} ft", "other": "{0} ft"},
+				"length-inch":              {"one": "{0} in", "other": "{0} in"},
+				"length-kilometer":         {"one": "{0} km", "other": "{0} km"},
+				"length-meter":             {"one": "{0} m", "other": "{0} m"},
+				"length-mile":              {"one": "{0} mi", "other": "{0} mi"},
+				"length-millimeter":        {"one": "{0} mm", "other": "{0} mm"},
+				"mass-gram":                {"one": "{0} g", "other": "{0} g"},
+				"mass-kilogram":            {"one": "{0} kg", "other": "{0} kg"},
+				"mass-pound":               {"one": "{0} lb", "other": "{0} lb"},
+				"speed-kilometer-per-hour": {"one": "{0} km/h", "other": "{0} km/h"},
+				"temperature-celsius":      {"one": "{0} °C", "other": "{0} °C"},
+				"temperature-fahrenheit":   {"one": "{0} °F", "other": "{0} °F"},
+				"volume-liter":             {"one": "{0} l", "other": "{0} l"},
+			},
+		},
+	},
+	"en": {
+		Calendar: &Calendar{
+			MonthsAbbreviated:           [12]string{"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sep", "Oct", "Nov", "Dec"},
+			MonthsWide:                  [12]string{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"},
+			DaysAbbreviated:             [7]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"},
+			DaysWide:                    [7]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"},
+			MonthsStandAloneAbbreviated: [12]string{"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sep", "Oct", "Nov", "Dec"},
+			MonthsStandAloneWide:        [12]string{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"},
+			DaysStandAloneAbbreviated:   [7]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"},
+			DaysStandAloneWide:          [7]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"},
+			DayPeriods:                  [2]string{"AM", "PM"},
+			ErasAbbreviated:             [2]string{"BC", "AD"},
+			ErasWide:                    [2]string{"Before Christ", "Anno Domini"},
+			ErasNarrow:                  [2]string{"B", "A"},
+			DateFormats:                 Styles{Full: "EEEE, MMMM d, y", Long: "MMMM d, y", Medium: "MMM d, y", Short: "M/d/yy"},
+			TimeFormats:                 Styles{Full: "h:mm:ss a zzzz", Long: "h:mm:ss a z", Medium: "h:mm:ss a", Short: "h:mm a"},
+			DateTimeFormats:             Styles{Full: "{1} 'at' {0}", Long: "{1} 'at' {0}", Medium: "{1}, {0}", Short: "{1}, {0}"},
+			AvailableFormats:            map[string]string{"E": "E", "EEEEd": "d EEEE", "Ed": "d E", "GyMMMd": "MMM d, y G", "H": "HH", "Hm": "HH:mm", "Hms": "HH:mm:ss", "M": "M", "MEd": "E, M/d", "MMM": "MMM", "MMMEd": "E, MMM d", "MMMM": "MMMM", "MMMMd": "MMMM d", "MMMd": "MMM d", "Md": "M/d", "d": "d", "h": "h a", "hm": "h:mm a", "hms": "h:mm:ss a", "ms": "mm:ss", "y": "y", "yM": "M/y", "yMEd": "E, M/d/y", "yMMM": "MMM y", "yMMMEd": "E, MMM d, y", "yMMMM": "MMMM y", "yMMMMEEEEd": "EEEE, MMMM d, y", "yMMMMd": "MMMM d, y", "yMMMd": "MMM d, y", "yMd": "M/d/y"},
+		},
+		Numbers: &Numbers{
+			Symbols:               Symbols{Decimal: ".", Group: ",", MinusSign: "-"},
+			MinimumGroupingDigits: 1,
+			Compact: map[string]map[int]map[plural.Form]string{
+				"long": {
+					3:  {"one": "0 thousand", "other": "0 thousand"},
+					4:  {"other": "00 thousand"},
+					5:  {"other": "000 thousand"},
+					6:  {"one": "0 million", "other": "0 million"},
+					7:  {"other": "00 million"},
+					8:  {"other": "000 million"},
+					9:  {"one": "0 billion", "other": "0 billion"},
+					10: {"other": "00 billion"},
+					11: {"other": "000 billion"},
+					12: {"one": "0 trillion", "other": "0 trillion"},
+					13: {"other": "00 trillion"},
+					14: {"other": "000 trillion"},
+				},
+				"short": {
+					3:  {"one": "0K", "other": "0K"},
+					4:  {"other": "00K"},
+					5:  {"other": "000K"},
+					6:  {"one": "0M", "other": "0M"},
+					7:  {"other": "00M"},
+					8:  {"other": "000M"},
+					9:  {"one": "0B", "other": "0B"},
+					10: {"other": "00B"},
+					11: {"other": "000B"},
+					12: {"one": "0T", "other": "0T"},
+					13: {"other": "00T"},
+					14: {"other": "000T"},
+				},
+			},
+		},
+		RelativeTimes: map[string]*RelativeTime{
+			"day": {
+				Relative: map[int]string{-1: "yesterday", 0: "today", 1: "tomorrow"},
+				Future:   map[plural.Form]string{"one": "in {0} day", "other": "in {0} days"},
+				Past:     map[plural.Form]string{"one": "{0} day ago", "other": "{0} days ago"},
+			},
+			"day-narrow": {
+				Relative: map[int]string{-1: "yesterday", 0: "today", 1: "tomorrow"},
+				Future:   map[plural.Form]string{"one": "in {0}d", "other": "in {0}d"},
+				Past:     map[plural.Form]string{"one": "{0}d ago", "other": "{0}d ago"},
+			},
+			"day-short": {
+				Relative: map[int]string{-1: "yesterday", 0: "today", 1: "tomorrow"},
+				Future:   map[plural.Form]string{"one": "in {0} day", "other": "in {0} days"},
+				Past:     map[plural.Form]string{"one": "{0} day ago", "other": "{0} days ago"},
+			},
+			"hour": {
+				Relative: map[int]string{0: "this hour"},
+				Future:   map[plural.Form]string{"one": "in {0} hour", "other": "in {0} hours"},
+				Past:     map[plural.Form]string{"one": "{0} hour ago", "other": "{0} hours ago"},
+			},
+			"hour-narrow": {
+				Relative: map[int]string{0: "this hour"},
+				Future:   map[plural.Form]string{"one": "in {0}h", "other": "in {0}h"},
+				Past:     map[plural.Form]string{"one": "{0}h ago", "other": "{0}h ago"},
+			},
+			"hour-short": {
+				Relative: map[int]string{0: "this hour"},
+				Future:   map[plural.Form]string{"one": "in {0} hr.", "other": "in {0} hr."},
+				Past:     map[plural.Form]string{"one": "{0} hr. ago", "other": "{0} hr. ago"},
+			},
+			"minute": {
+				Relative: map[int]string{0: "this minute"},
+				Future:   map[plural.Form]string{"one": "in {0} minute", "other": "in {0} minutes"},
+				Past:     map[plural.Form]string{"one": "{0} minute ago", "other": "{0} minutes ago"},
+			},
+			"minute-narrow": {
+				Relative: map[int]string{0: "this minute"},
+				Future:   map[plural.Form]string{"one": "in {0}m", "other": "in {0}m"},
+				Past:     map[plural.Form]string{"one": "{0}m ago", "other": "{0}m ago"},
+			},
+			"minute-short": {
+				Relative: map[int]string{0: "this minute"},
+				Future:   map[plural.Form]string{"one": "in {0} min.", "other": "in {0} min."},
+				Past:     map[plural.Form]string{"one": "{0} min. ago", "other": "{0} min. ago"},
+			},
+			"month": {
+				Relative: map[int]string{-1: "last month", 0: "this month", 1: "next month"},
+				Future:   map[plural.Form]string{"one": "in {0} month", "other": "in {0} months"},
+				Past:     map[plural.Form]string{"one": "{0} month ago", "other": "{0} months ago"},
+			},
+			"month-narrow": {
+				Relative: map[int]string{-1: "last mo.", 0: "this mo.", 1: "next mo."},
+				Future:   map[plural.Form]string{"one": "in {0}mo", "other": "in {0}mo"},
+				Past:     map[plural.Form]string{"one": "{0}mo ago", "other": "{0}mo ago"},
+			},
+			"month-short": {
+				Relative: map[int]string{-1: "last mo.", 0: "this mo.", 1: "next mo."},
+				Future:   map[plural.Form]string{"one": "in {0} mo.", "other": "in {0} mo."},
+				Past:     map[plural.Form]string{"one": "{0} mo. ago", "other": "{0} mo. ago"},
+			},
+			"quarter": {
+				Relative: map[int]string{-1: "last quarter", 0: "this quarter", 1: "next quarter"},
+				Future:   map[plural.Form]string{"one": "in {0} quarter", "other": "in {0} quarters"},
+				Past:     map[plural.Form]string{"one": "{0} quarter ago", "other": "{0} quarters ago"},
+			},
+			"quarter-narrow": {
+				Relative: map[int]string{-1: "last qtr.", 0: "this qtr.", 1: "next qtr."},
+				Future:   map[plural.Form]string{"one": "in {0}q", "other": "in {0}q"},
+				Past:     map[plural.Form]string{"one": "{0}q ago", "other": "{0}q ago"},
+			},
+			"quarter-short": {
+				Relative: map[int]string{-1: "last qtr.", 0: "this qtr.", 1: "next qtr."},
+				Future:   map[plural.Form]string{"one": "in {0} qtr.", "other": "in {0} qtrs."},
+				Past:     map[plural.Form]string{"one": "{0} qtr. ago", "other": "{0} qtrs. ago"},
+			},
+			"second": {
+				Relative: map[int]string{0: "now"},
+				Future:   map[plural.Form]string{"one": "in {0} second", "other": "in {0} seconds"},
+				Past:     map[plural.Form]string{"one": "{0} second ago", "other": "{0} seconds ago"},
+			},
+			"second-narrow": {
+				Relative: map[int]string{0: "now"},
+				Future:   map[plural.Form]string{"one": "in {0}s", "other": "in {0}s"},
+				Past:     map[plural.Form]string{"one": "{0}s ago", "other": "{0}s ago"},
+			},
+			"second-short": {
+				Relative: map[int]string{0: "now"},
+				Future:   map[plural.Form]string{"one": "in {0} sec.", "other": "in {0} sec."},
+				Past:     map[plural.Form]string{"one": "{0} sec. ago", "other": "{0} sec. ago"},
+			},
+			"week": {
+				Relative: map[int]string{-1: "last week", 0: "this week", 1: "next week"},
+				Future:   map[plural.Form]string{"one": "in {0} week", "other": "in {0} weeks"},
+				Past:     map[plural.Form]string{"one": "{0} week ago", "other": "{0} weeks ago"},
+			},
+			"week-narrow": {
+				Relative: map[int]string{-1: "last wk.", 0: "this wk.", 1: "next wk."},
+				Future:   map[plural.Form]string{"one": "in {0}w", "other": "in {0}w"},
+				Past:     map[plural.Form]string{"one": "{0}w ago", "other": "{0}w ago"},
+			},
+			"week-short": {
+				Relative: map[int]string{-1: "last wk.", 0: "this wk.", 1: "next wk."},
+				Future:   map[plural.Form]string{"one": "in {0} wk.", "other": "in {0} wk."},
+				Past:     map[plural.Form]string{"one": "{0} wk. ago", "other": "{0} wk. ago"},
+			},
+			"year": {
+				Relative: map[int]string{-1: "last year", 0: "this year", 1: "next year"},
+				Future:   map[plural.Form]string{"one": "in {0} year", "other": "in {0} years"},
+				Past:     map[plural.Form]string{"one": "{0} year ago", "other": "{0} years ago"},
+			},
+			"year-narrow": {
+				Relative: map[int]string{-1: "last yr.", 0: "this yr.", 1: "next yr."},
+				Future:   map[plural.Form]string{"one": "in {0}y", "other": "in {0}y"},
+				Past:     map[plural.Form]string{"one": "{0}y ago", "other": "{0}y ago"},
+			},
+			"year-short": {
+				Relative: map[int]string{-1: "last yr.", 0: "this yr.", 1: "next yr."},
+				Future:   map[plural.Form]string{"one": "in {0} yr.", "other": "in {0} yr."},
+				Past:     map[plural.Form]string{"one": "{0} yr. ago", "other": "{0} yr. ago"},
+			},
+		},
+		ListPatterns: map[string]*ListPattern{
+			"or":              {Start: "{0}, {1}", Middle: "{0}, {1}", End: "{0}, or {1}", Two: "{0} or {1}"},
+			"or-narrow":       {Start: "{0}, {1}", Middle: "{0}, {1}", End: "{0}, or {1}", Two: "{0} or {1}"},
+			"or-short":        {Start: "{0}, {1}", Middle: "{0}, {1}", End: "{0}, or {1}", Two: "{0} or {1}"},
+			"standard":        {Start: "{0}, {1}", Middle: "{0}, {1}", End: "{0}, and {1}", Two: "{0} and {1}"},
+			"standard-narrow": {Start: "{0}, {1}", Middle: "{0}, {1}", End: "{0}, {1}", Two: "{0}, {1}"},
+			"standard-short":  {Start: "{0}, {1}", Middle: "{0}, {1}", End: "{0}, & {1}", Two: "{0} & {1}"},
+			"unit":            {Start: "{0}, {1}", Middle: "{0}, {1}", End: "{0}, {1}", Two: "{0}, {1}"},
+			"unit-narrow":     {Start: "{0} {1}", Middle: "{0} {1}", End: "{0} {1}", Two: "{0} {1}"},
+			"unit-short":      {Start: "{0}, {1}", Middle: "{0}, {1}", End: "{0}, {1}", Two: "{0}, {1}"},
+		},
+		Units: map[string]map[string]map[plural.Form]string{
+			"long": {
+				"concentr-percent":         {"one": "{0} percent", "other": "{0} percent"},
+				"digital-bit":              {"one": "{0} bit", "other": "{0} bits"},
+				"digital-byte":             {"one": "{0} byte", "other": "{0} bytes"},
+				"digital-gigabyte":         {"one": "{0} gigabyte", "other": "{0} gigabytes"},
+				"digital-kilobyte":         {"one": "{0} kilobyte", "other": "{0} kilobytes"},
+				"digital-megabyte":         {"one": "{0} megabyte", "other": "{0} megabytes"},
+				"digital-petabyte":         {"one": "{0} petabyte", "other": "{0} petabytes"},
+				"digital-terabyte":         {"one": "{0} terabyte", "other": "{0} terabytes"},
+				"duration-day":             {"one": "{0} day", "other": "{0} days"},
+				"duration-hour":            {"one": "{0} hour", "other": "{0} hours"},
+				"duration-millisecond":     {"one": "{0} millisecond", "other": "{0} milliseconds"},
+				"duration-minute":          {"one": "{0} minute", "other": "{0} minutes"},
+				"duration-month":           {"one": "{0} month", "other": "{0} months"},
+				"duration-second":          {"one": "{0} second", "other": "{0} seconds"},
+				"duration-week":            {"one": "{0} week", "other": "{0} weeks"},
+				"duration-year":            {"one": "{0} year", "other": "{0} years"},
+				"length-centimeter":        {"one": "{0} centimeter", "other": "{0} centimeters"},
+				"length-foot":              {"one": "{0} foot", "other": "{0} feet"},
+				"length-inch":              {"one": "{0} inch", "other": "{0} inches"},
+				"length-kilometer":         {"one": "{0} kilometer", "other": "{0} kilometers"},
+				"length-meter":             {"one": "{0} meter", "other": "{0} meters"},
+				"length-mile":              {"one": "{0} mile", "other": "{0} miles"},
+				"length-millimeter":        {"one": "{0} millimeter", "other": "{0} millimeters"},
+				"mass-gram":                {"one": "{0} gram", "other": "{0} grams"},
+				"mass-kilogram":            {"one": "{0} kilogram", "other": "{0} kilograms"},
+				"mass-pound":               {"one": "{0} pound", "other": "{0} pounds"},
+				"speed-kilometer-per-hour": {"one": "{0} kilometer per hour", "other": "{0} kilometers per hour"},
+				"temperature-celsius":      {"one": "{0} degree Celsius", "other": "{0} degrees Celsius"},
+				"temperature-fahrenheit":   {"one": "{0} degree Fahrenheit", "other": "{0} degrees Fahrenheit"},
+				"volume-liter":             {"one": "{0} liter", "other": "{0} liters"},
+			},
+			"narrow": {
+				"concentr-percent":         {"one": "{0}%", "other": "{0}%"},
+				"digital-bit":              {"one": "{0}bit", "other": "{0}bit"},
+				"digital-byte":             {"one": "{0}B", "other": "{0}B"},
+				"digital-gigabyte":         {"one": "{0}GB", "other": "{0}GB"},
+				"digital-kilobyte":         {"one": "{0}kB", "other": "{0}kB"},
+				"digital-megabyte":         {"one": "{0}MB", "other": "{0}MB"},
+				"digital-petabyte":         {"one": "{0}PB", "other": "{0}PB"},
+				"digital-terabyte":         {"one": "{0}TB", "other": "{0}TB"},
+				"duration-day":             {"one": "{0}d", "other": "{0}d"},
+				"duration-hour":            {"one": "{0}h", "other": "{0}h"},
+				"duration-millisecond":     {"one": "{0}ms", "other": "{0}ms"},
+				"duration-minute":          {"one": "{0}m", "other": "{0}m"},
+				"duration-month":           {"one": "{0}m", "other": "{0}m"},
+				"duration-second":          {"one": "{0}s", "other": "{0}s"},
+				"duration-week":            {"one": "{0}w", "other": "{0}w"},
+				"duration-year":            {"one": "{0}y", "other": "{0}y"},
+				"length-centimeter":        {"one": "{0}cm", "other": "{0}cm"},
+				"length-foot":              {"one": "{0}′", "other": "{0}′"},
+				"length-inch":              {"one": "{0}″", "other": "{0}″"},
+				"length-kilometer":         {"one": "{0}km", "other": "{0}km"},
+				"length-meter":             {"one": "{0}m", "other": "{0}m"},
+				"length-mile":              {"one": "{0}mi", "other": "{0}mi"},
+				"length-millimeter":        {"one": "{0}mm", "other": "{0}mm"},
+				"mass-gram":                {"one": "{0}g", "other": "{0}g"},
+				"mass-kilogram":            {"one": "{0}kg", "other": "{0}kg"},
+				"mass-pound":               {"one": "{0}#", "other": "{0}#"},
+				"speed-kilometer-per-hour": {"one": "{0}km/h", "other": "{0}km/h"},
+				"temperature-celsius":      {"one": "{0}°C", "other": "{0}°C"},
+				"temperature-fahrenheit":   {"one": "{0}°", "other": "{0}°"},
+				"volume-liter":             {"one": "{0}L", "other": "{0}L"},
+			},
+			"short": {
+				"concentr-percent":         {"one": "{0}%", "other": "{0}%"},
+				"digital-bit":              {"one": "{0} bit", "other": "{0} bit"},
+				"digital-byte":             {"one": "{0} byte", "other": "{0} byte"},
+				"digital-gigabyte":         {"one": "{0} GB", "other": "{0} GB"},
+				"digital-kilobyte":         {"one": "{0} kB", "other": "{0} kB"},
+				"digital-megabyte":         {"one": "{0} MB", "other": "{0} MB"},
+				"digital-petabyte":         {"one": "{0} PB", "other": "{0} PB"},
+				"digital-terabyte":         {"one": "{0} TB", "other": "{0} TB"},
+				"duration-day":             {"one": "{0} day", "other": "{0} days"},
+				"duration-hour":            {"one": "{0} hr", "other": "{0} hr"},
+				"duration-millisecond":     {"one": "{0} ms", "other": "{0} ms"},
+				"duration-minute":          {"one": "{0} min", "other": "{0} min"},
+				"duration-month":           {"one": "{0} mth", "other": "{0} mths"},
+				"duration-second":          {"one": "{0} sec", "other": "{0} sec"},
+				"duration-week":            {"one": "{0} wk", "other": "{0} wks"},
+				"duration-year":            {"one": "{0} yr", "other": "{0} yrs"},
+				"length-centimeter":        {"one": "{0} cm", "other": "{0} cm"},
+				"length-foot":              {"one": "{0} ft", "other": "{0} ft"},
+				"length-inch":              {"one": "{0} in", "other": "{0} in"},
+				"length-kilometer":         {"one": "{0} km", "other": "{0} km"},
+				"length-meter":             {"one": "{0} m", "other": "{0} m"},
+				"length-mile":              {"one": "{0} mi", "other": "{0} mi"},
+				"length-millimeter":        {"one": "{0} mm", "other": "{0} mm"},
+				"mass-gram":                {"one": "{0} g", "other": "{0} g"},
+				"mass-kilogram":            {"one": "{0} kg", "other": "{0} kg"},
+				"mass-pound":               {"one": "{0} lb", "other": "{0} lb"},
+				"speed-kilometer-per-hour": {"one": "{0} km/h", "other": "{0} km/h"},
+				"temperature-celsius":      {"one": "{0}°C", "other": "{0}°C"},
+				"temperature-fahrenheit":   {"one": "{0}°F", "other": "{0}°F"},
+				"volume-liter":             {"one": "{0} L", "other": "{0} L"},
+			},
+		},
+	},
+	"es": {
+		Calendar: &Calendar{
+			MonthsAbbreviated:           [12]string{"ene", "feb", "mar", "abr", "may", "jun", "jul", "ago", "sept", "oct", "nov", "dic"},
+			MonthsWide:                  [12]string{"enero", "febrero", "marzo", "abril", "mayo", "junio", "julio", "agosto", "septiembre", "octubre", "noviembre", "diciembre"},
+			DaysAbbreviated:             [7]string{"dom", "lun", "mar", "mié", "jue", "vie", "sáb"},
+			DaysWide:                    [7]string{"domingo", "lunes", "martes", "miércoles", "jueves", "viernes", "sábado"},
+			MonthsStandAloneAbbreviated: [12]string{"ene", "feb", "mar", "abr", "may", "jun", "jul", "ago", "sept", "oct", "nov", "dic"},
+			MonthsStandAloneWide:        [12]string{"enero", "febrero", "marzo", "abril", "mayo", "junio", "julio", "agosto", "septiembre", "octubre", "noviembre", "diciembre"},
+			DaysStandAloneAbbreviated:   [7]string{"dom", "lun", "mar", "mié", "jue", "vie", "sáb"},
+			DaysStandAloneWide:          [7]string{"domingo", "lunes", "martes", "miércoles", "jueves", "viernes", "sábado"},
+			DayPeriods:                  [2]string{"a. m.", "p. m."},
+			ErasAbbreviated:             [2]string{"a. C.", "d. C."},
+			ErasWide:                    [2]string{"antes de Cristo", "después de Cristo"},
+			ErasNarrow:                  [2]string{"a. C.", "d. C."},
+			DateFormats:                 Styles{Full: "EEEE, d 'de' MMMM 'de' y", Long: "d 'de' MMMM 'de' y", Medium: "d MMM y", Short: "d/M/yy"},
+			TimeFormats:                 Styles{Full: "H:mm:ss (zzzz)", Long: "H:mm:ss z", Medium: "H:mm:ss", Short: "H:mm"},
+			DateTimeFormats:             Styles{Full: "{1}, {0}", Long: "{1}, {0}", Medium: "{1}, {0}", Short: "{1}, {0}"},
+			AvailableFormats:            map[string]string{"E": "E", "EEEEd": "EEEE d", "Ed": "E d", "H": "HH", "Hm": "HH:mm", "Hms": "HH:mm:ss", "M": "M", "MEd": "E, d/M", "MMM": "MMM", "MMMEd": "E, d MMM", "MMMM": "MMMM", "MMMMd": "d 'de' MMMM", "MMMd": "d MMM", "Md": "d/M", "d": "d", "h": "h a", "hm": "h:mm a", "hms": "h:mm:ss a", "ms": "mm:ss", "y": "y", "yM": "M/y", "yMEd": "E, d/M/y", "yMMM": "MMM y", "yMMMEd": "E, d MMM y", "yMMMM": "MMMM 'de' y", "yMMMMEEEEd": "EEEE, d 'de' MMMM 'de' y", "yMMMMd": "d 'de' MMMM 'de' y", "yMMMd": "d MMM y", "yMd": "d/M/y"},
+		},
+		Numbers: &Numbers{
+			Symbols:               Symbols{Decimal: ",", Group: ".", MinusSign: "-"},
+			MinimumGroupingDigits: 2,
+			Compact: map[string]map[int]map[plural.Form]string{
+				"long": {
+					3:  {"one": "0 mil", "other": "0 mil"},
+					4:  {"other": "00 mil"},
+					5:  {"other": "000 mil"},
+					6:  {"one": "0 millón", "other": "0 millones"},
+					7:  {"other": "00 millones"},
+					8:  {"other": "000 millones"},
+					9:  {"one": "0 mil millones", "other": "0 mil millones"},
+					10: {"other": "00 mil millones"},
+					11: {"other": "000 mil millones"},
+					12: {"one": "0 billón", "other": "0 billones"},
+					13: {"other": "00 billones"},
+					14: {"other": "000 billones"},
+				},
+				"short": {
+					3:  {"one": "0 mil", "other": "0 mil"},
+					4:  {"other": "00 mil"},
+					5:  {"other": "000 mil"},
+					6:  {"one": "0 M", "other": "0 M"},
+					7:  {"other": "00 M"},
+					8:  {"other": "000 M"},
+					9:  {"other": "0000 M"},
+					10: {"other": "00 mil M"},
+					11: {"other": "000 mil M"},
+					12: {"one": "0 B", "other": "0 B"},
+					13: {"other": "00 B"},
+					14: {"other": "000 B"},
+				},
+			},
+		},
+		RelativeTimes: map[string]*RelativeTime{
+			"day": {
+				Relative: map[int]string{-2: "anteayer", -1: "ayer", 0: "hoy", 1: "mañana", 2: "pasado mañana"},
+				Future:   map[plural.Form]string{"one": "dentro de {0} día", "other": "dentro de {0} días"},
+				Past:     map[plural.Form]string{"one": "hace {0} día", "other": "hace {0} días"},
+			},
+			"day-narrow": {
+				Relative: map[int]string{-2: "anteayer", -1: "ayer", 0: "hoy", 1: "mañana", 2: "pasado mañana"},
+				Future:   map[plural.Form]string{"one": "dentro de {0} d", "other": "dentro de {0} d"},
+				Past:     map[plural.Form]string{"one": "hace {0} d", "other": "hace {0} d"},
+			},
+			"day-short": {
+				Relative: map[int]string{-2: "anteayer", -1: "ayer", 0: "hoy", 1: "mañana", 2: "pasado mañana"},
+				Future:   map[plural.Form]string{"one": "dentro de {0} d", "other": "dentro de {0} d"},
+				Past:     map[plural.Form]string{"one": "hace {0} d", "other": "hace {0} d"},
+			},
+			"hour": {
+				Relative: map[int]string{0: "esta hora"},
+				Future:   map[plural.Form]string{"one": "dentro de {0} hora", "other": "dentro de {0} horas"},
+				Past:     map[plural.Form]string{"one": "hace {0} hora", "other": "hace {0} horas"},
+			},
+			"hour-narrow": {
+				Relative: map[int]string{0: "esta hora"},
+				Future:   map[plural.Form]string{"one": "dentro de {0} h", "other": "dentro de {0} h"},
+				Past:     map[plural.Form]string{"one": "hace {0} h", "other": "hace {0} h"},
+			},
+			"hour-short": {
+				Relative: map[int]string{0: "esta hora"},
+				Future:   map[plural.Form]string{"one": "dentro de {0} h", "other": "dentro de {0} h"},
+				Past:     map[plural.Form]string{"one": "hace {0} h", "other": "hace {0} h"},
+			},
+			"minute": {
+				Relative: map[int]string{0: "este minuto"},
+				Future:   map[plural.Form]string{"one": "dentro de {0} minuto", "other": "dentro de {0} minutos"},
+				Past:     map[plural.Form]string{"one": "hace {0} minuto", "other": "hace {0} minutos"},
+			},
+			"minute-narrow": {
+				Relative: map[int]string{0: "este minuto"},
+				Future:   map[plural.Form]string{"one": "dentro de {0} min", "other": "dentro de {0} min"},
+				Past:     map[plural.Form]string{"one": "hace {0} min", "other": "hace {0} min"},
+			},
+			"minute-short": {
+				Relative: map[int]string{0: "este minuto"},
+				Future:   map[plural.Form]string{"one": "dentro de {0} min", "other": "dentro de {0} min"},
+				Past:     map[plural.Form]string{"one": "hace {0} min", "other": "hace {0} min"},
+			},
+			"month": {
+				Relative: map[int]string{-1: "el mes pasado", 0: "este mes", 1: "el próximo mes"},
+				Future:   map[plural.Form]string{"one": "dentro de {0} mes", "other": "dentro de {0} meses"},
+				Past:     map[plural.Form]string{"one": "hace {0} mes", "other": "hace {0} meses"},
+			},
+			"month-narrow": {
+				Relative: map[int]string{-1: "el mes pasado", 0: "este mes", 1: "el próximo mes"},
+				Future:   map[plural.Form]string{"one": "dentro de {0} m", "other": "dentro de {0} m"},
+				Past:     map[plural.Form]string{"one": "hace {0} m", "other": "hace {0} m"},
+			},
+			"month-short": {
+				Relative: map[int]string{-1: "el mes pasado", 0: "este mes", 1: "el próximo mes"},
+				Future:   map[plural.Form]string{"one": "dentro de {0} m", "other": "dentro de {0} m"},
+				Past:     map[plural.Form]string{"one": "hace {0} m", "other": "hace {0} m"},
+			},
+			"quarter": {
+				Relative: map[int]string{-1: "el trimestre pasado", 0: "este trimestre", 1: "el próximo trimestre"},
+				Future:   map[plural.Form]string{"one": "dentro de {0} trimestre", "other": "dentro de {0} trimestres"},
+				Past:     map[plural.Form]string{"one": "hace {0} trimestre", "other": "hace {0} trimestres"},
+			},
+			"quarter-narrow": {
+				Relative: map[int]string{-1: "el trimestre pasado", 0: "este trimestre", 1: "el próximo trimestre"},
+				Future:   map[plural.Form]string{"one": "dentro de {0} trim.", "other": "dentro de {0} trim."},
+				Past:     map[plural.Form]string{"one": "hace {0} trim.", "other": "hace {0} trim."},
+			},
+			"quarter-short": {
+				Relative: map[int]string{-1: "el trimestre pasado", 0: "este trimestre", 1: "el próximo trimestre"},
+				Future:   map[plural.Form]string{"one": "dentro de {0} trim.", "other": "dentro de {0} trim."},
+				Past:     map[plural.Form]string{"one": "hace {0} trim.", "other": "hace {0} trim."},
+			},
+			"second": {
+				Relative: map[int]string{0: "ahora"},
+				Future:   map[plural.Form]string{"one": "dentro de {0} segundo", "other": "dentro de {0} segundos"},
+				Past:     map[plural.Form]string{"one": "hace {0} segundo", "other": "hace {0} segundos"},
+			},
+			"second-narrow": {
+				Relative: map[int]string{0: "ahora"},
+				Future:   map[plural.Form]string{"one": "dentro de {0} s", "other": "dentro de {0} s"},
+				Past:     map[plural.Form]string{"one": "hace {0} s", "other": "hace {0} s"},
+			},
+			"second-short": {
+				Relative: map[int]string{0: "ahora"},
+				Future:   map[plural.Form]string{"one": "dentro de {0} s", "other": "dentro de {0} s"},
+				Past:     map[plural.Form]string{"one": "hace {0} s", "other": "hace {0} s"},
+			},
+			"week": {
+				Relative: map[int]string{-1: "la semana pasada", 0: "esta semana", 1: "la próxima semana"},
+				Future:   map[plural.Form]string{"one": "dentro de {0} semana", "other": "dentro de {0} semanas"},
+				Past:     map[plural.Form]string{"one": "hace {0} semana", "other": "hace {0} semanas"},
+			},
+			"week-narrow": {
+				Relative: map[int]string{-1: "sem. ant.", 0: "esta sem.", 1: "próx. sem."},
+				Future:   map[plural.Form]string{"one": "dentro de {0} sem.", "other": "dentro de {0} sem."},
+				Past:     map[plural.Form]string{"one": "hace {0} sem.", "other": "hace {0} sem."},
+			},
+			"week-short": {
+				Relative: map[int]string{-1: "sem. ant.", 0: "esta sem.", 1: "próx. sem."},
+				Future:   map[plural.Form]string{"one": "dentro de {0} sem.", "other": "dentro de {0} sem."},
+				Past:     map[plural.Form]string{"one": "hace {0} sem.", "other": "hace {0} sem."},
+			},
+			"year": {
+				Relative: map[int]string{-1: "el año pasado", 0: "este año", 1: "el próximo año"},
+				Future:   map[plural.Form]string{"one": "dentro de {0} año", "other": "dentro de {0} años"},
+				Past:     map[plural.Form]string{"one": "hace {0} año", "other": "hace {0} años"},
+			},
+			"year-narrow": {
+				Relative: map[int]string{-1: "el año pasado", 0: "este año", 1: "el próximo año"},
+				Future:   map[plural.Form]string{"one": "dentro de {0} a", "other": "dentro de {0} a"},
+				Past:     map[plural.Form]string{"one": "hace {0} a", "other": "hace {0} a"},
+			},
+			"year-short": {
+				Relative: map[int]string{-1: "el año pasado", 0: "este año", 1: "el próximo año"},
+				Future:   map[plural.Form]string{"one": "dentro de {0} a", "other": "dentro de {0} a"},
+				Past:     map[plural.Form]string{"one": "hace {0} a", "other": "hace {0} a"},
+			},
+		},
+		ListPatterns: map[string]*ListPattern{
+			"or":              {Start: "{0}, {1}", Middle: "{0}, {1}", End: "{0} o {1}", Two: "{0} o {1}"},
+			"or-narrow":       {Start: "{0}, {1}", Middle: "{0}, {1}", End: "{0} o {1}", Two: "{0} o {1}"},
+			"or-short":        {Start: "{0}, {1}", Middle: "{0}, {1}", End: "{0} o {1}", Two: "{0} o {1}"},
+			"standard":        {Start: "{0}, {1}", Middle: "{0}, {1}", End: "{0} y {1}", Two: "{0} y {1}"},
+			"standard-narrow": {Start: "{0}, {1}", Middle: "{0}, {1}", End: "{0} y {1}", Two: "{0} y {1}"},
+			"standard-short":  {Start: "{0}, {1}", Middle: "{0}, {1}", End: "{0} y {1}", Two: "{0} y {1}"},
+			"unit":            {Start: "{0}, {1}", Middle: "{0}, {1}", End: "{0} y {1}", Two: "{0} y {1}"},
+			"unit-narrow":     {Start: "{0} {1}", Middle: "{0} {1}", End: "{0} {1}", Two: "{0} {1}"},
+			"unit-short":      {Start: "{0}, {1}", Middle: "{0}, {1}", End: "{0}, {1}", Two: "{0} y {1}"},
+		},
+		Units: map[string]map[string]map[plural.Form]string{
+			"long": {
+				"concentr-percent":         {"one": "{0} por ciento", "other": "{0} por ciento"},
+				"digital-bit":              {"one": "{0} bit", "other": "{0} bits"},
+				"digital-byte":             {"one": "{0} byte", "other": "{0} bytes"},
+				"digital-gigabyte":         {"one": "{0} gigabyte", "other": "{0} gigabytes"},
+				"digital-kilobyte":         {"one": "{0} kilobyte", "other": "{0} kilobytes"},
+				"digital-megabyte":         {"one": "{0} megabyte", "other": "{0} megabytes"},
+				"digital-petabyte":         {"one": "{0} petabyte", "other": "{0} petabytes"},
+				"digital-terabyte":         {"one": "{0} terabyte", "other": "{0} terabytes"},
+				"duration-day":             {"one": "{0} día", "other": "{0} días"},
+				"duration-hour":            {"one": "{0} hora", "other": "{0} horas"},
+				"duration-millisecond":     {"one": "{0} milisegundo", "other": "{0} milisegundos"},
+				"duration-minute":          {"one": "{0} minuto", "other": "{0} minutos"},
+				"duration-month":           {"one": "{0} mes", "other": "{0} meses"},
+				"duration-second":          {"one": "{0} segundo", "other": "{0} segundos"},
+				"duration-week":            {"one": "{0} semana", "other": "{0} semanas"},
+				"duration-year":            {"one": "{0} año", "other": "{0} años"},
+				"length-centimeter":        {"one": "{0} centímetro", "other": "{0} centímetros"},
+				"length-foot":              {"one": "{0} pie", "other": "{0} pies"},
+				"length-inch":              {"one": "{0} pulgada", "other": "{0} pulgadas"},
+				"length-kilometer":         {"one": "{0} kilómetro", "other": "{0} kilómetros"},
+				"length-meter":             {"one": "{0} metro", "other": "{0} metros"},
+				"length-mile":              {"one": "{0} milla", "other": "{0} millas"},
+				"length-millimeter":        {"one": "{0} milímetro", "other": "{0} milímetros"},
+				"mass-gram":                {"one": "{0} gramo", "other": "{0} gramos"},
+				"mass-kilogram":            {"one": "{0} kilogramo", "other": "{0} kilogramos"},
+				"mass-pound":               {"one": "{0} libra", "other": "{0} libras"},
+				"speed-kilometer-per-hour": {"one": "{0} kilómetro por hora", "other": "{0} kilómetros por hora"},
+				"temperature-celsius":      {"one": "{0} grado Celsius", "other": "{0} grados Celsius"},
+				"temperature-fahrenheit":   {"one": "{0} grado Fahrenheit", "other": "{0} grados Fahrenheit"},
+				"volume-liter":             {"one": "{0} litro", "other": "{0} litros"},
+			},
+			"narrow": {
+				"concentr-percent":         {"one": "{0} %", "other": "{0} %"},
+				"digital-bit":              {"one": "{0}b", "other": "{0}b"},
+				"digital-byte":             {"one": "{0}B", "other": "{0}B"},
+				"digital-gigabyte":         {"one": "{0}GB", "other": "{0}GB"},
+				"digital-kilobyte":         {"one": "{0}kB", "other": "{0}kB"},
+				"digital-megabyte":         {"one": "{0}MB", "other": "{0}MB"},
+				"digital-petabyte":         {"one": "{0}PB", "other": "{0}PB"},
+				"digital-terabyte":         {"one": "{0}TB", "other": "{0}TB"},
+				"duration-day":             {"one": "{0}d", "other": "{0}d"},
+				"duration-hour":            {"one": "{0}h", "other": "{0}h"},
+				"duration-millisecond":     {"one": "{0}ms", "other": "{0}ms"},
+				"duration-minute":          {"one": "{0}min", "other": "{0}min"},
+				"duration-month":           {"one": "{0}m", "other": "{0}m"},
+				"duration-second":          {"one": "{0}s", "other": "{0}s"},
+				"duration-week":            {"one": "{0}sem", "other": "{0}sem"},
+				"duration-year":            {"one": "{0}a", "other": "{0}a"},
+				"length-centimeter":        {"one": "{0}cm", "other": "{0}cm"},
+				"length-foot":              {"one": "{0}ft", "other": "{0}ft"},
+				"length-inch":              {"one": "{0}in", "other": "{0}in"},
+				"length-kilometer":         {"one": "{0}km", "other": "{0}km"},
+				"length-meter":             {"one": "{0}m", "other": "{0}m"},
+				"length-mile":              {"one": "{0}mi", "other": "{0}mi"},
+				"length-millimeter":        {"one": "{0}mm", "other": "{0}mm"},
+				"mass-gram":                {"one": "{0}g", "other": "{0}g"},
+				"mass-kilogram":            {"one": "{0}kg", "other": "{0}kg"},
+				"mass-pound":               {"one": "{0}lb", "other": "{0}lb"},
+				"speed-kilometer-per-hour": {"one": "{0}km/h", "other": "{0}km/h"},
+				"temperature-celsius":      {"one": "{0}°C", "other": "{0}°C"},
+				"temperature-fahrenheit":   {"one": "{0}°F", "other": "{0}°F"},
+				"volume-liter":             {"one": "{0}l", "other": "{0}l"},
+			},
+			"short": {
+				"concentr-percent":         {"one": "{0} %", "other": "{0} %"},
+				"digital-bit":              {"one": "{0} b", "other": "{0} b"},
+				"digital-byte":             {"one": "{0} B", "other": "{0} B"},
+				"digital-gigabyte":         {"one": "{0} GB", "other": "{0} GB"},
+				"digital-kilobyte":         {"one": "{0} kB", "other": "{0} kB"},
+				"digital-megabyte":         {"one": "{0} MB", "other": "{0} MB"},
+				"digital-petabyte":         {"one": "{0} PB", "other": "{0} PB"},
+				"digital-terabyte":         {"one": "{0} TB", "other": "{0} TB"},
+				"duration-day":             {"one": "{0} d", "other": "{0} d"},
+				"duration-hour":            {"one": "{0} h", "other": "{0} h"},
+				"duration-millisecond":     {"one": "{0} ms", "other": "{0} ms"},
+				"duration-minute":          {"one": "{0} min", "other": "{0} min"},
+				"duration-month":           {"one": "{0} m.", "other": "{0} m."},
+				"duration-second":          {"one": "{0} s", "other": "{0} s"},
+				"duration-week":            {"one": "{0} sem.", "other": "{0} sem."},
+				"duration-year":            {"one": "{0} a", "other": "{0} a"},
+				"length-centimeter":        {"one": "{0} cm", "other": "{0} cm"},
+				"length-foot":              {"one": "{0} ft", "other": "{0} ft"},
+				"length-inch":              {"one": "{0} in", "other": "{0} in"},
+				"length-kilometer":         {"one": "{0} km", "other": "{0} km"},
+				"length-meter":             {"one": "{0} m", "other": "{0} m"},
+				"length-mile":              {"one": "{0} mi", "other": "{0} mi"},
+				"length-millimeter":        {"one": "{0} mm", "other": "{0} mm"},
+				"mass-gram":                {"one": "{0} g", "other": "{0} g"},
+				"mass-kilogram":            {"one": "{0} kg", "other": "{0} kg"},
+				"mass-pound":               {"one": "{0} lb", "other": "{0} lb"},
+				"speed-kilometer-per-hour": {"one": "{0} km/h", "other": "{0} km/h"},
+				"temperature-celsius":      {"one": "{0} °C", "other": "{0} °C"},
+				"temperature-fahrenheit":   {"one": "{0} °F", "other": "{0} °F"},
+				"volume-liter":             {"one": "{0} l", "other": "{0} l"},
+			},
+		},
+	},
+	"fr": {
+		Calendar: &Calendar{
+			MonthsAbbreviated:           [12]string{"janv.", "févr.", "mars", "avr.", "mai", "juin", "juil.", "août", "sept.", "oct.", "nov.", "déc."},
+			MonthsWide:                  [12]string{"janvier", "février", "mars", "avril", "mai", "juin", "juillet", "août", "septembre", "octobre", "novembre", "décembre"},
+			DaysAbbreviated:             [7]string{"dim.", "lun.", "mar.", "mer.", "jeu.", "ven.", "sam."},
+			DaysWide:                    [7]string{"dimanche", "lundi", "mardi", "mercredi", "jeudi", "vendredi", "samedi"},
+			MonthsStandAloneAbbreviated: [12]string{"janv.", "févr.", "mars", "avr.", "mai", "juin", "juil.", "août", "sept.", "oct.", "nov.", "déc."},
+			MonthsStandAloneWide:        [12]string{"janvier", "février", "mars", "avril", "mai", "juin", "juillet", "août", "septembre", "octobre", "novembre", "décembre"},
+			DaysStandAloneAbbreviated:   [7]string{"dim.", "lun.", "mar.", "mer.", "jeu.", "ven.", "sam."},
+			DaysStandAloneWide:          [7]string{"dimanche", "lundi", "mardi", "mercredi", "jeudi", "vendredi", "samedi"},
+			DayPeriods:                  [2]string{"AM", "PM"},
+			ErasAbbreviated:             [2]string{"av. J.-C.", "ap. J.-C."},
+			ErasWide:                    [2]string{"avant Jésus-Christ", "après Jésus-Christ"},
+			ErasNarrow:                  [2]string{"av. J.-C.", "ap. J.-C."},
+			DateFormats:                 Styles{Full: "EEEE d MMMM y", Long: "d MMMM y", Medium: "d MMM y", Short: "dd/MM/y"},
+			TimeFormats:                 Styles{Full: "HH:mm:ss zzzz", Long: "HH:mm:ss z", Medium: "HH:mm:ss", Short: "HH:mm"},
+			DateTimeFormats:             Styles{Full: "{1} à {0}", Long: "{1} à {0}", Medium: "{1}, {0}", Short: "{1} {0}"},
+			AvailableFormats:            map[string]string{"E": "E", "EEEEd": "EEEE d", "Ed": "E d", "H": "HH 'h'", "Hm": "HH:mm", "Hms": "HH:mm:ss", "M": "M", "MEd": "E dd/MM", "MMM": "MMM", "MMMEd": "E d MMM", "MMMM": "MMMM", "MMMMd": "d MMMM", "MMMd": "d MMM", "Md": "dd/MM", "d": "d", "h": "h a", "hm": "h:mm a", "hms": "h:mm:ss a", "ms": "mm:ss", "y": "y", "yM": "MM/y", "yMEd": "E dd/MM/y", "yMMM": "MMM y", "yMMMEd": "E d MMM y", "yMMMM": "MMMM y", "yMMMMEEEEd": "EEEE d MMMM y", "yMMMMd": "d MMMM y", "yMMMd": "d MMM y", "yMd": "dd/MM/y"},
+		},
+		Numbers: &Numbers{
+			Symbols:               Symbols{Decimal: ",", Group: " ", MinusSign: "-"},
+			MinimumGroupingDigits: 1,
+			Compact: map[string]map[int]map[plural.Form]string{
+				"long": {
+					3:  {"1": "mille", "one": "0 millier", "other": "0 mille"},
+					4:  {"other": "00 mille"},
+					5:  {"other": "000 mille"},
+					6:  {"one": "0 million", "other": "0 millions"},
+					7:  {"other": "00 millions"},
+					8:  {"other": "000 millions"},
+					9:  {"one": "0 milliard", "other": "0 milliards"},
+					10: {"other": "00 milliards"},
+					11: {"other": "000 milliards"},
+					12: {"one": "0 billion", "other": "0 billions"},
+					13: {"other": "00 billions"},
+					14: {"other": "000 billions"},
+				},
+				"short": {
+					3:  {"one": "0 k", "other": "0 k"},
+					4:  {"other": "00 k"},
+					5:  {"other": "000 k"},
+					6:  {"one": "0 M", "other": "0 M"},
+					7:  {"other": "00 M"},
+					8:  {"other": "000 M"},
+					9:  {"one": "0 Md", "other": "0 Md"},
+					10: {"other": "00 Md"},
+					11: {"other": "000 Md"},
+					12: {"one": "0 Bn", "other": "0 Bn"},
+					13: {"other": "00 Bn"},
+					14: {"other": "000 Bn"},
+				},
+			},
+		},
+		RelativeTimes: map[string]*RelativeTime{
+			"day": {
+				Relative: map[int]string{-2: "avant-hier", -1: "hier", 0: "aujourd’hui", 1: "demain", 2: "après-demain"},
+				Future:   map[plural.Form]string{"one": "dans {0} jour", "other": "dans {0} jours"},
+				Past:     map[plural.Form]string{"one": "il y a {0} jour", "other": "il y a {0} jours"},
+			},
+			"day-narrow": {
+				Relative: map[int]string{-2: "avant-hier", -1: "hier", 0: "aujourd’hui", 1: "demain", 2: "après-demain"},
+				Future:   map[plural.Form]string{"one": "+{0} j", "other": "+{0} j"},
+				Past:     map[plural.Form]string{"one": "-{0} j", "other": "-{0} j"},
+			},
+			"day-short": {
+				Relative: map[int]string{-2: "avant-hier", -1: "hier", 0: "aujourd’hui", 1: "demain", 2: "après-demain"},
+				Future:   map[plural.Form]string{"one": "dans {0} j", "other": "dans {0} j"},
+				Past:     map[plural.Form]string{"one": "il y a {0} j", "other": "il y a {0} j"},
+			},
+			"hour": {
+				Relative: map[int]string{0: "cette heure-ci"},
+				Future:   map[plural.Form]string{"one": "dans {0} heure", "other": "dans {0} heures"},
+				Past:     map[plural.Form]string{"one": "il y a {0} heure", "other": "il y a {0} heures"},
+			},
+			"hour-narrow": {
+				Relative: map[int]string{0: "cette heure-ci"},
+				Future:   map[plural.Form]string{"one": "+{0} h", "other": "+{0} h"},
+				Past:     map[plural.Form]string{"one": "-{0} h", "other": "-{0} h"},
+			},
+			"hour-short": {
+				Relative: map[int]string{0: "cette heure-ci"},
+				Future:   map[plural.Form]string{"one": "dans {0} h", "other": "dans {0} h"},
+				Past:     map[plural.Form]string{"one": "il y a {0} h", "other": "il y a {0} h"},
+			},
+			"minute": {
+				Relative: map[int]string{0: "cette minute-ci"},
+				Future:   map[plural.Form]string{"one": "dans {0} minute", "other": "dans {0} minutes"},
+				Past:     map[plural.Form]string{"one": "il y a {0} minute", "other": "il y a {0} minutes"},
+			},
+			"minute-narrow": {
+				Relative: map[int]string{0: "cette minute-ci"},
+				Future:   map[plural.Form]string{"one": "+{0} min", "other": "+{0} min"},
+				Past:     map[plural.Form]string{"one": "-{0} min", "other": "-{0} min"},
+			},
+			"minute-short": {
+				Relative: map[int]string{0: "cette minute-ci"},
+				Future:   map[plural.Form]string{"one": "dans {0} min", "other": "dans {0} min"},
+				Past:     map[plural.Form]string{"one": "il y a {0} min", "other": "il y a {0} min"},
+			},
+			"month": {
+				Relative: map[int]string{-1: "le mois dernier", 0: "ce mois-ci", 1: "le mois prochain"},
+				Future:   map[plural.Form]string{"one": "dans {0} mois", "other": "dans {0} mois"},
+				Past:     map[plural.Form]string{"one": "il y a {0} mois", "other": "il y a {0} mois"},
+			},
+			"month-narrow": {
+				Relative: map[int]string{-1: "le mois dernier", 0: "ce mois-ci", 1: "le mois prochain"},
+				Future:   map[plural.Form]string{"one": "+{0} m.", "other": "+{0} m."},
+				Past:     map[plural.Form]string{"one": "-{0} m.", "other": "-{0} m."},
+			},
+			"month-short": {
+				Relative: map[int]string{-1: "le mois dernier", 0: "ce mois-ci", 1: "le mois prochain"},
+				Future:   map[plural.Form]string{"one": "dans {0} m.", "other": "dans {0} m."},
+				Past:     map[plural.Form]string{"one": "il y a {0} m.", "other": "il y a {0} m."},
+			},
+			"quarter": {
+				Relative: map[int]string{-1: "le trimestre dernier", 0: "ce trimestre", 1: "le trimestre prochain"},
+				Future:   map[plural.Form]string{"one": "dans {0} trimestre", "other": "dans {0} trimestres"},
+				Past:     map[plural.Form]string{"one": "il y a {0} trimestre", "other": "il y a {0} trimestres"},
+			},
+			"quarter-narrow": {
+				Relative: map[int]string{-1: "le trimestre dernier", 0: "ce trimestre", 1: "le trimestre prochain"},
+				Future:   map[plural.Form]string{"one": "+{0} trim.", "other": "+{0} trim."},
+				Past:     map[plural.Form]string{"one": "-{0} trim.", "other": "-{0} trim."},
+			},
+			"quarter-short": {
+				Relative: map[int]string{-1: "le trimestre dernier", 0: "ce trimestre", 1: "le trimestre prochain"},
+				Future:   map[plural.Form]string{"one": "dans {0} trim.", "other": "dans {0} trim."},
+				Past:     map[plural.Form]string{"one": "il y a {0} trim.", "other": "il y a {0} trim."},
+			},
+			"second": {
+				Relative: map[int]string{0: "maintenant"},
+				Future:   map[plural.Form]string{"one": "dans {0} seconde", "other": "dans {0} secondes"},
+				Past:     map[plural.Form]string{"one": "il y a {0} seconde", "other": "il y a {0} secondes"},
+			},
+			"second-narrow": {
+				Relative: map[int]string{0: "maintenant"},
+				Future:   map[plural.Form]string{"one": "+{0} s", "other": "+{0} s"},
+				Past:     map[plural.Form]string{"one": "-{0} s", "other": "-{0} s"},
+			},
+			"second-short": {
+				Relative: map[int]string{0: "maintenant"},
+				Future:   map[plural.Form]string{"one": "dans {0} s", "other": "dans {0} s"},
+				Past:     map[plural.Form]string{"one": "il y a {0} s", "other": "il y a {0} s"},
+			},
+			"week": {
+				Relative: map[int]string{-1: "la semaine dernière", 0: "cette semaine", 1: "la semaine prochaine"},
+				Future:   map[plural.Form]string{"one": "dans {0} semaine", "other": "dans {0} semaines"},
+				Past:     map[plural.Form]string{"one": "il y a {0} semaine", "other": "il y a {0} semaines"},
+			},
+			"week-narrow": {
+				Relative: map[int]string{-1: "la semaine dernière", 0: "cette semaine", 1: "la semaine prochaine"},
+				Future:   map[plural.Form]string{"one": "+{0} sem.", "other": "+{0} sem."},
+				Past:     map[plural.Form]string{"one": "-{0} sem.", "other": "-{0} sem."},
+			},
+			"week-short": {
+				Relative: map[int]string{-1: "la semaine dernière", 0: "cette semaine", 1: "la semaine prochaine"},
+				Future:   map[plural.Form]string{"one": "dans {0} sem.", "other": "dans {0} sem."},
+				Past:     map[plural.Form]string{"one": "il y a {0} sem.", "other": "il y a {0} sem."},
+			},
+			"year": {
+				Relative: map[int]string{-1: "l’année dernière", 0: "cette année", 1: "l’année prochaine"},
+				Future:   map[plural.Form]string{"one": "dans {0} an", "other": "dans {0} ans"},
+				Past:     map[plural.Form]string{"one": "il y a {0} an", "other": "il y a {0} ans"},
+			},
+			"year-narrow": {
+				Relative: map[int]string{-1: "l’année dernière", 0: "cette année", 1: "l’année prochaine"},
+				Future:   map[plural.Form]string{"one": "+{0} a", "other": "+{0} a"},
+				Past:     map[plural.Form]string{"one": "-{0} a", "other": "-{0} a"},
+			},
+			"year-short": {
+				Relative: map[int]string{-1: "l’année dernière", 0: "cette année", 1: "l’année prochaine"},
+				Future:   map[plural.Form]string{"one": "dans {0} a", "other": "dans {0} a"},
+				Past:     map[plural.Form]string{"one": "il y a {0} a", "other": "il y a {0} a"},
+			},
+		},
+		ListPatterns: map[string]*ListPattern{
+			"or":              {Start: "{0}, {1}", Middle: "{0}, {1}", End: "{0} ou {1}", Two: "{0} ou {1}"},
+			"or-narrow":       {Start: "{0}, {1}", Middle: "{0}, {1}", End: "{0} ou {1}", Two: "{0} ou {1}"},
+			"or-short":        {Start: "{0}, {1}", Middle: "{0}, {1}", End: "{0} ou {1}", Two: "{0} ou {1}"},
+			"standard":        {Start: "{0}, {1}", Middle: "{0}, {1}", End: "{0} et {1}", Two: "{0} et {1}"},
+			"standard-narrow": {Start: "{0}, {1}", Middle: "{0}, {1}", End: "{0}, {1}", Two: "{0}, {1}"},
+			"standard-short":  {Start: "{0}, {1}", Middle: "{0}, {1}", End: "{0} et {1}", Two: "{0} et {1}"},
+			"unit":            {Start: "{0}, {1}", Middle: "{0}, {1}", End: "{0} et {1}", Two: "{0} et {1}"},
+			"unit-narrow":     {Start: "{0} {1}", Middle: "{0} {1}", End: "{0} {1}", Two: "{0} {1}"},
+			"unit-short":      {Start: "{0}, {1}", Middle: "{0}, {1}", End: "{0} et {1}", Two: "{0} et {1}"},
+		},
+		Units: map[string]map[string]map[plural.Form]string{
+			"long": {
+				"concentr-percent":         {"one": "{0} pour cent", "other": "{0} pour cent"},
+				"digital-bit":              {"one": "{0} bit", "other": "{0} bits"},
+				"digital-byte":             {"one": "{0} octet", "other": "{0} octets"},
+				"digital-gigabyte":         {"one": "{0} gigaoctet", "other": "{0} gigaoctets"},
+				"digital-kilobyte":         {"one": "{0} kilooctet", "other": "{0} kilooctets"},
+				"digital-megabyte":         {"one": "{0} mégaoctet", "other": "{0} mégaoctets"},
+				"digital-petabyte":         {"one": "{0} pétaoctet", "other": "{0} pétaoctets"},
+				"digital-terabyte":         {"one": "{0} téraoctet", "other": "{0} téraoctets"},
+				"duration-day":             {"one": "{0} jour", "other": "{0} jours"},
+				"duration-hour":            {"one": "{0} heure", "other": "{0} heures"},
+				"duration-millisecond":     {"one": "{0} milliseconde", "other": "{0} millisecondes"},
+				"duration-minute":          {"one": "{0} minute", "other": "{0} minutes"},
+				"duration-month":           {"one": "{0} mois", "other": "{0} mois"},
+				"duration-second":          {"one": "{0} seconde", "other": "{0} secondes"},
+				"duration-week":            {"one": "{0} semaine", "other": "{0} semaines"},
+				"duration-year":            {"one": "{0} an", "other": "{0} ans"},
+				"length-centimeter":        {"one": "{0} centimètre", "other": "{0} centimètres"},
+				"length-foot":              {"one": "{0} pied", "other": "{0} pieds"},
+				"length-inch":              {"one": "{0} pouce", "other": "{0} pouces"},
+				"length-kilometer":         {"one": "{0} kilomètre", "other": "{0} kilomètres"},
+				"length-meter":             {"one": "{0} mètre", "other": "{0} mètres"},
+				"length-mile":              {"one": "{0} mile", "other": "{0} miles"},
+				"length-millimeter":        {"one": "{0} millimètre", "other": "{0} millimètres"},
+				"mass-gram":                {"one": "{0} gramme", "other": "{0} grammes"},
+				"mass-kilogram":            {"one": "{0} kilogramme", "other": "{0} kilogrammes"},
+				"mass-pound":               {"one": "{0} livre", "other": "{0} livres"},
+				"speed-kilometer-per-hour": {"one": "{0} kilomètre par heure", "other": "{0} kilomètres par heure"},
+				"temperature-celsius":      {"one": "{0} degré Celsius", "other": "{0} degrés Celsius"},
+				"temperature-fahrenheit":   {"one": "{0} degré Fahrenheit", "other": "{0} degrés Fahrenheit"},
+				"volume-liter":             {"one": "{0} litre", "other": "{0} litres"},
+			},
+			"narrow": {
+				"concentr-percent":         {"one": "{0} %", "other": "{0} %"},
+				"digital-bit":              {"one": "{0}bit", "other": "{0}bit"},
+				"digital-byte":             {"one": "{0}o", "other": "{0}o"},
+				"digital-gigabyte":         {"one": "{0}Go", "other": "{0}Go"},
+				"digital-kilobyte":         {"one": "{0}ko", "other": "{0}ko"},
+				"digital-megabyte":         {"one": "{0}Mo", "other": "{0}Mo"},
+				"digital-petabyte":         {"one": "{0}Po", "other": "{0}Po"},
+				"digital-terabyte":         {"one": "{0}To", "other": "{0}To"},
+				"duration-day":             {"one": "{0}j", "other": "{0}j"},
+				"duration-hour":            {"one": "{0}h", "other": "{0}h"},
+				"duration-millisecond":     {"one": "{0}ms", "other": "{0}ms"},
+				"duration-minute":          {"one": "{0}min", "other": "{0}min"},
+				"duration-month":           {"one": "{0}m.", "other": "{0}m."},
+				"duration-second":          {"one": "{0}s", "other": "{0}s"},
+				"duration-week":            {"one": "{0}sem.", "other": "{0}sem."},
+				"duration-year":            {"one": "{0}a", "other": "{0}a"},
+				"length-centimeter":        {"one": "{0}cm", "other": "{0}cm"},
+				"length-foot":              {"one": "{0}′", "other": "{0}′"},
+				"length-inch":              {"one": "{0}″", "other": "{0}″"},
+				"length-kilometer":         {"one": "{0}km", "other": "{0}km"},
+				"length-meter":             {"one": "{0}m", "other": "{0}m"},
+				"length-mile":              {"one": "{0}mi", "other": "{0}mi"},
+				"length-millimeter":        {"one": "{0}mm", "other": "{0}mm"},
+				"mass-gram":                {"one": "{0}g", "other": "{0}g"},
+				"mass-kilogram":            {"one": "{0}kg", "other": "{0}kg"},
+				"mass-pound":               {"one": "{0}lb", "other": "{0}lb"},
+				"speed-kilometer-per-hour": {"one": "{0}km/h", "other": "{0}km/h"},
+				"temperature-celsius":      {"one": "{0}°C", "other": "{0}°C"},
+				"temperature-fahrenheit":   {"one": "{0}°F", "other": "{0}°F"},
+				"volume-liter":             {"one": "{0}l", "other": "{0}l"},
+			},
+			"short": {
+				"concentr-percent":         {"one": "{0} %", "other": "{0} %"},
+				"digital-bit":              {"one": "{0} bit", "other": "{0} bit"},
+				"digital-byte":             {"one": "{0} o", "other": "{0} o"},
+				"digital-gigabyte":         {"one": "{0} Go", "other": "{0} Go"},
+				"digital-kilobyte":         {"one": "{0} ko", "other": "{0} ko"},
+				"digital-megabyte":         {"one": "{0} Mo", "other": "{0} Mo"},
+				"digital-petabyte":         {"one": "{0} Po", "other": "{0} Po"},
+				"digital-terabyte":         {"one": "{0} To", "other": "{0} To"},
+				"duration-day":             {"one": "{0} j", "other": "{0} j"},
+				"duration-hour":            {"one": "{0} h", "other": "{0} h"},
+				"duration-millisecond":     {"one": "{0} ms", "other": "{0} ms"},
+				"duration-minute":          {"one": "{0} min", "other": "{0} min"},
+				"duration-month":           {"one": "{0} m.", "other": "{0} m."},
+				"duration-second":          {"one": "{0} s", "other": "{0} s"},
+				"duration-week":            {"one": "{0} sem.", "other": "{0} sem."},
+				"duration-year":            {"one": "{0} an", "other": "{0} ans"},
+				"length-centimeter":        {"one": "{0} cm", "other": "{0} cm"},
+				"length-foot":              {"one": "{0} pi", "other": "{0} pi"},
+				"length-inch":              {"one": "{0} po", "other": "{0} po"},
+				"length-kilometer":         {"one": "{0} km", "other": "{0} km"},
+				"length-meter":             {"one": "{0} m", "other": "{0} m"},
+				"length-mile":              {"one": "{0} mi", "other": "{0} mi"},
+				"length-millimeter":        {"one": "{0} mm", "other": "{0} mm"},
+				"mass-gram":                {"one": "{0} g", "other": "{0} g"},
+				"mass-kilogram":            {"one": "{0} kg", "other": "{0} kg"},
+				"mass-pound":               {"one": "{0} lb", "other": "{0} lb"},
+				"speed-kilometer-per-hour": {"one": "{0} km/h", "other": "{0} km/h"},
+				"temperature-celsius":      {"one": "{0} °C", "other": "{0} °C"},
+				"temperature-fahrenheit":   {"one": "{0} °F", "other": "{0} °F"},
+				"volume-liter":             {"one": "{0} l", "other": "{0} l"},
+			},
+		},
+	},
+	"it": {
+		Calendar: &Calendar{
+			MonthsAbbreviated:           [12]string{"gen", "feb", "mar", "apr", "mag", "giu", "lug", "ago", "set", "ott", "nov", "dic"},
+			MonthsWide:                  [12]string{"gennaio", "febbraio", "marzo", "aprile", "maggio", "giugno", "luglio", "agosto", "settembre", "ottobre", "novembre", "dicembre"},
+			DaysAbbreviated:             [7]string{"dom", "lun", "mar", "mer", "gio", "ven", "sab"},
+			DaysWide:                    [7]string{"domenica", "lunedì", "martedì", "mercoledì", "giovedì", "venerdì", "sabato"},
+			MonthsStandAloneAbbreviated: [12]string{"gen", "feb", "mar", "apr", "mag", "giu", "lug", "ago", "set", "ott", "nov", "dic"},
+			MonthsStandAloneWide:        [12]string{"gennaio", "febbraio", "marzo", "aprile", "maggio", "giugno", "luglio", "agosto", "settembre", "ottobre", "novembre", "dicembre"},
+			DaysStandAloneAbbreviated:   [7]string{"dom", "lun", "mar", "mer", "gio", "ven", "sab"},
+			DaysStandAloneWide:          [7]string{"domenica", "lunedì", "martedì", "mercoledì", "giovedì", "venerdì", "sabato"},
+			DayPeriods:                  [2]string{"AM", "PM"},
+			ErasAbbreviated:             [2]string{"a.C.", "d.C."},
+			ErasWide:                    [2]string{"avanti Cristo", "dopo Cristo"},
+			ErasNarrow:                  [2]string{"aC", "dC"},
+			DateFormats:                 Styles{Full: "EEEE d MMMM y", Long: "d MMMM y", Medium: "d MMM y", Short: "dd/MM/yy"},
+			TimeFormats:                 Styles{Full: "HH:mm:ss zzzz", Long: "HH:mm:ss z", Medium: "HH:mm:ss", Short: "HH:mm"},
+			DateTimeFormats:             Styles{Full: "{1} 'alle' 'ore' {0}", Long: "{1} 'alle' 'ore' {0}", Medium: "{1}, {0}", Short: "{1}, {0}"},
+			AvailableFormats:            map[string]string{"E": "E", "EEEEd": "EEEE d", "Ed": "E d", "H": "HH", "Hm": "HH:mm", "Hms": "HH:mm:ss", "M": "M", "MEd": "E dd/MM", "MMM": "MMM", "MMMEd": "E d MMM", "MMMM": "MMMM", "MMMMd": "d MMMM", "MMMd": "d MMM", "Md": "dd/MM", "d": "d", "h": "h a", "hm": "h:mm a", "hms": "h:mm:ss a", "ms": "mm:ss", "y": "y", "yM": "MM/y", "yMEd": "E dd/MM/y", "yMMM": "MMM y", "yMMMEd": "E d MMM y", "yMMMM": "MMMM y", "yMMMMEEEEd": "EEEE d MMMM y", "yMMMMd": "d MMMM y", "yMMMd": "d MMM y", "yMd": "dd/MM/y"},
+		},
+		Numbers: &Numbers{
+			Symbols:               Symbols{Decimal: ",", Group: ".", MinusSign: "-"},
+			MinimumGroupingDigits: 2,
+			Compact: map[string]map[int]map[plural.Form]string{
+				"long": {
+					3:  {"1": "mille", "one": "mille", "other": "0 mila"},
+					4:  {"other": "00 mila"},
+					5:  {"other": "000 mila"},
+					6:  {"one": "0 milione", "other": "0 milioni"},
+					7:  {"other": "00 milioni"},
+					8:  {"other": "000 milioni"},
+					9:  {"one": "0 miliardo", "other": "0 miliardi"},
+					10: {"other": "00 miliardi"},
+					11: {"other": "000 miliardi"},
+					12: {"one": "0 mille miliardi", "other": "0 mila miliardi"},
+					13: {"other": "00 mila miliardi"},
+					14: {"other": "000 mila miliardi"},
+				},
+				"short": {
+					3:  {"other": "0"},
+					4:  {"other": "0"},
+					5:  {"other": "0"},
+					6:  {"one": "0 Mln", "other": "0 Mln"},
+					7:  {"other": "00 Mln"},
+					8:  {"other": "000 Mln"},
+					9:  {"one": "0 Mld", "other": "0 Mld"},
+					10: {"other": "00 Mld"},
+					11: {"other": "000 Mld"},
+					12: {"one": "0 Bln", "other": "0 Bln"},
+					13: {"other": "00 Bln"},
+					14: {"other": "000 Bln"},
+				},
+			},
+		},
+		RelativeTimes: map[string]*RelativeTime{
+			"day": {
+				Relative: map[int]string{-2: "l’altro ieri", -1: "ieri", 0: "oggi", 1: "domani", 2: "dopodomani"},
+				Future:   map[plural.Form]string{"one": "tra {0} giorno", "other": "tra {0} giorni"},
+				Past:     map[plural.Form]string{"one": "{0} giorno fa", "other": "{0} giorni fa"},
+			},
+			"day-narrow": {
+				Relative: map[int]string{-2: "l’altro ieri", -1: "ieri", 0: "oggi", 1: "domani", 2: "dopodomani"},
+				Future:   map[plural.Form]string{"one": "tra {0} g", "other": "tra {0} gg"},
+				Past:     map[plural.Form]string{"one": "{0} g fa", "other": "{0} gg fa"},
+			},
+			"day-short": {
+				Relative: map[int]string{-2: "l’altro ieri", -1: "ieri", 0: "oggi", 1: "domani", 2: "dopodomani"},
+				Future:   map[plural.Form]string{"one": "tra {0} g", "other": "tra {0} gg"},
+				Past:     map[plural.Form]string{"one": "{0} g fa", "other": "{0} gg fa"},
+			},
+			"hour": {
+				Relative: map[int]string{0: "quest’ora"},
+				Future:   map[plural.Form]string{"one": "tra {0} ora", "other": "tra {0} ore"},
+				Past:     map[plural.Form]string{"one": "{0} ora fa", "other": "{0} ore fa"},
+			},
+			"hour-narrow": {
+				Relative: map[int]string{0: "quest’ora"},
+				Future:   map[plural.Form]string{"one": "tra {0} h", "other": "tra {0} h"},
+				Past:     map[plural.Form]string{"one": "{0} h fa", "other": "{0} h fa"},
+			},
+			"hour-short": {
+				Relative: map[int]string{0: "quest’ora"},
+				Future:   map[plural.Form]string{"one": "tra {0} h", "other": "tra {0} h"},
+				Past:     map[plural.Form]string{"one": "{0} h fa", "other": "{0} h fa"},
+			},
+			"minute": {
+				Relative: map[int]string{0: "questo minuto"},
+				Future:   map[plural.Form]string{"one": "tra {0} minuto", "other": "tra {0} minuti"},
+				Past:     map[plural.Form]string{"one": "{0} minuto fa", "other": "{0} minuti fa"},
+			},
+			"minute-narrow": {
+				Relative: map[int]string{0: "questo minuto"},
+				Future:   map[plural.Form]string{"one": "tra {0} min", "other": "tra {0} min"},
+				Past:     map[plural.Form]string{"one": "{0} min fa", "other": "{0} min fa"},
+			},
+			"minute-short": {
+				Relative: map[int]string{0: "questo minuto"},
+				Future:   map[plural.Form]string{"one": "tra {0} min", "other": "tra {0} min"},
+				Past:     map[plural.Form]string{"one": "{0} min fa", "other": "{0} min fa"},
+			},
+			"month": {
+				Relative: map[int]string{-1: "mese scorso", 0: "questo mese", 1: "mese prossimo"},
+				Future:   map[plural.Form]string{"one": "tra {0} mese", "other": "tra {0} mesi"},
+				Past:     map[plural.Form]string{"one": "{0} mese fa", "other": "{0} mesi fa"},
+			},
+			"month-narrow": {
+				Relative: map[int]string{-1: "mese scorso", 0: "questo mese", 1: "mese prossimo"},
+				Future:   map[plural.Form]string{"one": "tra {0} mese", "other": "tra {0} mesi"},
+				Past:     map[plural.Form]string{"one": "{0} mese fa", "other": "{0} mesi fa"},
+			},
+			"month-short": {
+				Relative: map[int]string{-1: "mese scorso", 0: "questo mese", 1: "mese prossimo"},
+				Future:   map[plural.Form]string{"one": "tra {0} mese", "other": "tra {0} mesi"},
+				Past:     map[plural.Form]string{"one": "{0} mese fa", "other": "{0} mesi fa"},
+			},
+			"quarter": {
+				Relative: map[int]string{-1: "trimestre scorso", 0: "questo trimestre", 1: "trimestre prossimo"},
+				Future:   map[plural.Form]string{"one": "tra {0} trimestre", "other": "tra {0} trimestri"},
+				Past:     map[plural.Form]string{"one": "{0} trimestre fa", "other": "{0} trimestri fa"},
+			},
+			"quarter-narrow": {
+				Relative: map[int]string{-1: "trim. scorso", 0: "questo trim.", 1: "trim. prossimo"},
+				Future:   map[plural.Form]string{"one": "tra {0} trim.", "other": "tra {0} trim."},
+				Past:     map[plural.Form]string{"one": "{0} trim. fa", "other": "{0} trim. fa"},
+			},
+			"quarter-short": {
+				Relative: map[int]string{-1: "trim. scorso", 0: "questo trim.", 1: "trim. prossimo"},
+				Future:   map[plural.Form]string{"one": "tra {0} trim.", "other": "tra {0} trim."},
+				Past:     map[plural.Form]string{"one": "{0} trim. fa", "other": "{0} trim. fa"},
+			},
+			"second": {
+				Relative: map[int]string{0: "ora"},
+				Future:   map[plural.Form]string{"one": "tra {0} secondo", "other": "tra {0} secondi"},
+				Past:     map[plural.Form]string{"one": "{0} secondo fa", "other": "{0} secondi fa"},
+			},
+			"second-narrow": {
+				Relative: map[int]string{0: "ora"},
+				Future:   map[plural.Form]string{"one": "tra {0} s", "other": "tra {0} s"},
+				Past:     map[plural.Form]string{"one": "{0} s fa", "other": "{0} s fa"},
+			},
+			"second-short": {
+				Relative: map[int]string{0: "ora"},
+				Future:   map[plural.Form]string{"one": "tra {0} sec.", "other": "tra {0} sec."},
+				Past:     map[plural.Form]string{"one": "{0} sec. fa", "other": "{0} sec. fa"},
+			},
+			"week": {
+				Relative: map[int]string{-1: "settimana scorsa", 0: "questa settimana", 1: "settimana prossima"},
+				Future:   map[plural.Form]string{"one": "tra {0} settimana", "other": "tra {0} settimane"},
+				Past:     map[plural.Form]string{"one": "{0} settimana fa", "other": "{0} settimane fa"},
+			},
+			"week-narrow": {
+				Relative: map[int]string{-1: "sett. scorsa", 0: "questa sett.", 1: "sett. prossima"},
+				Future:   map[plural.Form]string{"one": "tra {0} sett.", "other": "tra {0} sett."},
+				Past:     map[plural.Form]string{"one": "{0} sett. fa", "other": "{0} sett. fa"},
+			},
+			"week-short": {
+				Relative: map[int]string{-1: "sett. scorsa", 0: "questa sett.", 1: "sett. prossima"},
+				Future:   map[plural.Form]string{"one": "tra {0} sett.", "other": "tra {0} sett."},
+				Past:     map[plural.Form]string{"one": "{0} sett. fa", "other": "{0} sett. fa"},
+			},
+			"year": {
+				Relative: map[int]string{-1: "anno scorso", 0: "quest’anno", 1: "anno prossimo"},
+				Future:   map[plural.Form]string{"one": "tra {0} anno", "other": "tra {0} anni"},
+				Past:     map[plural.Form]string{"one": "{0} anno fa", "other": "{0} anni fa"},
+			},
+			"year-narrow": {
+				Relative: map[int]string{-1: "anno scorso", 0: "quest’anno", 1: "anno prossimo"},
+				Future:   map[plural.Form]string{"one": "tra {0} anno", "other": "tra {0} anni"},
+				Past:     map[plural.Form]string{"one": "{0} anno fa", "other": "{0} anni fa"},
+			},
+			"year-short": {
+				Relative: map[int]string{-1: "anno scorso", 0: "quest’anno", 1: "anno prossimo"},
+				Future:   map[plural.Form]string{"one": "tra {0} anno", "other": "tra {0} anni"},
+				Past:     map[plural.Form]string{"one": "{0} anno fa", "other": "{0} anni fa"},
+			},
+		},
+		ListPatterns: map[string]*ListPattern{
+			"or":              {Start: "{0}, {1}", Middle: "{0}, {1}", End: "{0} o {1}", Two: "{0} o {1}"},
+			"or-narrow":       {Start: "{0}, {1}", Middle: "{0}, {1}", End: "{0} o {1}", Two: "{0} o {1}"},
+			"or-short":        {Start: "{0}, {1}", Middle: "{0}, {1}", End: "{0} o {1}", Two: "{0} o {1}"},
+			"standard":        {Start: "{0}, {1}", Middle: "{0}, {1}", End: "{0} e {1}", Two: "{0} e {1}"},
+			"standard-narrow": {Start: "{0}, {1}", Middle: "{0}, {1}", End: "{0} e {1}", Two: "{0} e {1}"},
+			"standard-short":  {Start: "{0}, {1}", Middle: "{0}, {1}", End: "{0} e {1}", Two: "{0} e {1}"},
+			"unit":            {Start: "{0}, {1}", Middle: "{0}, {1}", End: "{0} e {1}", Two: "{0} e {1}"},
+			"unit-narrow":     {Start: "{0} {1}", Middle: "{0} {1}", End: "{0} {1}", Two: "{0} {1}"},
+			"unit-short":      {Start: "{0}, {1}", Middle: "{0}, {1}", End: "{0} e {1}", Two: "{0} e {1}"},
+		},
+		Units: map[string]map[string]map[plural.Form]string{
+			"long": {
+				"concentr-percent":         {"one": "{0} percento", "other": "{0} percento"},
+				"digital-bit":              {"one": "{0} bit", "other": "{0} bit"},
+				"digital-byte":             {"one": "{0} byte", "other": "{0} byte"},
+				"digital-gigabyte":         {"one": "{0} gigabyte", "other": "{0} gigabyte"},
+				"digital-kilobyte":         {"one": "{0} kilobyte", "other": "{0} kilobyte"},
+				"digital-megabyte":         {"one": "{0} megabyte", "other": "{0} megabyte"},
+				"digital-petabyte":         {"one": "{0} petabyte", "other": "{0} petabyte"},
+				"digital-terabyte":         {"one": "{0} terabyte", "other": "{0} terabyte"},
+				"duration-day":             {"one": "{0} giorno", "other": "{0} giorni"},
+				"duration-hour":            {"one": "{0} ora", "other": "{0} ore"},
+				"duration-millisecond":     {"one": "{0} millisecondo", "other": "{0} millisecondi"},
+				"duration-minute":          {"one": "{0} minuto", "other": "{0} minuti"},
+				"duration-month":           {"one": "{0} mese", "other": "{0} mesi"},
+				"duration-second":          {"one": "{0} secondo", "other": "{0} secondi"},
+				"duration-week":            {"one": "{0} settimana", "other": "{0} settimane"},
+				"duration-year":            {"one": "{0} anno", "other": "{0} anni"},
+				"length-centimeter":        {"one": "{0} centimetro", "other": "{0} centimetri"},
+				"length-foot":              {"one": "{0} piede", "other": "{0} piedi"},
+				"length-inch":              {"one": "{0} pollice", "other": "{0} pollici"},
+				"length-kilometer":         {"one": "{0} chilometro", "other": "{0} chilometri"},
+				"length-meter":             {"one": "{0} metro", "other": "{0} metri"},
+				"length-mile":              {"one": "{0} miglio", "other": "{0} miglia"},
+				"length-millimeter":        {"one": "{0} millimetro", "other": "{0} millimetri"},
+				"mass-gram":                {"one": "{0} grammo", "other": "{0} grammi"},
+				"mass-kilogram":            {"one": "{0} chilogrammo", "other": "{0} chilogrammi"},
+				"mass-pound":               {"one": "{0} libbra", "other": "{0} libbre"},
+				"speed-kilometer-per-hour": {"one": "{0} chilometro orario", "other": "{0} chilometri orari"},
+				"temperature-celsius":      {"one": "{0} grado Celsius", "other": "{0} gradi Celsius"},
+				"temperature-fahrenheit":   {"one": "{0} grado Fahrenheit", "other": "{0} gradi Fahrenheit"},
+				"volume-liter":             {"one": "{0} litro", "other": "{0} litri"},
+			},
+			"narrow": {
+				"concentr-percent":         {"one": "{0}%", "other": "{0}%"},
+				"digital-bit":              {"one": "{0}bit", "other": "{0}bit"},
+				"digital-byte":             {"one": "{0}B", "other": "{0}B"},
+				"digital-gigabyte":         {"one": "{0}GB", "other": "{0}GB"},
+				"digital-kilobyte":         {"one": "{0}kB", "other": "{0}kB"},
+				"digital-megabyte":         {"one": "{0}MB", "other": "{0}MB"},
+				"digital-petabyte":         {"one": "{0}PB", "other": "{0}PB"},
+				"digital-terabyte":         {"one": "{0}TB", "other": "{0}TB"},
+				"duration-day":             {"one": "{0}g", "other": "{0}gg"},
+				"duration-hour":            {"one": "{0}h", "other": "{0}h"},
+				"duration-millisecond":     {"one": "{0}ms", "other": "{0}ms"},
+				"duration-minute":          {"one": "{0}min", "other": "{0}min"},
+				"duration-month":           {"one": "{0} mese", "other": "{0} mesi"},
+				"duration-second":          {"one": "{0}s", "other": "{0}s"},
+				"duration-week":            {"one": "{0}sett.", "other": "{0}sett."},
+				"duration-year":            {"one": "{0}anno", "other": "{0}anni"},
+				"length-centimeter":        {"one": "{0}cm", "other": "{0}cm"},
+				"length-foot":              {"one": "{0}ft", "other": "{0}ft"},
+				"length-inch":              {"one": "{0}″", "other": "{0}″"},
+				"length-kilometer":         {"one": "{0}km", "other": "{0}km"},
+				"length-meter":             {"one": "{0}m", "other": "{0}m"},
+				"length-mile":              {"one": "{0}mi", "other": "{0}mi"},
+				"length-millimeter":        {"one": "{0}mm", "other": "{0}mm"},
+				"mass-gram":                {"one": "{0}g", "other": "{0}g"},
+				"mass-kilogram":            {"one": "{0}kg", "other": "{0}kg"},
+				"mass-pound":               {"one": "{0}lb", "other": "{0}lb"},
+				"speed-kilometer-per-hour": {"one": "{0}km/h", "other": "{0}km/h"},
+				"temperature-celsius":      {"one": "{0}°C", "other": "{0}°C"},
+				"temperature-fahrenheit":   {"one": "{0}°F", "other": "{0}°F"},
+				"volume-liter":             {"one": "{0}l", "other": "{0}l"},
+			},
+			"short": {
+				"concentr-percent":         {"one": "{0}%", "other": "{0}%"},
+				"digital-bit":              {"one": "{0} bit", "other": "{0} bit"},
+				"digital-byte":             {"one": "{0} byte", "other": "{0} byte"},
+				"digital-gigabyte":         {"one": "{0} GB", "other": "{0} GB"},
+				"digital-kilobyte":         {"one": "{0} kB", "other": "{0} kB"},
+				"digital-megabyte":         {"one": "{0} MB", "other": "{0} MB"},
+				"digital-petabyte":         {"one": "{0} PB", "other": "{0} PB"},
+				"digital-terabyte":         {"one": "{0} TB", "other": "{0} TB"},
+				"duration-day":             {"one": "{0} giorno", "other": "{0} giorni"},
+				"duration-hour":            {"one": "{0} h", "other": "{0} h"},
+				"duration-millisecond":     {"one": "{0} ms", "other": "{0} ms"},
+				"duration-minute":          {"one": "{0} min", "other": "{0} min"},
+				"duration-month":           {"one": "{0} mese", "other": "{0} mesi"},
+				"duration-second":          {"one": "{0} s", "other": "{0} s"},
+				"duration-week":            {"one": "{0} sett.", "other": "{0} sett."},
+				"duration-year":            {"one": "{0} anno", "other": "{0} anni"},
+				"length-centimeter":        {"one": "{0} cm", "other": "{0} cm"},
+				"length-foot":              {"one": "{0} ft", "other": "{0} ft"},
+				"length-inch":              {"one": "{0} in", "other": "{0} in"},
+				"length-kilometer":         {"one": "{0} km", "other": "{0} km"},
+				"length-meter":             {"one": "{0} m", "other": "{0} m"},
+				"length-mile":              {"one": "{0} mi", "other": "{0} mi"},
+				"length-millimeter":        {"one": "{0} mm", "other": "{0} mm"},
+				"mass-gram":                {"one": "{0} g", "other": "{0} g"},
+				"mass-kilogram":            {"one": "{0} kg", "other": "{0} kg"},
+				"mass-pound":               {"one": "{0} lb", "other": "{0} lb"},
+				"speed-kilometer-per-hour": {"one": "{0} km/h", "other": "{0} km/h"},
+				"temperature-celsius":      {"one": "{0} °C", "other": "{0} °C"},
+				"temperature-fahrenheit":   {"one": "{0} °F", "other": "{0} °F"},
+				"volume-liter":             {"one": "{0} l", "other": "{0} l"},
+			},
+		},
+	},
+	"ja": {
+		Calendar: &Calendar{
+			MonthsAbbreviated:           [12]string{"1月", "2月", "3月", "4月", "5月", "6月", "7月", "8月", "9月", "10月", "11月", "12月"},
+			MonthsWide:                  [12]string{"1月", "2月", "3月", "4月", "5月", "6月", "7月", "8月", "9月", "10月", "11月", "12月"},
+			DaysAbbreviated:             [7]string{"日", "月", "火", "水", "木", "金", "土"},
+			DaysWide:                    [7]string{"日曜日", "月曜日", "火曜日", "水曜日", "木曜日", "金曜日", "土曜日"},
+			MonthsStandAloneAbbreviated: [12]string{"1月", "2月", "3月", "4月", "5月", "6月", "7月", "8月", "9月", "10月", "11月", "12月"},
+			MonthsStandAloneWide:        [12]string{"1月", "2月", "3月", "4月", "5月", "6月", "7月", "8月", "9月", "10月", "11月", "12月"},
+			DaysStandAloneAbbreviated:   [7]string{"日", "月", "火", "水", "木", "金", "土"},
+			DaysStandAloneWide:          [7]string{"日曜日", "月曜日", "火曜日", "水曜日", "木曜日", "金曜日", "土曜日"},
+			DayPeriods:                  [2]string{"午前", "午後"},
+			ErasAbbreviated:             [2]string{"紀元前", "西暦"},
+			ErasWide:                    [2]string{"紀元前", "西暦"},
+			ErasNarrow:                  [2]string{"BC", "AD"},
+			DateFormats:                 Styles{Full: "y年M月d日EEEE", Long: "y年M月d日", Medium: "y/MM/dd", Short: "y/MM/dd"},
+			TimeFormats:                 Styles{Full: "H時mm分ss秒 zzzz", Long: "H:mm:ss z", Medium: "H:mm:ss", Short: "H:mm"},
+			DateTimeFormats:             Styles{Full: "{1} {0}", Long: "{1} {0}", Medium: "{1} {0}", Short: "{1} {0}"},
+			AvailableFormats:            map[string]string{"E": "E", "EEEEd": "d日EEEE", "Ed": "d日(E)", "GyMMMd": "Gy年M月d日", "H": "HH時", "Hm": "HH:mm", "Hms": "HH:mm:ss", "M": "M月", "MEd": "M/d(E)", "MMM": "M月", "MMMEd": "M月d日(E)", "MMMM": "M月", "MMMMd": "M月d日", "MMMd": "M月d日", "Md": "M/d", "d": "d日", "h": "ah時", "hm": "ah:mm", "hms": "ah:mm:ss", "ms": "mm:ss", "y": "y年", "yM": "y/M", "yMEd": "y/M/d(E)", "yMMM": "y年M月", "yMMMEd": "y年M月d日(E)", "yMMMM": "y年M月", "yMMMMEEEEd": "y年M月d日EEEE", "yMMMMd": "y年M月d日", "yMMMd": "y年M月d日", "yMd": "y/M/d"},
+		},
+		Numbers: &Numbers{
+			Symbols:               Symbols{Decimal: ".", Group: ",", MinusSign: "-"},
+			MinimumGroupingDigits: 1,
+			Compact: map[string]map[int]map[plural.Form]string{
+				"long": {
+					3:  {"other": "0"},
+					4:  {"other": "0万"},
+					5:  {"other": "00万"},
+					6:  {"other": "000万"},
+					7:  {"other": "0000万"},
+					8:  {"other": "0億"},
+					9:  {"other": "00億"},
+					10: {"other": "000億"},
+					11: {"other": "0000億"},
+					12: {"other": "0兆"},
+					13: {"other": "00兆"},
+					14: {"other": "000兆"},
+				},
+				"short": {
+					3:  {"other": "0"},
+					4:  {"other": "0万"},
+					5:  {"other": "00万"},
+					6:  {"other": "000万"},
+					7:  {"other": "0000万"},
+					8:  {"other": "0億"},
+					9:  {"other": "00億"},
+					10: {"other": "000億"},
+					11: {"other": "0000億"},
+					12: {"other": "0兆"},
+					13: {"other": "00兆"},
+					14: {"other": "000兆"},
+				},
+			},
+		},
+		RelativeTimes: map[string]*RelativeTime{
+			"day": {
+				Relative: map[int]string{-2: "一昨日", -1: "昨日", 0: "今日", 1: "明日", 2: "明後日"},
+				Future:   map[plural.Form]string{"other": "{0} 日後"},
+				Past:     map[plural.Form]string{"other": "{0} 日前"},
+			},
+			"day-narrow": {
+				Relative: map[int]string{-2: "一昨日", -1: "昨日", 0: "今日", 1: "明日", 2: "明後日"},
+				Future:   map[plural.Form]string{"other": "{0}日後"},
+				Past:     map[plural.Form]string{"other": "{0}日前"},
+			},
+			"day-short": {
+				Relative: map[int]string{-2: "一昨日", -1: "昨日", 0: "今日", 1: "明日", 2: "明後日"},
+				Future:   map[plural.Form]string{"other": "{0} 日後"},
+				Past:     map[plural.Form]string{"other": "{0} 日前"},
+			},
+			"hour": {
+				Relative: map[int]string{0: "1 時間以内"},
+				Future:   map[plural.Form]string{"other": "{0} 時間後"},
+				Past:     map[plural.Form]string{"other": "{0} 時間前"},
+			},
+			"hour-narrow": {
+				Relative: map[int]string{0: "1 時間以内"},
+				Future:   map[plural.Form]string{"other": "{0}時間後"},
+				Past:     map[plural.Form]string{"other": "{0}時間前"},
+			},
+			"hour-short": {
+				Relative: map[int]string{0: "1 時間以内"},
+				Future:   map[plural.Form]string{"other": "{0} 時間後"},
+				Past:     map[plural.Form]string{"other": "{0} 時間前"},
+			},
+			"minute": {
+				Relative: map[int]string{0: "1 分以内"},
+				Future:   map[plural.Form]string{"other": "{0} 分後"},
+				Past:     map[plural.Form]string{"other": "{0} 分前"},
+			},
+			"minute-narrow": {
+				Relative: map[int]string{0: "1 分以内"},
+				Future:   map[plural.Form]string{"other": "{0}分後"},
+				Past:     map[plural.Form]string{"other": "{0}分前"},
+			},
+			"minute-short": {
+				Relative: map[int]string{0: "1 分以内"},
+				Future:   map[plural.Form]string{"other": "{0} 分後"},
+				Past:     map[plural.Form]string{"other": "{0} 分前"},
+			},
+			"month": {
+				Relative: map[int]string{-1: "先月", 0: "今月", 1: "来月"},
+				Future:   map[plural.Form]string{"other": "{0} か月後"},
+				Past:     map[plural.Form]string{"other": "{0} か月前"},
+			},
+			"month-narrow": {
+				Relative: map[int]string{-1: "先月", 0: "今月", 1: "来月"},
+				Future:   map[plural.Form]string{"other": "{0}か月後"},
+				Past:     map[plural.Form]string{"other": "{0}か月前"},
+			},
+			"month-short": {
+				Relative: map[int]string{-1: "先月", 0: "今月", 1: "来月"},
+				Future:   map[plural.Form]string{"other": "{0} か月後"},
+				Past:     map[plural.Form]string{"other": "{0} か月前"},
+			},
+			"quarter": {
+				Relative: map[int]string{-1: "前四半期", 0: "今四半期", 1: "翌四半期"},
+				Future:   map[plural.Form]string{"other": "{0} 四半期後"},
+				Past:     map[plural.Form]string{"other": "{0} 四半期前"},
+			},
+			"quarter-narrow": {
+				Relative: map[int]string{-1: "前四半期", 0: "今四半期", 1: "翌四半期"},
+				Future:   map[plural.Form]string{"other": "{0}四半期後"},
+				Past:     map[plural.Form]string{"other": "{0}四半期前"},
+			},
+			"quarter-short": {
+				Relative: map[int]string{-1: "前四半期", 0: "今四半期", 1: "翌四半期"},
+				Future:   map[plural.Form]string{"other": "{0} 四半期後"},
+				Past:     map[plural.Form]string{"other": "{0} 四半期前"},
+			},
+			"second": {
+				Relative: map[int]string{0: "今"},
+				Future:   map[plural.Form]string{"other": "{0} 秒後"},
+				Past:     map[plural.Form]string{"other": "{0} 秒前"},
+			},
+			"second-narrow": {
+				Relative: map[int]string{0: "今"},
+				Future:   map[plural.Form]string{"other": "{0}秒後"},
+				Past:     map[plural.Form]string{"other": "{0}秒前"},
+			},
+			"second-short": {
+				Relative: map[int]string{0: "今"},
+				Future:   map[plural.Form]string{"other": "{0} 秒後"},
+				Past:     map[plural.Form]string{"other": "{0} 秒前"},
+			},
+			"week": {
+				Relative: map[int]string{-1: "先週", 0: "今週", 1: "来週"},
+				Future:   map[plural.Form]string{"other": "{0} 週間後"},
+				Past:     map[plural.Form]string{"other": "{0} 週間前"},
+			},
+			"week-narrow": {
+				Relative: map[int]string{-1: "先週", 0: "今週", 1: "来週"},
+				Future:   map[plural.Form]string{"other": "{0}週間後"},
+				Past:     map[plural.Form]string{"other": "{0}週間前"},
+			},
+			"week-short": {
+				Relative: map[int]string{-1: "先週", 0: "今週", 1: "来週"},
+				Future:   map[plural.Form]string{"other": "{0} 週間後"},
+				Past:     map[plural.Form]string{"other": "{0} 週間前"},
+			},
+			"year": {
+				Relative: map[int]string{-1: "昨年", 0: "今年", 1: "来年"},
+				Future:   map[plural.Form]string{"other": "{0} 年後"},
+				Past:     map[plural.Form]string{"other": "{0} 年前"},
+			},
+			"year-narrow": {
+				Relative: map[int]string{-1: "昨年", 0: "今年", 1: "来年"},
+				Future:   map[plural.Form]string{"other": "{0}年後"},
+				Past:     map[plural.Form]string{"other": "{0}年前"},
+			},
+			"year-short": {
+				Relative: map[int]string{-1: "昨年", 0: "今年", 1: "来年"},
+				Future:   map[plural.Form]string{"other": "{0} 年後"},
+				Past:     map[plural.Form]string{"other": "{0} 年前"},
+			},
+		},
+		ListPatterns: map[string]*ListPattern{
+			"or":              {Start: "{0}、{1}", Middle: "{0}、{1}", End: "{0}、または{1}", Two: "{0}または{1}"},
+			"or-narrow":       {Start: "{0}、{1}", Middle: "{0}、{1}", End: "{0}、または{1}", Two: "{0}または{1}"},
+			"or-short":        {Start: "{0}、{1}", Middle: "{0}、{1}", End: "{0}、または{1}", Two: "{0}または{1}"},
+			"standard":        {Start: "{0}、{1}", Middle: "{0}、{1}", End: "{0}、{1}", Two: "{0}、{1}"},
+			"standard-narrow": {Start: "{0}、{1}", Middle: "{0}、{1}", End: "{0}、{1}", Two: "{0}、{1}"},
+			"standard-short":  {Start: "{0}、{1}", Middle: "{0}、{1}", End: "{0}、{1}", Two: "{0}、{1}"},
+			"unit":            {Start: "{0} {1}", Middle: "{0} {1}", End: "{0} {1}", Two: "{0} {1}"},
+			"unit-narrow":     {Start: "{0}{1}", Middle: "{0}{1}", End: "{0}{1}", Two: "{0}{1}"},
+			"unit-short":      {Start: "{0} {1}", Middle: "{0} {1}", End: "{0} {1}", Two: "{0} {1}"},
+		},
+		Units: map[string]map[string]map[plural.Form]string{
+			"long": {
+				"concentr-percent":         {"other": "{0} パーセント"},
+				"digital-bit":              {"other": "{0} ビット"},
+				"digital-byte":             {"other": "{0} バイト"},
+				"digital-gigabyte":         {"other": "{0} ギガバイト"},
+				"digital-kilobyte":         {"other": "{0} キロバイト"},
+				"digital-megabyte":         {"other": "{0} メガバイト"},
+				"digital-petabyte":         {"other": "{0} ペタバイト"},
+				"digital-terabyte":         {"other": "{0} テラバイト"},
+				"duration-day":             {"other": "{0} 日"},
+				"duration-hour":            {"other": "{0} 時間"},
+				"duration-millisecond":     {"other": "{0} ミリ秒"},
+				"duration-minute":          {"other": "{0} 分"},
+				"duration-month":           {"other": "{0} か月"},
+				"duration-second":          {"other": "{0} 秒"},
+				"duration-week":            {"other": "{0} 週間"},
+				"duration-year":            {"other": "{0} 年"},
+				"length-centimeter":        {"other": "{0} センチメートル"},
+				"length-foot":              {"other": "{0} フィート"},
+				"length-inch":              {"other": "{0} インチ"},
+				"length-kilometer":         {"other": "{0} キロメートル"},
+				"length-meter":             {"other": "{0} メートル"},
+				"length-mile":              {"other": "{0} マイル"},
+				"length-millimeter":        {"other": "{0} ミリメートル"},
+				"mass-gram":                {"other": "{0} グラム"},
+				"mass-kilogram":            {"other": "{0} キログラム"},
+				"mass-pound":               {"other": "{0} ポンド"},
+				"speed-kilometer-per-hour": {"other": "時速 {0} キロメートル"},
+				"temperature-celsius":      {"other": "摂氏 {0} 度"},
+				"temperature-fahrenheit":   {"other": "華氏 {0} 度"},
+				"volume-liter":             {"other": "{0} リットル"},
+			},
+			"narrow": {
+				"concentr-percent":         {"other": "{0}%"},
+				"digital-bit":              {"other": "{0}b"},
+				"digital-byte":             {"other": "{0}B"},
+				"digital-gigabyte":         {"other": "{0}GB"},
+				"digital-kilobyte":         {"other": "{0}KB"},
+				"digital-megabyte":         {"other": "{0}MB"},
+				"digital-petabyte":         {"other": "{0}PB"},
+				"digital-terabyte":         {"other": "{0}TB"},
+				"duration-day":             {"other": "{0}d"},
+				"duration-hour":            {"other": "{0}h"},
+				"duration-millisecond":     {"other": "{0}ms"},
+				"duration-minute":          {"other": "{0}m"},
+				"duration-month":           {"other": "{0}m"},
+				"duration-second":          {"other": "{0}s"},
+				"duration-week":            {"other": "{0}w"},
+				"duration-year":            {"other": "{0}y"},
+				"length-centimeter":        {"other": "{0}cm"},
+				"length-foot":              {"other": "{0}′"},
+				"length-inch":              {"other": "{0}″"},
+				"length-kilometer":         {"other": "{0}km"},
+				"length-meter":             {"other": "{0}m"},
+				"length-mile":              {"other": "{0}mi"},
+				"length-millimeter":        {"other": "{0}mm"},
+				"mass-gram":                {"other": "{0}g"},
+				"mass-kilogram":            {"other": "{0}kg"},
+				"mass-pound":               {"other": "{0}lb"},
+				"speed-kilometer-per-hour": {"other": "{0}km/h"},
+				"temperature-celsius":      {"other": "{0}°C"},
+				"temperature-fahrenheit":   {"other": "{0}°F"},
+				"volume-liter":             {"other": "{0}L"},
+			},
+			"short": {
+				"concentr-percent":         {"other": "{0}%"},
+				"digital-bit":              {"other": "{0} bit"},
+				"digital-byte":             {"other": "{0} byte"},
+				"digital-gigabyte":         {"other": "{0} GB"},
+				"digital-kilobyte":         {"other": "{0} KB"},
+				"digital-megabyte":         {"other": "{0} MB"},
+				"digital-petabyte":         {"other": "{0} PB"},
+				"digital-terabyte":         {"other": "{0} TB"},
+				"duration-day":             {"other": "{0} 日"},
+				"duration-hour":            {"other": "{0} 時間"},
+				"duration-millisecond":     {"other": "{0} ms"},
+				"duration-minute":          {"other": "{0} 分"},
+				"duration-month":           {"other": "{0} か月"},
+				"duration-second":          {"other": "{0} 秒"},
+				"duration-week":            {"other": "{0} 週間"},
+				"duration-year":            {"other": "{0} 年"},
+				"length-centimeter":        {"other": "{0} cm"},
+				"length-foot":              {"other": "{0} ft"},
+				"length-inch":              {"other": "{0} in"},
+				"length-kilometer":         {"other": "{0} km"},
+				"length-meter":             {"other": "{0} m"},
+				"length-mile":              {"other": "{0} mi"},
+				"length-millimeter":        {"other": "{0} mm"},
+				"mass-gram":                {"other": "{0} g"},
+				"mass-kilogram":            {"other": "{0} kg"},
+				"mass-pound":               {"other": "{0} lb"},
+				"speed-kilometer-per-hour": {"other": "{0} km/h"},
+				"temperature-celsius":      {"other": "{0}°C"},
+				"temperature-fahrenheit":   {"other": "{0}°F"},
+				"volume-liter":             {"other": "{0} L"},
+			},
+		},
+	},
+	"pl": {
+		Calendar: &Calendar{
+			MonthsAbbreviated:           [12]string{"sty", "lut", "mar", "kwi", "maj", "cze", "lip", "sie", "wrz", "paź", "lis", "gru"},
+			MonthsWide:                  [12]string{"stycznia", "lutego", "marca", "kwietnia", "maja", "czerwca", "lipca", "sierpnia", "września", "października", "listopada", "grudnia"},
+			DaysAbbreviated:             [7]string{"niedz.", "pon.", "wt.", "śr.", "czw.", "pt.", "sob."},
+			DaysWide:                    [7]string{"niedziela", "poniedziałek", "wtorek", "środa", "czwartek", "piątek", "sobota"},
+			MonthsStandAloneAbbreviated: [12]string{"sty", "lut", "mar", "kwi", "maj", "cze", "lip", "sie", "wrz", "paź", "lis", "gru"},
+			MonthsStandAloneWide:        [12]string{"styczeń", "luty", "marzec", "kwiecień", "maj", "czerwiec", "lipiec", "sierpień", "wrzesień", "październik", "listopad", "grudzień"},
+			DaysStandAloneAbbreviated:   [7]string{"niedz.", "pon.", "wt.", "śr.", "czw.", "pt.", "sob."},
+			DaysStandAloneWide:          [7]string{"niedziela", "poniedziałek", "wtorek", "środa", "czwartek", "piątek", "sobota"},
+			DayPeriods:                  [2]string{"AM", "PM"},
+			ErasAbbreviated:             [2]string{"p.n.e.", "n.e."},
+			ErasWide:                    [2]string{"przed naszą erą", "naszej ery"},
+			ErasNarrow:                  [2]string{"p.n.e.", "n.e."},
+			DateFormats:                 Styles{Full: "EEEE, d MMMM y", Long: "d MMMM y", Medium: "d MMM y", Short: "d.MM.y"},
+			TimeFormats:                 Styles{Full: "HH:mm:ss zzzz", Long: "HH:mm:ss z", Medium: "HH:mm:ss", Short: "HH:mm"},
+			DateTimeFormats:             Styles{Full: "{1} {0}", Long: "{1} {0}", Medium: "{1}, {0}", Short: "{1}, {0}"},
+			AvailableFormats:            map[string]string{"E": "E", "EEEEd": "EEEE, d", "Ed": "E, d", "H": "HH", "Hm": "HH:mm", "Hms": "HH:mm:ss", "M": "M", "MEd": "E, d.MM", "MMM": "LLL", "MMMEd": "E, d MMM", "MMMM": "LLLL", "MMMMd": "d MMMM", "MMMd": "d MMM", "Md": "d.MM", "d": "d", "h": "h a", "hm": "h:mm a", "hms": "h:mm:ss a", "ms": "mm:ss", "y": "y", "yM": "MM.y", "yMEd": "E, d.MM.y", "yMMM": "LLL y", "yMMMEd": "E, d MMM y", "yMMMM": "LLLL y", "yMMMMEEEEd": "EEEE, d MMMM y", "yMMMMd": "d MMMM y", "yMMMd": "d MMM y", "yMd": "d.MM.y"},
+		},
+		Numbers: &Numbers{
+			Symbols:               Symbols{Decimal: ",", Group: " ", MinusSign: "-"},
+			MinimumGroupingDigits: 2,
+			Compact: map[string]map[int]map[plural.Form]string{
+				"long": {
+					3:  {"few": "0 tysiące", "many": "0 tysięcy", "one": "0 tysiąc", "other": "0 tysiąca"},
+					4:  {"few": "00 tysiące", "many": "00 tysięcy", "other": "00 tysiąca"},
+					5:  {"few": "000 tysiące", "many": "000 tysięcy", "other": "000 tysiąca"},
+					6:  {"few": "0 miliony", "many": "0 milionów", "one": "0 milion", "other": "0 miliona"},
+					7:  {"few": "00 miliony", "many": "00 milionów", "other": "00 miliona"},
+					8:  {"few": "000 miliony", "many": "000 milionów", "other": "000 miliona"},
+					9:  {"few": "0 miliardy", "many": "0 miliardów", "one": "0 miliard", "other": "0 miliarda"},
+					10: {"few": "00 miliardy", "many": "00 miliardów", "other": "00 miliarda"},
+					11: {"few": "000 miliardy", "many": "000 miliardów", "other": "000 miliarda"},
+					12: {"few": "0 biliony", "many": "0 bilionów", "one": "0 bilion", "other": "0 biliona"},
+					13: {"few": "00 biliony", "many": "00 bilionów", "other": "00 biliona"},
+					14: {"few": "000 biliony", "many": "000 bilionów", "other": "000 biliona"},
+				},
+				"short": {
+					3:  {"few": "0 tys.", "many": "0 tys.", "one": "0 tys.", "other": "0 tys."},
+					4:  {"few": "00 tys.", "many": "00 tys.", "other": "00 tys."},
+					5:  {"few": "000 tys.", "many": "000 tys.", "other": "000 tys."},
+					6:  {"few": "0 mln", "many": "0 mln", "one": "0 mln", "other": "0 mln"},
+					7:  {"few": "00 mln", "many": "00 mln", "other": "00 mln"},
+					8:  {"few": "000 mln", "many": "000 mln", "other": "000 mln"},
+					9:  {"few": "0 mld", "many": "0 mld", "one": "0 mld", "other": "0 mld"},
+					10: {"few": "00 mld", "many": "00 mld", "other": "00 mld"},
+					11: {"few": "000 mld", "many": "000 mld", "other": "000 mld"},
+					12: {"few": "0 bln", "many": "0 bln", "one": "0 bln", "other": "0 bln"},
+					13: {"few": "00 bln", "many": "00 bln", "other": "00 bln"},
+					14: {"few": "000 bln", "many": "000 bln", "other": "000 bln"},
+				},
+			},
+		},
+		RelativeTimes: map[string]*RelativeTime{
+			"day": {
+				Relative: map[int]string{-2: "przedwczoraj", -1: "wczoraj", 0: "dzisiaj", 1: "jutro", 2: "pojutrze"},
+				Future:   map[plural.Form]string{"few": "za {0} dni", "many": "za {0} dni", "one": "za {0} dzień", "other": "za {0} dnia"},
+				Past:     map[plural.Form]string{"few": "{0} dni temu", "many": "{0} dni temu", "one": "{0} dzień temu", "other": "{0} dnia temu"},
+			},
+			"day-narrow": {
+				Relative: map[int]string{-2: "przedwczoraj", -1: "wcz.", 0: "dziś", 1: "jutro", 2: "pojutrze"},
+				Future:   map[plural.Form]string{"few": "za {0} dni", "many": "za {0} dni", "one": "za {0} dzień", "other": "za {0} dnia"},
+				Past:     map[plural.Form]string{"few": "{0} dni temu", "many": "{0} dni temu", "one": "{0} dzień temu", "other": "{0} dnia temu"},
+			},
+			"day-short": {
+				Relative: map[int]string{-2: "przedwczoraj", -1: "wczoraj", 0: "dzisiaj", 1: "jutro", 2: "pojutrze"},
+				Future:   map[plural.Form]string{"few": "za {0} dni", "many": "za {0} dni", "one": "za {0} dzień", "other": "za {0} dnia"},
+				Past:     map[plural.Form]string{"few": "{0} dni temu", "many": "{0} dni temu", "one": "{0} dzień temu", "other": "{0} dnia temu"},
+			},
+			"hour": {
+				Relative: map[int]string{0: "ta godzina"},
+				Future:   map[plural.Form]string{"few": "za {0} godziny", "many": "za {0} godzin", "one": "za {0} godzinę", "other": "za {0} godziny"},
+				Past:     map[plural.Form]string{"few": "{0} godziny temu", "many": "{0} godzin temu", "one": "{0} godzinę temu", "other": "{0} godziny temu"},
+			},
+			"hour-narrow": {
+				Relative: map[int]string{0: "ta godzina"},
+				Future:   map[plural.Form]string{"few": "za {0} g.", "many": "za {0} g.", "one": "za {0} g.", "other": "za {0} g."},
+				Past:     map[plural.Form]string{"few": "{0} g. temu", "many": "{0} g. temu", "one": "{0} g. temu", "other": "{0} g. temu"},
+			},
+			"hour-short": {
+				Relative: map[int]string{0: "ta godzina"},
+				Future:   map[plural.Form]string{"few": "za {0} godz.", "many": "za {0} godz.", "one": "za {0} godz.", "other": "za {0} godz."},
+				Past:     map[plural.Form]string{"few": "{0} godz. temu", "many": "{0} godz. temu", "one": "{0} godz. temu", "other": "{0} godz. temu"},
+			},
+			"minute": {
+				Relative: map[int]string{0: "ta minuta"},
+				Future:   map[plural.Form]string{"few": "za {0} minuty", "many": "za {0} minut", "one": "za {0} minutę", "other": "za {0} minuty"},
+				Past:     map[plural.Form]string{"few": "{0} minuty temu", "many": "{0} minut temu", "one": "{0} minutę temu", "other": "{0} minuty temu"},
+			},
+			"minute-narrow": {
+				Relative: map[int]string{0: "ta minuta"},
+				Future:   map[plural.Form]string{"few": "za {0} min", "many": "za {0} min", "one": "za {0} min", "other": "za {0} min"},
+				Past:     map[plural.Form]string{"few": "{0} min temu", "many": "{0} min temu", "one": "{0} min temu", "other": "{0} min temu"},
+			},
+			"minute-short": {
+				Relative: map[int]string{0: "ta minuta"},
+				Future:   map[plural.Form]string{"few": "za {0} min", "many": "za {0} min", "one": "za {0} min", "other": "za {0} min"},
+				Past:     map[plural.Form]string{"few": "{0} min temu", "many": "{0} min temu", "one": "{0} min temu", "other": "{0} min temu"},
+			},
+			"month": {
+				Relative: map[int]string{-1: "w zeszłym miesiącu", 0: "w tym miesiącu", 1: "w przyszłym miesiącu"},
+				Future:   map[plural.Form]string{"few": "za {0} miesiące", "many": "za {0} miesięcy", "one": "za {0} miesiąc", "other": "za {0} miesiąca"},
+				Past:     map[plural.Form]string{"few": "{0} miesiące temu", "many": "{0} miesięcy temu", "one": "{0} miesiąc temu", "other": "{0} miesiąca temu"},
+			},
+			"month-narrow": {
+				Relative: map[int]string{-1: "w zeszłym mies.", 0: "w tym mies.", 1: "w przyszłym mies."},
+				Future:   map[plural.Form]string{"few": "za {0} mies.", "many": "za {0} mies.", "one": "za {0} mies.", "other": "za {0} mies."},
+				Past:     map[plural.Form]string{"few": "{0} mies. temu", "many": "{0} mies. temu", "one": "{0} mies. temu", "other": "{0} mies. temu"},
+			},
+			"month-short": {
+				Relative: map[int]string{-1: "w zeszłym mies.", 0: "w tym mies.", 1: "w przyszłym mies."},
+				Future:   map[plural.Form]string{"few": "za {0} mies.", "many": "za {0} mies.", "one": "za {0} mies.", "other": "za {0} mies."},
+				Past:     map[plural.Form]string{"few": "{0} mies. temu", "many": "{0} mies. temu", "one": "{0} mies. temu", "other": "{0} mies. temu"},
+			},
+			"quarter": {
+				Relative: map[int]string{-1: "w zeszłym kwartale", 0: "w tym kwartale", 1: "w przyszłym kwartale"},
+				Future:   map[plural.Form]string{"few": "za {0} kwartały", "many": "za {0} kwartałów", "one": "za {0} kwartał", "other": "za {0} kwartału"},
+				Past:     map[plural.Form]string{"few": "{0} kwartały temu", "many": "{0} kwartałów temu", "one": "{0} kwartał temu", "other": "{0} kwartału temu"},
+			},
+			"quarter-narrow": {
+				Relative: map[int]string{-1: "w zeszłym kwartale", 0: "w tym kwartale", 1: "w przyszłym kwartale"},
+				Future:   map[plural.Form]string{"few": "za {0} kw.", "many": "za {0} kw.", "one": "za {0} kw.", "other": "za {0} kw."},
+				Past:     map[plural.Form]string{"few": "{0} kw. temu", "many": "{0} kw. temu", "one": "{0} kw. temu", "other": "{0} kw. temu"},
+			},
+			"quarter-short": {
+				Relative: map[int]string{-1: "w zeszłym kwartale", 0: "w tym kwartale", 1: "w przyszłym kwartale"},
+				Future:   map[plural.Form]string{"few": "za {0} kw.", "many": "za {0} kw.", "one": "za {0} kw.", "other": "za {0} kw."},
+				Past:     map[plural.Form]string{"few": "{0} kw. temu", "many": "{0} kw. temu", "one": "{0} kw. temu", "other": "{0} kw. temu"},
+			},
+			"second": {
+				Relative: map[int]string{0: "teraz"},
+				Future:   map[plural.Form]string{"few": "za {0} sekundy", "many": "za {0} sekund", "one": "za {0} sekundę", "other": "za {0} sekundy"},
+				Past:     map[plural.Form]string{"few": "{0} sekundy temu", "many": "{0} sekund temu", "one": "{0} sekundę temu", "other": "{0} sekundy temu"},
+			},
+			"second-narrow": {
+				Relative: map[int]string{0: "teraz"},
+				Future:   map[plural.Form]string{"few": "za {0} s", "many": "za {0} s", "one": "za {0} s", "other": "za {0} s"},
+				Past:     map[plural.Form]string{"few": "{0} s temu", "many": "{0} s temu", "one": "{0} s temu", "other": "{0} s temu"},
+			},
+			"second-short": {
+				Relative: map[int]string{0: "teraz"},
+				Future:   map[plural.Form]string{"few": "za {0} sek.", "many": "za {0} sek.", "one": "za {0} sek.", "other": "za {0} sek."},
+				Past:     map[plural.Form]string{"few": "{0} sek. temu", "many": "{0} sek. temu", "one": "{0} sek. temu", "other": "{0} sek. temu"},
+			},
+			"week": {
+				Relative: map[int]string{-1: "w zeszłym tygodniu", 0: "w tym tygodniu", 1: "w przyszłym tygodniu"},
+				Future:   map[plural.Form]string{"few": "za {0} tygodnie", "many": "za {0} tygodni", "one": "za {0} tydzień", "other": "za {0} tygodnia"},
+				Past:     map[plural.Form]string{"few": "{0} tygodnie temu", "many": "{0} tygodni temu", "one": "{0} tydzień temu", "other": "{0} tygodnia temu"},
+			},
+			"week-narrow": {
+				Relative: map[int]string{-1: "w zeszłym tyg.", 0: "w tym tyg.", 1: "w przyszłym tyg."},
+				Future:   map[plural.Form]string{"few": "za {0} tyg.", "many": "za {0} tyg.", "one": "za {0} tydz.", "other": "za {0} tyg."},
+				Past:     map[plural.Form]string{"few": "{0} tyg. temu", "many": "{0} tyg. temu", "one": "{0} tydz. temu", "other": "{0} tyg. temu"},
+			},
+			"week-short": {
+				Relative: map[int]string{-1: "w zeszłym tyg.", 0: "w tym tyg.", 1: "w przyszłym tyg."},
+				Future:   map[plural.Form]string{"few": "za {0} tyg.", "many": "za {0} tyg.", "one": "za {0} tydz.", "other": "za {0} tyg."},
+				Past:     map[plural.Form]string{"few": "{0} tyg. temu", "many": "{0} tyg. temu", "one": "{0} tydz. temu", "other": "{0} tyg. temu"},
+			},
+			"year": {
+				Relative: map[int]string{-1: "w zeszłym roku", 0: "w tym roku", 1: "w przyszłym roku"},
+				Future:   map[plural.Form]string{"few": "za {0} lata", "many": "za {0} lat", "one": "za {0} rok", "other": "za {0} roku"},
+				Past:     map[plural.Form]string{"few": "{0} lata temu", "many": "{0} lat temu", "one": "{0} rok temu", "other": "{0} roku temu"},
+			},
+			"year-narrow": {
+				Relative: map[int]string{-1: "w zeszłym roku", 0: "w tym roku", 1: "w przyszłym roku"},
+				Future:   map[plural.Form]string{"few": "za {0} lata", "many": "za {0} lat", "one": "za {0} rok", "other": "za {0} roku"},
+				Past:     map[plural.Form]string{"few": "{0} lata temu", "many": "{0} lat temu", "one": "{0} rok temu", "other": "{0} roku temu"},
+			},
+			"year-short": {
+				Relative: map[int]string{-1: "w zeszłym roku", 0: "w tym roku", 1: "w przyszłym roku"},
+				Future:   map[plural.Form]string{"few": "za {0} lata", "many": "za {0} lat", "one": "za {0} rok", "other": "za {0} roku"},
+				Past:     map[plural.Form]string{"few": "{0} lata temu", "many": "{0} lat temu", "one": "{0} rok temu", "other": "{0} roku temu"},
+			},
+		},
+		ListPatterns: map[string]*ListPattern{
+			"or":              {Start: "{0}, {1}", Middle: "{0}, {1}", End: "{0} lub {1}", Two: "{0} lub {1}"},
+			"or-narrow":       {Start: "{0}, {1}", Middle: "{0}, {1}", End: "{0} lub {1}", Two: "{0} lub {1}"},
+			"or-short":        {Start: "{0}, {1}", Middle: "{0}, {1}", End: "{0} lub {1}", Two: "{0} lub {1}"},
+			"standard":        {Start: "{0}, {1}", Middle: "{0}, {1}", End: "{0} i {1}", Two: "{0} i {1}"},
+			"standard-narrow": {Start: "{0}, {1}", Middle: "{0}, {1}", End: "{0} i {1}", Two: "{0} i {1}"},
+			"standard-short":  {Start: "{0}, {1}", Middle: "{0}, {1}", End: "{0} i {1}", Two: "{0} i {1}"},
+			"unit":            {Start: "{0}, {1}", Middle: "{0}, {1}", End: "{0} i {1}", Two: "{0} i {1}"},
+			"unit-narrow":     {Start: "{0}, {1}", Middle: "{0}, {1}", End: "{0} i {1}", Two: "{0} i {1}"},
+			"unit-short":      {Start: "{0}, {1}", Middle: "{0}, {1}", End: "{0} i {1}", Two: "{0} i {1}"},
+		},
+		Units: map[string]map[string]map[plural.Form]string{
+			"long": {
+				"concentr-percent":         {"few": "{0} procent", "many": "{0} procent", "one": "{0} procent", "other": "{0} procent"},
+				"digital-bit":              {"few": "{0} bity", "many": "{0} bitów", "one": "{0} bit", "other": "{0} bita"},
+				"digital-byte":             {"few": "{0} bajty", "many": "{0} bajtów", "one": "{0} bajt", "other": "{0} bajta"},
+				"digital-gigabyte":         {"few": "{0} gigabajty", "many": "{0} gigabajtów", "one": "{0} gigabajt", "other": "{0} gigabajta"},
+				"digital-kilobyte":         {"few": "{0} kilobajty", "many": "{0} kilobajtów", "one": "{0} kilobajt", "other": "{0} kilobajta"},
+				"digital-megabyte":         {"few": "{0} megabajty", "many": "{0} megabajtów", "one": "{0} megabajt", "other": "{0} megabajta"},
+				"digital-petabyte":         {"few": "{0} petabajty", "many": "{0} petabajtów", "one": "{0} petabajt", "other": "{0} petabajta"},
+				"digital-terabyte":         {"few": "{0} terabajty", "many": "{0} terabajtów", "one": "{0} terabajt", "other": "{0} terabajta"},
+				"duration-day":             {"few": "{0} dni", "many": "{0} dni", "one": "{0} dzień", "other": "{0} dnia"},
+				"duration-hour":            {"few": "{0} godziny", "many": "{0} godzin", "one": "{0} godzina", "other": "{0} godziny"},
+				"duration-millisecond":     {"few": "{0} milisekundy", "many": "{0} milisekund", "one": "{0} milisekunda", "other": "{0} milisekundy"},
+				"duration-minute":          {"few": "{0} minuty", "many": "{0} minut", "one": "{0} minuta", "other": "{0} minuty"},
+				"duration-month":           {"few": "{0} miesiące", "many": "{0} miesięcy", "one": "{0} miesiąc", "other": "{0} miesiąca"},
+				"duration-second":          {"few": "{0} sekundy", "many": "{0} sekund", "one": "{0} sekunda", "other": "{0} sekundy"},
+				"duration-week":            {"few": "{0} tygodnie", "many": "{0} tygodni", "one": "{0} tydzień", "other": "{0} tygodnia"},
+				"duration-year":            {"few": "{0} lata", "many": "{0} lat", "one": "{0} rok", "other": "{0} roku"},
+				"length-centimeter":        {"few": "{0} centymetry", "many": "{0} centymetrów", "one": "{0} centymetr", "other": "{0} centymetra"},
+				"length-foot":              {"few": "{0} stopy", "many": "{0} stóp", "one": "{0} stopa", "other": "{0} stopy"},
+				"length-inch":              {"few": "{0} cale", "many": "{0} cali", "one": "{0} cal", "other": "{0} cala"},
+				"length-kilometer":         {"few": "{0} kilometry", "many": "{0} kilometrów", "one": "{0} kilometr", "other": "{0} kilometra"},
+				"length-meter":             {"few": "{0} metry", "many": "{0} metrów", "one": "{0} metr", "other": "{0} metra"},
+				"length-mile":              {"few": "{0} mile", "many": "{0} mil", "one": "{0} mila", "other": "{0} mili"},
+				"length-millimeter":        {"few": "{0} milimetry", "many": "{0} milimetrów", "one": "{0} milimetr", "other": "{0} milimetra"},
+				"mass-gram":                {"few": "{0} gramy", "many": "{0} gramów", "one": "{0} gram", "other": "{0} grama"},
+				"mass-kilogram":            {"few": "{0} kilogramy", "many": "{0} kilogramów", "one": "{0} kilogram", "other": "{0} kilograma"},
+				"mass-pound":               {"few": "{0} funty", "many": "{0} funtów", "one": "{0} funt", "other": "{0} funta"},
+				"speed-kilometer-per-hour": {"few": "{0} kilometry na godzinę", "many": "{0} kilometrów na godzinę", "one": "{0} kilometr na godzinę", "other": "{0} kilometra na godzinę"},
+				"temperature-celsius":      {"few": "{0} stopnie Celsjusza", "many": "{0} stopni Celsjusza", "one": "{0} stopień Celsjusza", "other": "{0} stopnia Celsjusza"},
+				"temperature-fahrenheit":   {"few": "{0} stopnie Fahrenheita", "many": "{0} stopni Fahrenheita", "one": "{0} stopień Fahrenheita", "other": "{0} stopnia Fahrenheita"},
+				"volume-liter":             {"few": "{0} litry", "many": "{0} litrów", "one": "{0} litr", "other": "{0} litra"},
+			},
+			"narrow": {
+				"concentr-percent":         {"few": "{0}%", "many": "{0}%", "one": "{0}%", "other": "{0}%"},
+				"digital-bit":              {"few": "{0} b", "many": "{0} b", "one": "{0} b", "other": "{0} b"},
+				"digital-byte":             {"few": "{0} B", "many": "{0} B", "one": "{0} B", "other": "{0} B"},
+				"digital-gigabyte":         {"few": "{0} GB", "many": "{0} GB", "one": "{0} GB", "other": "{0} GB"},
+				"digital-kilobyte":         {"few": "{0} kB", "many": "{0} kB", "one": "{0} kB", "other": "{0} kB"},
+				"digital-megabyte":         {"few": "{0} MB", "many": "{0} MB", "one": "{0} MB", "other": "{0} MB"},
+				"digital-petabyte":         {"few": "{0} PB", "many": "{0} PB", "one": "{0} PB", "other": "{0} PB"},
+				"digital-terabyte":         {"few": "{0} TB", "many": "{0} TB", "one": "{0} TB", "other": "{0} TB"},
+				"duration-day":             {"few": "{0} d.", "many": "{0} d.", "one": "{0} d.", "other": "{0} d."},
+				"duration-hour":            {"few": "{0} h", "many": "{0} h", "one": "{0} h", "other": "{0} h"},
+				"duration-millisecond":     {"few": "{0} ms", "many": "{0} ms", "one": "{0} ms", "other": "{0} ms"},
+				"duration-minute":          {"few": "{0} min", "many": "{0} min", "one": "{0} min", "other": "{0} min"},
+				"duration-month":           {"few": "{0} m-ce", "many": "{0} m-cy", "one": "{0} m-c", "other": "{0} m-ca"},
+				"duration-second":          {"few": "{0} s", "many": "{0} s", "one": "{0} s", "other": "{0} s"},
+				"duration-week":            {"few": "{0} t.", "many": "{0} t.", "one": "{0} t.", "other": "{0} t."},
+				"duration-year":            {"few": "{0} l.", "many": "{0} l.", "one": "{0} r.", "other": "{0} r."},
+				"length-centimeter":        {"few": "{0} cm", "many": "{0} cm", "one": "{0} cm", "other": "{0} cm"},
+				"length-foot":              {"few": "{0} ft", "many": "{0} ft", "one": "{0} ft", "other": "{0} ft"},
+				"length-inch":              {"few": "{0}″", "many": "{0}″", "one": "{0}″", "other": "{0}″"},
+				"length-kilometer":         {"few": "{0} km", "many": "{0} km", "one": "{0} km", "other": "{0} km"},
+				"length-meter":             {"few": "{0} m", "many": "{0} m", "one": "{0} m", "other": "{0} m"},
+				"length-mile":              {"few": "{0} mile", "many": "{0} mil", "one": "{0} mila", "other": "{0} mili"},
+				"length-millimeter":        {"few": "{0} mm", "many": "{0} mm", "one": "{0} mm", "other": "{0} mm"},
+				"mass-gram":                {"few": "{0} g", "many": "{0} g", "one": "{0} g", "other": "{0} g"},
+				"mass-kilogram":            {"few": "{0} kg", "many": "{0} kg", "one": "{0} kg", "other": "{0} kg"},
+				"mass-pound":               {"few": "{0} funty", "many": "{0} funtów", "one": "{0} funt", "other": "{0} funta"},
+				"speed-kilometer-per-hour": {"few": "{0} km/h", "many": "{0} km/h", "one": "{0} km/h", "other": "{0}km/h"},
+				"temperature-celsius":      {"few": "{0}°C", "many": "{0}°C", "one": "{0}°C", "other": "{0}°C"},
+				"temperature-fahrenheit":   {"few": "{0}°F", "many": "{0}°F", "one": "{0}°F", "other": "{0}°F"},
+				"volume-liter":             {"few": "{0} l", "many": "{0} l", "one": "{0} l", "other": "{0} l"},
+			},
+			"short": {
+				"concentr-percent":         {"few": "{0}%", "many": "{0}%", "one": "{0}%", "other": "{0}%"},
+				"digital-bit":              {"few": "{0} b", "many": "{0} b", "one": "{0} b", "other": "{0} b"},
+				"digital-byte":             {"few": "{0} B", "many": "{0} B", "one": "{0} B", "other": "{0} B"},
+				"digital-gigabyte":         {"few": "{0} GB", "many": "{0} GB", "one": "{0} GB", "other": "{0} GB"},
+				"digital-kilobyte":         {"few": "{0} kB", "many": "{0} kB", "one": "{0} kB", "other": "{0} kB"},
+				"digital-megabyte":         {"few": "{0} MB", "many": "{0} MB", "one": "{0} MB", "other": "{0} MB"},
+				"digital-petabyte":         {"few": "{0} PB", "many": "{0} PB", "one": "{0} PB", "other": "{0} PB"},
+				"digital-terabyte":         {"few": "{0} TB", "many": "{0} TB", "one": "{0} TB", "other": "{0} TB"},
+				"duration-day":             {"few": "{0} dni", "many": "{0} dni", "one": "{0} dzień", "other": "{0} dnia"},
+				"duration-hour":            {"few": "{0} godz.", "many": "{0} godz.", "one": "{0} godz.", "other": "{0} godz."},
+				"duration-millisecond":     {"few": "{0} ms", "many": "{0} ms", "one": "{0} ms", "other": "{0} ms"},
+				"duration-minute":          {"few": "{0} min", "many": "{0} min", "one": "{0} min", "other": "{0} min"},
+				"duration-month":           {"few": "{0} mies.", "many": "{0} mies.", "one": "{0} mies.", "other": "{0} mies."},
+				"duration-second":          {"few": "{0} sek.", "many": "{0} sek.", "one": "{0} sek.", "other": "{0} sek."},
+				"duration-week":            {"few": "{0} tyg.", "many": "{0} tyg.", "one": "{0} tydz.", "other": "{0} tyg."},
+				"duration-year":            {"few": "{0} lata", "many": "{0} lat", "one": "{0} rok", "other": "{0} roku"},
+				"length-centimeter":        {"few": "{0} cm", "many": "{0} cm", "one": "{0} cm", "other": "{0} cm"},
+				"length-foot":              {"few": "{0} ft", "many": "{0} ft", "one": "{0} ft", "other": "{0} ft"},
+				"length-inch":              {"few": "{0} cale", "many": "{0} cali", "one": "{0} cal", "other": "{0} cala"},
+				"length-kilometer":         {"few": "{0} km", "many": "{0} km", "one": "{0} km", "other": "{0} km"},
+				"length-meter":             {"few": "{0} m", "many": "{0} m", "one": "{0} m", "other": "{0} m"},
+				"length-mile":              {"few": "{0} mile", "many": "{0} mil", "one": "{0} mila", "other": "{0} mili"},
+				"length-millimeter":        {"few": "{0} mm", "many": "{0} mm", "one": "{0} mm", "other": "{0} mm"},
+				"mass-gram":                {"few": "{0} g", "many": "{0} g", "one": "{0} g", "other": "{0} g"},
+				"mass-kilogram":            {"few": "{0} kg", "many": "{0} kg", "one": "{0} kg", "other": "{0} kg"},
+				"mass-pound":               {"few": "{0} funty", "many": "{0} funtów", "one": "{0} funt", "other": "{0} funta"},
+				"speed-kilometer-per-hour": {"few": "{0} km/godz.", "many": "{0} km/godz.", "one": "{0} km/godz.", "other": "{0} km/godz."},
+				"temperature-celsius":      {"few": "{0} st. C", "many": "{0} st. C", "one": "{0} st. C", "other": "{0} st. C"},
+				"temperature-fahrenheit":   {"few": "{0}°F", "many": "{0}°F", "one": "{0}°F", "other": "{0}°F"},
+				"volume-liter":             {"few": "{0} l", "many": "{0} l", "one": "{0} l", "other": "{0} l"},
+			},
+		},
+	},
+	"pt": {
+		Calendar: &Calendar{
+			MonthsAbbreviated:           [12]string{"jan.", "fev.", "mar.", "abr.", "mai.", "jun.", "jul.", "ago.", "set.", "out.", "nov.", "dez."},
+			MonthsWide:                  [12]string{"janeiro", "fevereiro", "março", "abril", "maio", "junho", "julho", "agosto", "setembro", "outubro", "novembro", "dezembro"},
+			DaysAbbreviated:             [7]string{"dom.", "seg.", "ter.", "qua.", "qui.", "sex.", "sáb."},
+			DaysWide:                    [7]string{"domingo", "segunda-feira", "terça-feira", "quarta-feira", "quinta-feira", "sexta-feira", "sábado"},
+			MonthsStandAloneAbbreviated: [12]string{"jan.", "fev.", "mar.", "abr.", "mai.", "jun.", "jul.", "ago.", "set.", "out.", "nov.", "dez."},
+			MonthsStandAloneWide:        [12]string{"janeiro", "fevereiro", "março", "abril", "maio", "junho", "julho", "agosto", "setembro", "outubro", "novembro", "dezembro"},
+			DaysStandAloneAbbreviated:   [7]string{"dom.", "seg.", "ter.", "qua.", "qui.", "sex.", "sáb."},
+			DaysStandAloneWide:          [7]string{"domingo", "segunda-feira", "terça-feira", "quarta-feira", "quinta-feira", "sexta-feira", "sábado"},
+			DayPeriods:                  [2]string{"AM", "PM"},
+			ErasAbbreviated:             [2]string{"a.C.", "d.C."},
+			ErasWide:                    [2]string{"antes de Cristo", "depois de Cristo"},
+			ErasNarrow:                  [2]string{"a.C.", "d.C."},
+			DateFormats:                 Styles{Full: "EEEE, d 'de' MMMM 'de' y", Long: "d 'de' MMMM 'de' y", Medium: "d 'de' MMM 'de' y", Short: "dd/MM/y"},
+			TimeFormats:                 Styles{Full: "HH:mm:ss zzzz", Long: "HH:mm:ss z", Medium: "HH:mm:ss", Short: "HH:mm"},
+			DateTimeFormats:             Styles{Full: "{1} à's' {0}", Long: "{1} à's' {0}", Medium: "{1}, {0}", Short: "{1}, {0}"},
+			AvailableFormats:            map[string]string{"E": "E", "EEEEd": "EEEE, d", "Ed": "E, d", "H": "HH", "Hm": "HH:mm", "Hms": "HH:mm:ss", "M": "M", "MEd": "E, dd/MM", "MMM": "MMM", "MMMEd": "E, d 'de' MMM", "MMMM": "MMMM", "MMMMd": "d 'de' MMMM", "MMMd": "d 'de' MMM", "Md": "dd/MM", "d": "d", "h": "h a", "hm": "h:mm a", "hms": "h:mm:ss a", "ms": "mm:ss", "y": "y", "yM": "MM/y", "yMEd": "E, dd/MM/y", "yMMM": "MMM 'de' y", "yMMMEd": "E, d 'de' MMM 'de' y", "yMMMM": "MMMM 'de' y", "yMMMMEEEEd": "EEEE, d 'de' MMMM 'de' y", "yMMMMd": "d 'de' MMMM 'de' y", "yMMMd": "d 'de' MMM 'de' y", "yMd": "dd/MM/y"},
+		},
+		Numbers: &Numbers{
+			Symbols:               Symbols{Decimal: ",", Group: ".", MinusSign: "-"},
+			MinimumGroupingDigits: 1,
+			Compact: map[string]map[int]map[plural.Form]string{
+				"long": {
+					3:  {"one": "0 mil", "other": "0 mil"},
+					4:  {"other": "00 mil"},
+					5:  {"other": "000 mil"},
+					6:  {"one": "0 milhão", "other": "0 milhões"},
+					7:  {"other": "00 milhões"},
+					8:  {"other": "000 milhões"},
+					9:  {"one": "0 bilhão", "other": "0 bilhões"},
+					10: {"other": "00 bilhões"},
+					11: {"other": "000 bilhões"},
+					12: {"one": "0 trilhão", "other": "0 trilhões"},
+					13: {"other": "00 trilhões"},
+					14: {"other": "000 trilhões"},
+				},
+				"short": {
+					3:  {"one": "0 mil", "other": "0 mil"},
+					4:  {"other": "00 mil"},
+					5:  {"other": "000 mil"},
+					6:  {"one": "0 mi", "other": "0 mi"},
+					7:  {"other": "00 mi"},
+					8:  {"other": "000 mi"},
+					9:  {"one": "0 bi", "other": "0 bi"},
+					10: {"other": "00 bi"},
+					11: {"other": "000 bi"},
+					12: {"one": "0 tri", "other": "0 tri"},
+					13: {"other": "00 tri"},
+					14: {"other": "000 tri"},
+				},
+			},
+		},
+		RelativeTimes: map[string]*RelativeTime{
+			"day": {
+				Relative: map[int]string{-2: "anteontem", -1: "ontem", 0: "hoje", 1: "amanhã", 2: "depois de amanhã"},
+				Future:   map[plural.Form]string{"one": "em {0} dia", "other": "em {0} dias"},
+				Past:     map[plural.Form]string{"one": "há {0} dia", "other": "há {0} dias"},
+			},
+			"day-narrow": {
+				Relative: map[int]string{-2: "anteontem", -1: "ontem", 0: "hoje", 1: "amanhã", 2: "depois de amanhã"},
+				Future:   map[plural.Form]string{"one": "em {0} dia", "other": "em {0} dias"},
+				Past:     map[plural.Form]string{"one": "há {0} dia", "other": "há {0} dias"},
+			},
+			"day-short": {
+				Relative: map[int]string{-2: "anteontem", -1: "ontem", 0: "hoje", 1: "amanhã", 2: "depois de amanhã"},
+				Future:   map[plural.Form]string{"one": "em {0} dia", "other": "em {0} dias"},
+				Past:     map[plural.Form]string{"one": "há {0} dia", "other": "há {0} dias"},
+			},
+			"hour": {
+				Relative: map[int]string{0: "esta hora"},
+				Future:   map[plural.Form]string{"one": "em {0} hora", "other": "em {0} horas"},
+				Past:     map[plural.Form]string{"one": "há {0} hora", "other": "há {0} horas"},
+			},
+			"hour-narrow": {
+				Relative: map[int]string{0: "esta hora"},
+				Future:   map[plural.Form]string{"one": "em {0} h", "other": "em {0} h"},
+				Past:     map[plural.Form]string{"one": "há {0} h", "other": "há {0} h"},
+			},
+			"hour-short": {
+				Relative: map[int]string{0: "esta hora"},
+				Future:   map[plural.Form]string{"one": "em {0} h", "other": "em {0} h"},
+				Past:     map[plural.Form]string{"one": "há {0} h", "other": "há {0} h"},
+			},
+			"minute": {
+				Relative: map[int]string{0: "este minuto"},
+				Future:   map[plural.Form]string{"one": "em {0} minuto", "other": "em {0} minutos"},
+				Past:     map[plural.Form]string{"one": "há {0} minuto", "other": "há {0} minutos"},
+			},
+			"minute-narrow": {
+				Relative: map[int]string{0: "este minuto"},
+				Future:   map[plural.Form]string{"one": "em {0} min.", "other": "em {0} min."},
+				Past:     map[plural.Form]string{"one": "há {0} min.", "other": "há {0} min."},
+			},
+			"minute-short": {
+				Relative: map[int]string{0: "este minuto"},
+				Future:   map[plural.Form]string{"one": "em {0} min.", "other": "em {0} min."},
+				Past:     map[plural.Form]string{"one": "há {0} min.", "other": "há {0} min."},
+			},
+			"month": {
+				Relative: map[int]string{-1: "mês passado", 0: "este mês", 1: "próximo mês"},
+				Future:   map[plural.Form]string{"one": "em {0} mês", "other": "em {0} meses"},
+				Past:     map[plural.Form]string{"one": "há {0} mês", "other": "há {0} meses"},
+			},
+			"month-narrow": {
+				Relative: map[int]string{-1: "mês passado", 0: "este mês", 1: "próximo mês"},
+				Future:   map[plural.Form]string{"one": "em {0} mês", "other": "em {0} meses"},
+				Past:     map[plural.Form]string{"one": "há {0} mês", "other": "há {0} meses"},
+			},
+			"month-short": {
+				Relative: map[int]string{-1: "mês passado", 0: "este mês", 1: "próximo mês"},
+				Future:   map[plural.Form]string{"one": "em {0} mês", "other": "em {0} meses"},
+				Past:     map[plural.Form]string{"one": "há {0} mês", "other": "há {0} meses"},
+			},
+			"quarter": {
+				Relative: map[int]string{-1: "último trimestre", 0: "este trimestre", 1: "próximo trimestre"},
+				Future:   map[plural.Form]string{"one": "em {0} trimestre", "other": "em {0} trimestres"},
+				Past:     map[plural.Form]string{"one": "há {0} trimestre", "other": "há {0} trimestres"},
+			},
+			"quarter-narrow": {
+				Relative: map[int]string{-1: "último trimestre", 0: "este trimestre", 1: "próximo trimestre"},
+				Future:   map[plural.Form]string{"one": "em {0} trim.", "other": "em {0} trim."},
+				Past:     map[plural.Form]string{"one": "há {0} trim.", "other": "há {0} trim."},
+			},
+			"quarter-short": {
+				Relative: map[int]string{-1: "último trimestre", 0: "este trimestre", 1: "próximo trimestre"},
+				Future:   map[plural.Form]string{"one": "em {0} trim.", "other": "em {0} trim."},
+				Past:     map[plural.Form]string{"one": "há {0} trim.", "other": "há {0} trim."},
+			},
+			"second": {
+				Relative: map[int]string{0: "agora"},
+				Future:   map[plural.Form]string{"one": "em {0} segundo", "other": "em {0} segundos"},
+				Past:     map[plural.Form]string{"one": "há {0} segundo", "other": "há {0} segundos"},
+			},
+			"second-narrow": {
+				Relative: map[int]string{0: "agora"},
+				Future:   map[plural.Form]string{"one": "em {0} seg.", "other": "em {0} seg."},
+				Past:     map[plural.Form]string{"one": "há {0} seg.", "other": "há {0} seg."},
+			},
+			"second-short": {
+				Relative: map[int]string{0: "agora"},
+				Future:   map[plural.Form]string{"one": "em {0} seg.", "other": "em {0} seg."},
+				Past:     map[plural.Form]string{"one": "há {0} seg.", "other": "há {0} seg."},
+			},
+			"week": {
+				Relative: map[int]string{-1: "semana passada", 0: "esta semana", 1: "próxima semana"},
+				Future:   map[plural.Form]string{"one": "em {0} semana", "other": "em {0} semanas"},
+				Past:     map[plural.Form]string{"one": "há {0} semana", "other": "há {0} semanas"},
+			},
+			"week-narrow": {
+				Relative: map[int]string{-1: "semana passada", 0: "esta semana", 1: "próxima semana"},
+				Future:   map[plural.Form]string{"one": "em {0} sem.", "other": "em {0} sem."},
+				Past:     map[plural.Form]string{"one": "há {0} sem.", "other": "há {0} sem."},
+			},
+			"week-short": {
+				Relative: map[int]string{-1: "semana passada", 0: "esta semana", 1: "próxima semana"},
+				Future:   map[plural.Form]string{"one": "em {0} sem.", "other": "em {0} sem."},
+				Past:     map[plural.Form]string{"one": "há {0} sem.", "other": "há {0} sem."},
+			},
+			"year": {
+				Relative: map[int]string{-1: "ano passado", 0: "este ano", 1: "próximo ano"},
+				Future:   map[plural.Form]string{"one": "em {0} ano", "other": "em {0} anos"},
+				Past:     map[plural.Form]string{"one": "há {0} ano", "other": "há {0} anos"},
+			},
+			"year-narrow": {
+				Relative: map[int]string{-1: "ano passado", 0: "este ano", 1: "próximo ano"},
+				Future:   map[plural.Form]string{"one": "em {0} ano", "other": "em {0} anos"},
+				Past:     map[plural.Form]string{"one": "há {0} ano", "other": "há {0} anos"},
+			},
+			"year-short": {
+				Relative: map[int]string{-1: "ano passado", 0: "este ano", 1: "próximo ano"},
+				Future:   map[plural.Form]string{"one": "em {0} ano", "other": "em {0} anos"},
+				Past:     map[plural.Form]string{"one": "há {0} ano", "other": "há {0} anos"},
+			},
+		},
+		ListPatterns: map[string]*ListPattern{
+			"or":              {Start: "{0}, {1}", Middle: "{0}, {1}", End: "{0} ou {1}", Two: "{0} ou {1}"},
+			"or-narrow":       {Start: "{0}, {1}", Middle: "{0}, {1}", End: "{0} ou {1}", Two: "{0} ou {1}"},
+			"or-short":        {Start: "{0}, {1}", Middle: "{0}, {1}", End: "{0} ou {1}", Two: "{0} ou {1}"},
+			"standard":        {Start: "{0}, {1}", Middle: "{0}, {1}", End: "{0} e {1}", Two: "{0} e {1}"},
+			"standard-narrow": {Start: "{0}, {1}", Middle: "{0}, {1}", End: "{0}, {1}", Two: "{0}, {1}"},
+			"standard-short":  {Start: "{0}, {1}", Middle: "{0}, {1}", End: "{0} e {1}", Two: "{0} e {1}"},
+			"unit":            {Start: "{0}, {1}", Middle: "{0}, {1}", End: "{0} e {1}", Two: "{0} e {1}"},
+			"unit-narrow":     {Start: "{0} {1}", Middle: "{0} {1}", End: "{0} {1}", Two: "{0} {1}"},
+			"unit-short":      {Start: "{0}, {1}", Middle: "{0}, {1}", End: "{0} e {1}", Two: "{0} e {1}"},
+		},
+		Units: map[string]map[string]map[plural.Form]string{
+			"long": {
+				"concentr-percent":         {"one": "{0} por cento", "other": "{0} por cento"},
+				"digital-bit":              {"one": "{0} bit", "other": "{0} bits"},
+				"digital-byte":             {"one": "{0} byte", "other": "{0} bytes"},
+				"digital-gigabyte":         {"one": "{0} gigabyte", "other": "{0} gigabytes"},
+				"digital-kilobyte":         {"one": "{0} kilobyte", "other": "{0} kilobytes"},
+				"digital-megabyte":         {"one": "{0} megabyte", "other": "{0} megabytes"},
+				"digital-petabyte":         {"one": "{0} petabyte", "other": "{0} petabytes"},
+				"digital-terabyte":         {"one": "{0} terabyte", "other": "{0} terabytes"},
+				"duration-day":             {"one": "{0} dia", "other": "{0} dias"},
+				"duration-hour":            {"one": "{0} hora", "other": "{0} horas"},
+				"duration-millisecond":     {"one": "{0} milissegundo", "other": "{0} milissegundos"},
+				"duration-minute":          {"one": "{0} minuto", "other": "{0} minutos"},
+				"duration-month":           {"one": "{0} mês", "other": "{0} meses"},
+				"duration-second":          {"one": "{0} segundo", "other": "{0} segundos"},
+				"duration-week":            {"one": "{0} semana", "other": "{0} semanas"},
+				"duration-year":            {"one": "{0} ano", "other": "{0} anos"},
+				"length-centimeter":        {"one": "{0} centímetro", "other": "{0} centímetros"},
+				"length-foot":              {"one": "{0} pé", "other": "{0} pés"},
+				"length-inch":              {"one": "{0} polegada", "other": "{0} polegadas"},
+				"length-kilometer":         {"one": "{0} quilômetro", "other": "{0} quilômetros"},
+				"length-meter":             {"one": "{0} metro", "other": "{0} metros"},
+				"length-mile":              {"one": "{0} milha", "other": "{0} milhas"},
+				"length-millimeter":        {"one": "{0} milímetro", "other": "{0} milímetros"},
+				"mass-gram":                {"one": "{0} grama", "other": "{0} gramas"},
+				"mass-kilogram":            {"one": "{0} quilograma", "other": "{0} quilogramas"},
+				"mass-pound":               {"one": "{0} libra", "other": "{0} libras"},
+				"speed-kilometer-per-hour": {"one": "{0} quilômetro por hora", "other": "{0} quilômetros por hora"},
+				"temperature-celsius":      {"one": "{0} grau Celsius", "other": "{0} graus Celsius"},
+				"temperature-fahrenheit":   {"one": "{0} grau Fahrenheit", "other": "{0} graus Fahrenheit"},
+				"volume-liter":             {"one": "{0} litro", "other": "{0} litros"},
+			},
+			"narrow": {
+				"concentr-percent":         {"one": "{0}%", "other": "{0}%"},
+				"digital-bit":              {"one": "{0} bit", "other": "{0} bits"},
+				"digital-byte":             {"one": "{0} B", "other": "{0} B"},
+				"digital-gigabyte":         {"one": "{0} GB", "other": "{0} GB"},
+				"digital-kilobyte":         {"one": "{0} kB", "other": "{0} kB"},
+				"digital-megabyte":         {"one": "{0} MB", "other": "{0} MB"},
+				"digital-petabyte":         {"one": "{0} PB", "other": "{0} PB"},
+				"digital-terabyte":         {"one": "{0} TB", "other": "{0} TB"},
+				"duration-day":             {"one": "{0} dia", "other": "{0} dias"},
+				"duration-hour":            {"one": "{0} h", "other": "{0} h"},
+				"duration-millisecond":     {"one": "{0} ms", "other": "{0} ms"},
+				"duration-minute":          {"one": "{0} min", "other": "{0} min"},
+				"duration-month":           {"one": "{0} mês", "other": "{0} meses"},
+				"duration-second":          {"one": "{0} s", "other": "{0} s"},
+				"duration-week":            {"one": "{0} sem.", "other": "{0} sem."},
+				"duration-year":            {"one": "{0} ano", "other": "{0} anos"},
+				"length-centimeter":        {"one": "{0} cm", "other": "{0} cm"},
+				"length-foot":              {"one": "{0}′", "other": "{0}′"},
+				"length-inch":              {"one": "{0}″", "other": "{0}″"},
+				"length-kilometer":         {"one": "{0} km", "other": "{0} km"},
+				"length-meter":             {"one": "{0} m", "other": "{0} m"},
+				"length-mile":              {"one": "{0} mi", "other": "{0} mi"},
+				"length-millimeter":        {"one": "{0} mm", "other": "{0} mm"},
+				"mass-gram":                {"one": "{0}g", "other": "{0}g"},
+				"mass-kilogram":            {"one": "{0}kg", "other": "{0}kg"},
+				"mass-pound":               {"one": "{0} lb", "other": "{0} lb"},
+				"speed-kilometer-per-hour": {"one": "{0}km/h", "other": "{0}km/h"},
+				"temperature-celsius":      {"one": "{0} °C", "other": "{0} °C"},
+				"temperature-fahrenheit":   {"one": "{0} °F", "other": "{0} °F"},
+				"volume-liter":             {"one": "{0}l", "other": "{0}l"},
+			},
+			"short": {
+				"concentr-percent":         {"one": "{0}%", "other": "{0}%"},
+				"digital-bit":              {"one": "{0} bits", "other": "{0} bits"},
+				"digital-byte":             {"one": "{0} bytes", "other": "{0} bytes"},
+				"digital-gigabyte":         {"one": "{0} GB", "other": "{0} GB"},
+				"digital-kilobyte":         {"one": "{0} kB", "other": "{0} kB"},
+				"digital-megabyte":         {"one": "{0} MB", "other": "{0} MB"},
+				"digital-petabyte":         {"one": "{0} PB", "other": "{0} PB"},
+				"digital-terabyte":         {"one": "{0} TB", "other": "{0} TB"},
+				"duration-day":             {"one": "{0} dia", "other": "{0} dias"},
+				"duration-hour":            {"one": "{0} h", "other": "{0} h"},
+				"duration-millisecond":     {"one": "{0} ms", "other": "{0} ms"},
+				"duration-minute":          {"one": "{0} min", "other": "{0} min"},
+				"duration-month":           {"one": "{0} mês", "other": "{0} meses"},
+				"duration-second":          {"one": "{0} s", "other": "{0} s"},
+				"duration-week":            {"one": "{0} sem.", "other": "{0} sem."},
+				"duration-year":            {"one": "{0} ano", "other": "{0} anos"},
+				"length-centimeter":        {"one": "{0} cm", "other": "{0} cm"},
+				"length-foot":              {"one": "{0} ft", "other": "{0} ft"},
+				"length-inch":              {"one": "{0} pol.", "other": "{0} pol."},
+				"length-kilometer":         {"one": "{0} km", "other": "{0} km"},
+				"length-meter":             {"one": "{0} m", "other": "{0} m"},
+				"length-mile":              {"one": "{0} mi", "other": "{0} mi"},
+				"length-millimeter":        {"one": "{0} mm", "other": "{0} mm"},
+				"mass-gram":                {"one": "{0} g", "other": "{0} g"},
+				"mass-kilogram":            {"one": "{0} kg", "other": "{0} kg"},
+				"mass-pound":               {"one": "{0} lb", "other": "{0} lb"},
+				"speed-kilometer-per-hour": {"one": "{0} km/h", "other": "{0} km/h"},
+				"temperature-celsius":      {"one": "{0} °C", "other": "{0} °C"},
+				"temperature-fahrenheit":   {"one": "{0} °F", "other": "{0} °F"},
+				"volume-liter":             {"one": "{0} l", "other": "{0} l"},
+			},
+		},
+	},
+	"ru": {
+		Calendar: &Calendar{
+			MonthsAbbreviated:           [12]string{"янв.", "февр.", "мар.", "апр.", "мая", "июн.", "июл.", "авг.", "сент.", "окт.", "нояб.", "дек."},
+			MonthsWide:                  [12]string{"января", "февраля", "марта", "апреля", "мая", "июня", "июля", "августа", "сентября", "октября", "ноября", "декабря"},
+			DaysAbbreviated:             [7]string{"вс", "пн", "вт", "ср", "чт", "пт", "сб"},
+			DaysWide:                    [7]string{"воскресенье", "понедельник", "вторник", "среда", "четверг", "пятница", "суббота"},
+			MonthsStandAloneAbbreviated: [12]string{"янв.", "февр.", "март", "апр.", "май", "июнь", "июль", "авг.", "сент.", "окт.", "нояб.", "дек."},
+			MonthsStandAloneWide:        [12]string{"январь", "февраль", "март", "апрель", "май", "июнь", "июль", "август", "сентябрь", "октябрь", "ноябрь", "декабрь"},
+			DaysStandAloneAbbreviated:   [7]string{"вс", "пн", "вт", "ср", "чт", "пт", "сб"},
+			DaysStandAloneWide:          [7]string{"воскресенье", "понедельник", "вторник", "среда", "четверг", "пятница", "суббота"},
+			DayPeriods:                  [2]string{"AM", "PM"},
+			ErasAbbreviated:             [2]string{"до н. э.", "н. э."},
+			ErasWide:                    [2]string{"до Рождества Христова", "от Рождества Христова"},
+			ErasNarrow:                  [2]string{"до н.э.", "н.э."},
+			DateFormats:                 Styles{Full: "EEEE, d MMMM y г.", Long: "d MMMM y г.", Medium: "d MMM y г.", Short: "dd.MM.y"},
+			TimeFormats:                 Styles{Full: "HH:mm:ss zzzz", Long: "HH:mm:ss z", Medium: "HH:mm:ss", Short: "HH:mm"},
+			DateTimeFormats:             Styles{Full: "{1} в {0}", Long: "{1} в {0}", Medium: "{1}, {0}", Short: "{1}, {0}"},
+			AvailableFormats:            map[string]string{"E": "E", "EEEEd": "EEEE, d", "Ed": "E, d", "H": "HH", "Hm": "HH:mm", "Hms": "HH:mm:ss", "M": "M", "MEd": "E, dd.MM", "MMM": "LLL", "MMMEd": "E, d MMM", "MMMM": "LLLL", "MMMMd": "d MMMM", "MMMd": "d MMM", "Md": "dd.MM", "d": "d", "h": "h a", "hm": "h:mm a", "hms": "h:mm:ss a", "ms": "mm:ss", "y": "y", "yM": "MM.y", "yMEd": "E, dd.MM.y 'г'.", "yMMM": "LLL y 'г'.", "yMMMEd": "E, d MMM y 'г'.", "yMMMM": "LLLL y 'г'.", "yMMMMEEEEd": "EEEE, d MMMM y 'г'.", "yMMMMd": "d MMMM y 'г'.", "yMMMd": "d MMM y 'г'.", "yMd": "dd.MM.y"},
+		},
+		Numbers: &Numbers{
+			Symbols:               Symbols{Decimal: ",", Group: " ", MinusSign: "-"},
+			MinimumGroupingDigits: 1,
+			Compact: map[string]map[int]map[plural.Form]string{
+				"long": {
+					3:  {"few": "0 тысячи", "many": "0 тысяч", "one": "0 тысяча", "other": "0 тысячи"},
+					4:  {"few": "00 тысячи", "many": "00 тысяч", "one": "00 тысяча", "other": "00 тысячи"},
+					5:  {"few": "000 тысячи", "many": "000 тысяч", "one": "000 тысяча", "other": "000 тысячи"},
+					6:  {"few": "0 миллиона", "many": "0 миллионов", "one": "0 миллион", "other": "0 миллиона"},
+					7:  {"few": "00 миллиона", "many": "00 миллионов", "one": "00 миллион", "other": "00 миллиона"},
+					8:  {"few": "000 миллиона", "many": "000 миллионов", "one": "000 миллион", "other": "000 миллиона"},
+					9:  {"few": "0 миллиарда", "many": "0 миллиардов", "one": "0 миллиард", "other": "0 миллиарда"},
+					10: {"few": "00 миллиарда", "many": "00 миллиардов", "one": "00 миллиард", "other": "00 миллиарда"},
+					11: {"few": "000 миллиарда", "many": "000 миллиардов", "one": "000 миллиард", "other": "000 миллиарда"},
+					12: {"few": "0 триллиона", "many": "0 триллионов", "one": "0 триллион", "other": "0 триллиона"},
+					13: {"few": "00 триллиона", "many": "00 триллионов", "one": "00 триллион", "other": "00 триллиона"},
+					14: {"few": "000 триллиона", "many": "000 триллионов", "one": "000 триллион", "other": "000 триллиона"},
+				},
+				"short": {
+					3:  {"few": "0 тыс.", "many": "0 тыс.", "one": "0 тыс.", "other": "0 тыс."},
+					4:  {"few": "00 тыс.", "many": "00 тыс.", "one": "00 тыс.", "other": "00 тыс."},
+					5:  {"few": "000 тыс.", "many": "000 тыс.", "one": "000 тыс.", "other": "000 тыс."},
+					6:  {"few": "0 млн", "many": "0 млн", "one": "0 млн", "other": "0 млн"},
+					7:  {"few": "00 млн", "many": "00 млн", "one": "00 млн", "other": "00 млн"},
+					8:  {"few": "000 млн", "many": "000 млн", "one": "000 млн", "other": "000 млн"},
+					9:  {"few": "0 млрд", "many": "0 млрд", "one": "0 млрд", "other": "0 млрд"},
+					10: {"few": "00 млрд", "many": "00 млрд", "one": "00 млрд", "other": "00 млрд"},
+					11: {"few": "000 млрд", "many": "000 млрд", "one": "000 млрд", "other": "000 млрд"},
+					12: {"few": "0 трлн", "many": "0 трлн", "one": "0 трлн", "other": "0 трлн"},
+					13: {"few": "00 трлн", "many": "00 трлн", "one": "00 трлн", "other": "00 трлн"},
+					14: {"few": "000 трлн", "many": "000 трлн", "one": "000 трлн", "other": "000 трлн"},
+				},
+			},
+		},
+		RelativeTimes: map[string]*RelativeTime{
+			"day": {
+				Relative: map[int]string{-2: "позавчера", -1: "вчера", 0: "сегодня", 1: "завтра", 2: "послезавтра"},
+				Future:   map[plural.Form]string{"few": "через {0} дня", "many": "через {0} дней", "one": "через {0} день", "other": "через {0} дня"},
+				Past:     map[plural.Form]string{"few": "{0} дня назад", "many": "{0} дней назад", "one": "{0} день назад", "other": "{0} дня назад"},
+			},
+			"day-narrow": {
+				Relative: map[int]string{-2: "позавчера", -1: "вчера", 0: "сегодня", 1: "завтра", 2: "послезавтра"},
+				Future:   map[plural.Form]string{"few": "+{0} дн.", "many": "+{0} дн.", "one": "+{0} дн.", "other": "+{0} дн."},
+				Past:     map[plural.Form]string{"few": "-{0} дн.", "many": "-{0} дн.", "one": "-{0} дн.", "other": "-{0} дн."},
+			},
+			"day-short": {
+				Relative: map[int]string{-2: "позавчера", -1: "вчера", 0: "сегодня", 1: "завтра", 2: "послезавтра"},
+				Future:   map[plural.Form]string{"few": "через {0} дн.", "many": "через {0} дн.", "one": "через {0} дн.", "other": "через {0} дн."},
+				Past:     map[plural.Form]string{"few": "{0} дн. назад", "many": "{0} дн. назад", "one": "{0} дн. назад", "other": "{0} дн. назад"},
+			},
+			"hour": {
+				Relative: map[int]string{0: "в этот час"},
+				Future:   map[plural.Form]string{"few": "через {0} часа", "many": "через {0} часов", "one": "через {0} час", "other": "через {0} часа"},
+				Past:     map[plural.Form]string{"few": "{0} часа назад", "many": "{0} часов назад", "one": "{0} час назад", "other": "{0} часа назад"},
+			},
+			"hour-narrow": {
+				Relative: map[int]string{0: "в этот час"},
+				Future:   map[plural.Form]string{"few": "+{0} ч", "many": "+{0} ч", "one": "+{0} ч", "other": "+{0} ч"},
+				Past:     map[plural.Form]string{"few": "-{0} ч", "many": "-{0} ч", "one": "-{0} ч", "other": "-{0} ч"},
+			},
+			"hour-short": {
+				Relative: map[int]string{0: "в этот час"},
+				Future:   map[plural.Form]string{"few": "через {0} ч", "many": "через {0} ч", "one": "через {0} ч", "other": "через {0} ч"},
+				Past:     map[plural.Form]string{"few": "{0} ч назад", "many": "{0} ч назад", "one": "{0} ч назад", "other": "{0} ч назад"},
+			},
+			"minute": {
+				Relative: map[int]string{0: "в эту минуту"},
+				Future:   map[plural.Form]string{"few": "через {0} минуты", "many": "через {0} минут", "one": "через {0} минуту", "other": "через {0} минуты"},
+				Past:     map[plural.Form]string{"few": "{0} минуты назад", "many": "{0} минут назад", "one": "{0} минуту назад", "other": "{0} минуты назад"},
+			},
+			"minute-narrow": {
+				Relative: map[int]string{0: "в эту минуту"},
+				Future:   map[plural.Form]string{"few": "+{0} мин", "many": "+{0} мин", "one": "+{0} мин", "other": "+{0} мин"},
+				Past:     map[plural.Form]string{"few": "-{0} мин", "many": "-{0} мин", "one": "-{0} мин", "other": "-{0} мин"},
+			},
+			"minute-short": {
+				Relative: map[int]string{0: "в эту минуту"},
+				Future:   map[plural.Form]string{"few": "через {0} мин.", "many": "через {0} мин.", "one": "через {0} мин.", "other": "через {0} мин."},
+				Past:     map[plural.Form]string{"few": "{0} мин. назад", "many": "{0} мин. назад", "one": "{0} мин. назад", "other": "{0} мин. назад"},
+			},
+			"month": {
+				Relative: map[int]string{-1: "в прошлом месяце", 0: "в этом месяце", 1: "в следующем месяце"},
+				Future:   map[plural.Form]string{"few": "через {0} месяца", "many": "через {0} месяцев", "one": "через {0} месяц", "other": "через {0} месяца"},
+				Past:     map[plural.Form]string{"few": "{0} месяца назад", "many": "{0} месяцев назад", "one": "{0} месяц назад", "other": "{0} месяца назад"},
+			},
+			"month-narrow": {
+				Relative: map[int]string{-1: "в пр. мес.", 0: "в эт. мес.", 1: "в след. мес."},
+				Future:   map[plural.Form]string{"few": "+{0} мес.", "many": "+{0} мес.", "one": "+{0} мес.", "other": "+{0} мес."},
+				Past:     map[plural.Form]string{"few": "-{0} мес.", "many": "-{0} мес.", "one": "-{0} мес.", "other": "-{0} мес."},
+			},
+			"month-short": {
+				Relative: map[int]string{-1: "в прошлом мес.", 0: "в этом мес.", 1: "в следующем мес."},
+				Future:   map[plural.Form]string{"few": "через {0} мес.", "many": "через {0} мес.", "one": "через {0} мес.", "other": "через {0} мес."},
+				Past:     map[plural.Form]string{"few": "{0} мес. назад", "many": "{0} мес. назад", "one": "{0} мес. назад", "other": "{0} мес. назад"},
+			},
+			"quarter": {
+				Relative: map[int]string{-1: "в прошлом квартале", 0: "в текущем квартале", 1: "в следующем квартале"},
+				Future:   map[plural.Form]string{"few": "через {0} квартала", "many": "через {0} кварталов", "one": "через {0} квартал", "other": "через {0} квартала"},
+				Past:     map[plural.Form]string{"few": "{0} квартала назад", "many": "{0} кварталов назад", "one": "{0} квартал назад", "other": "{0} квартала назад"},
+			},
+			"quarter-narrow": {
+				Relative: map[int]string{-1: "посл. кв.", 0: "тек. кв.", 1: "след. кв."},
+				Future:   map[plural.Form]string{"few": "+{0} кв.", "many": "+{0} кв.", "one": "+{0} кв.", "other": "+{0} кв."},
+				Past:     map[plural.Form]string{"few": "-{0} кв.", "many": "-{0} кв.", "one": "-{0} кв.", "other": "-{0} кв."},
+			},
+			"quarter-short": {
+				Relative: map[int]string{-1: "последний кв.", 0: "текущий кв.", 1: "следующий кв."},
+				Future:   map[plural.Form]string{"few": "через {0} кв.", "many": "через {0} кв.", "one": "через {0} кв.", "other": "через {0} кв."},
+				Past:     map[plural.Form]string{"few": "{0} кв. назад", "many": "{0} кв. назад", "one": "{0} кв. назад", "other": "{0} кв. назад"},
+			},
+			"second": {
+				Relative: map[int]string{0: "сейчас"},
+				Future:   map[plural.Form]string{"few": "через {0} секунды", "many": "через {0} секунд", "one": "через {0} секунду", "other": "через {0} секунды"},
+				Past:     map[plural.Form]string{"few": "{0} секунды назад", "many": "{0} секунд назад", "one": "{0} секунду назад", "other": "{0} секунды назад"},
+			},
+			"second-narrow": {
+				Relative: map[int]string{0: "сейчас"},
+				Future:   map[plural.Form]string{"few": "+{0} с", "many": "+{0} с", "one": "+{0} с", "other": "+{0} с"},
+				Past:     map[plural.Form]string{"few": "-{0} с", "many": "-{0} с", "one": "-{0} с", "other": "-{0} с"},
+			},
+			"second-short": {
+				Relative: map[int]string{0: "сейчас"},
+				Future:   map[plural.Form]string{"few": "через {0} сек.", "many": "через {0} сек.", "one": "через {0} сек.", "other": "через {0} сек."},
+				Past:     map[plural.Form]string{"few": "{0} сек. назад", "many": "{0} сек. назад", "one": "{0} сек. назад", "other": "{0} сек. назад"},
+			},
+			"week": {
+				Relative: map[int]string{-1: "на прошлой неделе", 0: "на этой неделе", 1: "на следующей неделе"},
+				Future:   map[plural.Form]string{"few": "через {0} недели", "many": "через {0} недель", "one": "через {0} неделю", "other": "через {0} недели"},
+				Past:     map[plural.Form]string{"few": "{0} недели назад", "many": "{0} недель назад", "one": "{0} неделю назад", "other": "{0} недели назад"},
+			},
+			"week-narrow": {
+				Relative: map[int]string{-1: "на пр. нед.", 0: "на эт. нед.", 1: "на след. нед."},
+				Future:   map[plural.Form]string{"few": "+{0} нед.", "many": "+{0} нед.", "one": "+{0} нед.", "other": "+{0} нед."},
+				Past:     map[plural.Form]string{"few": "-{0} нед.", "many": "-{0} нед.", "one": "-{0} нед.", "other": "-{0} нед."},
+			},
+			"week-short": {
+				Relative: map[int]string{-1: "на прошлой нед.", 0: "на этой нед.", 1: "на следующей нед."},
+				Future:   map[plural.Form]string{"few": "через {0} нед.", "many": "через {0} нед.", "one": "через {0} нед.", "other": "через {0} нед."},
+				Past:     map[plural.Form]string{"few": "{0} нед. назад", "many": "{0} нед. назад", "one": "{0} нед. назад", "other": "{0} нед. назад"},
+			},
+			"year": {
+				Relative: map[int]string{-1: "в прошлом году", 0: "в этом году", 1: "в следующем году"},
+				Future:   map[plural.Form]string{"few": "через {0} года", "many": "через {0} лет", "one": "через {0} год", "other": "через {0} года"},
+				Past:     map[plural.Form]string{"few": "{0} года назад", "many": "{0} лет назад", "one": "{0} год назад", "other": "{0} года назад"},
+			},
+			"year-narrow": {
+				Relative: map[int]string{-1: "в пр. г.", 0: "в эт. г.", 1: "в сл. г."},
+				Future:   map[plural.Form]string{"few": "+{0} г.", "many": "+{0} л.", "one": "+{0} г.", "other": "+{0} г."},
+				Past:     map[plural.Form]string{"few": "-{0} г.", "many": "-{0} л.", "one": "-{0} г.", "other": "-{0} г."},
+			},
+			"year-short": {
+				Relative: map[int]string{-1: "в прошлом г.", 0: "в этом г.", 1: "в след. г."},
+				Future:   map[plural.Form]string{"few": "через {0} г.", "many": "через {0} л.", "one": "через {0} г.", "other": "через {0} г."},
+				Past:     map[plural.Form]string{"few": "{0} г. назад", "many": "{0} л. назад", "one": "{0} г. назад", "other": "{0} г. назад"},
+			},
+		},
+		ListPatterns: map[string]*ListPattern{
+			"or":              {Start: "{0}, {1}", Middle: "{0}, {1}", End: "{0} или {1}", Two: "{0} или {1}"},
+			"or-narrow":       {Start: "{0}, {1}", Middle: "{0}, {1}", End: "{0} или {1}", Two: "{0} или {1}"},
+			"or-short":        {Start: "{0}, {1}", Middle: "{0}, {1}", End: "{0} или {1}", Two: "{0} или {1}"},
+			"standard":        {Start: "{0}, {1}", Middle: "{0}, {1}", End: "{0} и {1}", Two: "{0} и {1}"},
+			"standard-narrow": {Start: "{0}, {1}", Middle: "{0}, {1}", End: "{0}, {1}", Two: "{0}, {1}"},
+			"standard-short":  {Start: "{0}, {1}", Middle: "{0}, {1}", End: "{0} и {1}", Two: "{0} и {1}"},
+			"unit":            {Start: "{0} {1}", Middle: "{0} {1}", End: "{0} {1}", Two: "{0} {1}"},
+			"unit-narrow":     {Start: "{0} {1}", Middle: "{0} {1}", End: "{0} {1}", Two: "{0} {1}"},
+			"unit-short":      {Start: "{0} {1}", Middle: "{0} {1}", End: "{0} {1}", Two: "{0} {1}"},
+		},
+		Units: map[string]map[string]map[plural.Form]string{
+			"long": {
+				"concentr-percent":         {"few": "{0} процента", "many": "{0} процентов", "one": "{0} процент", "other": "{0} процента"},
+				"digital-bit":              {"few": "{0} бита", "many": "{0} бит", "one": "{0} бит", "other": "{0} бита"},
+				"digital-byte":             {"few": "{0} байта", "many": "{0} байт", "one": "{0} байт", "other": "{0} байта"},
+				"digital-gigabyte":         {"few": "{0} гигабайта", "many": "{0} гигабайт", "one": "{0} гигабайт", "other": "{0} гигабайта"},
+				"digital-kilobyte":         {"few": "{0} килобайта", "many": "{0} килобайт", "one": "{0} килобайт", "other": "{0} килобайта"},
+				"digital-megabyte":         {"few": "{0} мегабайта", "many": "{0} мегабайт", "one": "{0} мегабайт", "other": "{0} мегабайта"},
+				"digital-petabyte":         {"few": "{0} петабайта", "many": "{0} петабайт", "one": "{0} петабайт", "other": "{0} петабайта"},
+				"digital-terabyte":         {"few": "{0} терабайта", "many": "{0} терабайт", "one": "{0} терабайт", "other": "{0} терабайта"},
+				"duration-day":             {"few": "{0} дня", "many": "{0} дней", "one": "{0} день", "other": "{0} дня"},
+				"duration-hour":            {"few": "{0} часа", "many": "{0} часов", "one": "{0} час", "other": "{0} часа"},
+				"duration-millisecond":     {"few": "{0} миллисекунды", "many": "{0} миллисекунд", "one": "{0} миллисекунда", "other": "{0} миллисекунды"},
+				"duration-minute":          {"few": "{0} минуты", "many": "{0} минут", "one": "{0} минута", "other": "{0} минуты"},
+				"duration-month":           {"few": "{0} месяца", "many": "{0} месяцев", "one": "{0} месяц", "other": "{0} месяца"},
+				"duration-second":          {"few": "{0} секунды", "many": "{0} секунд", "one": "{0} секунда", "other": "{0} секунды"},
+				"duration-week":            {"few": "{0} недели", "many": "{0} недель", "one": "{0} неделя", "other": "{0} недели"},
+				"duration-year":            {"few": "{0} года", "many": "{0} лет", "one": "{0} год", "other": "{0} года"},
+				"length-centimeter":        {"few": "{0} сантиметра", "many": "{0} сантиметров", "one": "{0} сантиметр", "other": "{0} сантиметра"},
+				"length-foot":              {"few": "{0} фута", "many": "{0} футов", "one": "{0} фут", "other": "{0} фута"},
+				"length-inch":              {"few": "{0} дюйма", "many": "{0} дюймов", "one": "{0} дюйм", "other": "{0} дюйма"},
+				"length-kilometer":         {"few": "{0} километра", "many": "{0} километров", "one": "{0} километр", "other": "{0} километра"},
+				"length-meter":             {"few": "{0} метра", "many": "{0} метров", "one": "{0} метр", "other": "{0} метра"},
+				"length-mile":              {"few": "{0} мили", "many": "{0} миль", "one": "{0} миля", "other": "{0} мили"},
+				"length-millimeter":        {"few": "{0} миллиметра", "many": "{0} миллиметров", "one": "{0} миллиметр", "other": "{0} миллиметра"},
+				"mass-gram":                {"few": "{0} грамма", "many": "{0} грамм", "one": "{0} грамм", "other": "{0} грамма"},
+				"mass-kilogram":            {"few": "{0} килограмма", "many": "{0} килограмм", "one": "{0} килограмм", "other": "{0} килограмма"},
+				"mass-pound":               {"few": "{0} фунта", "many": "{0} фунтов", "one": "{0} фунт", "other": "{0} фунта"},
+				"speed-kilometer-per-hour": {"few": "{0} километра в час", "many": "{0} километров в час", "one": "{0} километр в час", "other": "{0} километра в час"},
+				"temperature-celsius":      {"few": "{0} градуса Цельсия", "many": "{0} градусов Цельсия", "one": "{0} градус Цельсия", "other": "{0} градуса Цельсия"},
+				"temperature-fahrenheit":   {"few": "{0} градуса Фаренгейта", "many": "{0} градусов Фаренгейта", "one": "{0} градус Фаренгейта", "other": "{0} градуса Фаренгейта"},
+				"volume-liter":             {"few": "{0} литра", "many": "{0} литров", "one": "{0} литр", "other": "{0} литра"},
+			},
+			"narrow": {
+				"concentr-percent":         {"few": "{0} %", "many": "{0} %", "one": "{0} %", "other": "{0} %"},
+				"digital-bit":              {"few": "{0} бита", "many": "{0} бит", "one": "{0} бит", "other": "{0} бита"},
+				"digital-byte":             {"few": "{0} Б", "many": "{0} Б", "one": "{0} Б", "other": "{0} Б"},
+				"digital-gigabyte":         {"few": "{0} ГБ", "many": "{0} ГБ", "one": "{0} ГБ", "other": "{0} ГБ"},
+				"digital-kilobyte":         {"few": "{0} кБ", "many": "{0} кБ", "one": "{0} кБ", "other": "{0} кБ"},
+				"digital-megabyte":         {"few": "{0} МБ", "many": "{0} МБ", "one": "{0} МБ", "other": "{0} МБ"},
+				"digital-petabyte":         {"few": "{0} ПБ", "many": "{0} ПБ", "one": "{0} ПБ", "other": "{0} ПБ"},
+				"digital-terabyte":         {"few": "{0} ТБ", "many": "{0} ТБ", "one": "{0} ТБ", "other": "{0} ТБ"},
+				"duration-day":             {"few": "{0} д.", "many": "{0} д.", "one": "{0} д.", "other": "{0} д."},
+				"duration-hour":            {"few": "{0} ч", "many": "{0} ч", "one": "{0} ч", "other": "{0} ч"},
+				"duration-millisecond":     {"few": "{0} мс", "many": "{0} мс", "one": "{0} мс", "other": "{0} мс"},
+				"duration-minute":          {"few": "{0} мин", "many": "{0} мин", "one": "{0} мин", "other": "{0} мин"},
+				"duration-month":           {"few": "{0} м.", "many": "{0} м.", "one": "{0} м.", "other": "{0} м."},
+				"duration-second":          {"few": "{0} с", "many": "{0} с", "one": "{0} с", "other": "{0} с"},
+				"duration-week":            {"few": "{0} н.", "many": "{0} н.", "one": "{0} н.", "other": "{0} н."},
+				"duration-year":            {"few": "{0} г.", "many": "{0} л.", "one": "{0} г.", "other": "{0} г."},
+				"length-centimeter":        {"few": "{0} см", "many": "{0} см", "one": "{0} см", "other": "{0} см"},
+				"length-foot":              {"few": "{0} фт", "many": "{0} фт", "one": "{0} фт", "other": "{0} фт"},
+				"length-inch":              {"few": "{0} дюйм.", "many": "{0} дюйм.", "one": "{0} дюйм.", "other": "{0} дюйм."},
+				"length-kilometer":         {"few": "{0} км", "many": "{0} км", "one": "{0} км", "other": "{0} км"},
+				"length-meter":             {"few": "{0} м", "many": "{0} м", "one": "{0} м", "other": "{0} м"},
+				"length-mile":              {"few": "{0} ми", "many": "{0} ми", "one": "{0} ми", "other": "{0} ми"},
+				"length-millimeter":        {"few": "{0} мм", "many": "{0} мм", "one": "{0} мм", "other": "{0} мм"},
+				"mass-gram":                {"few": "{0} г", "many": "{0} г", "one": "{0} г", "other": "{0} г"},
+				"mass-kilogram":            {"few": "{0} кг", "many": "{0} кг", "one": "{0} кг", "other": "{0} кг"},
+				"mass-pound":               {"few": "{0} фнт", "many": "{0} фнт", "one": "{0} фнт", "other": "{0} фнт"},
+				"speed-kilometer-per-hour": {"few": "{0} км/ч", "many": "{0} км/ч", "one": "{0} км/ч", "other": "{0} км/ч"},
+				"temperature-celsius":      {"few": "{0} °C", "many": "{0} °C", "one": "{0} °C", "other": "{0} °C"},
+				"temperature-fahrenheit":   {"few": "{0} °F", "many": "{0}°F", "one": "{0}°F", "other": "{0}°F"},
+				"volume-liter":             {"few": "{0} л", "many": "{0} л", "one": "{0} л", "other": "{0} л"},
+			},
+			"short": {
+				"concentr-percent":         {"few": "{0} %", "many": "{0} %", "one": "{0} %", "other": "{0} %"},
+				"digital-bit":              {"few": "{0} бита", "many": "{0} бит", "one": "{0} бит", "other": "{0} бита"},
+				"digital-byte":             {"few": "{0} Б", "many": "{0} Б", "one": "{0} Б", "other": "{0} Б"},
+				"digital-gigabyte":         {"few": "{0} ГБ", "many": "{0} ГБ", "one": "{0} ГБ", "other": "{0} ГБ"},
+				"digital-kilobyte":         {"few": "{0} кБ", "many": "{0} кБ", "one": "{0} кБ", "other": "{0} кБ"},
+				"digital-megabyte":         {"few": "{0} МБ", "many": "{0} МБ", "one": "{0} МБ", "other": "{0} МБ"},
+				"digital-petabyte":         {"few": "{0} ПБ", "many": "{0} ПБ", "one": "{0} ПБ", "other": "{0} ПБ"},
+				"digital-terabyte":         {"few": "{0} ТБ", "many": "{0} ТБ", "one": "{0} ТБ", "other": "{0} ТБ"},
+				"duration-day":             {"few": "{0} дн.", "many": "{0} дн.", "one": "{0} дн.", "other": "{0} дн."},
+				"duration-hour":            {"few": "{0} ч", "many": "{0} ч", "one": "{0} ч", "other": "{0} ч"},
+				"duration-millisecond":     {"few": "{0} мс", "many": "{0} мс", "one": "{0} мс", "other": "{0} мс"},
+				"duration-minute":          {"few": "{0} мин", "many": "{0} мин", "one": "{0} мин", "other": "{0} мин"},
+				"duration-month":           {"few": "{0} мес.", "many": "{0} мес.", "one": "{0} мес.", "other": "{0} мес."},
+				"duration-second":          {"few": "{0} с", "many": "{0} с", "one": "{0} с", "other": "{0} с"},
+				"duration-week":            {"few": "{0} нед.", "many": "{0} нед.", "one": "{0} нед.", "other": "{0} нед."},
+				"duration-year":            {"few": "{0} г.", "many": "{0} л.", "one": "{0} г.", "other": "{0} г."},
+				"length-centimeter":        {"few": "{0} см", "many": "{0} см", "one": "{0} см", "other": "{0} см"},
+				"length-foot":              {"few": "{0} фт", "many": "{0} фт", "one": "{0} фт", "other": "{0} фт"},
+				"length-inch":              {"few": "{0} дюйм.", "many": "{0} дюйм.", "one": "{0} дюйм", "other": "{0} дюйм."},
+				"length-kilometer":         {"few": "{0} км", "many": "{0} км", "one": "{0} км", "other": "{0} км"},
+				"length-meter":             {"few": "{0} м", "many": "{0} м", "one": "{0} м", "other": "{0} м"},
+				"length-mile":              {"few": "{0} ми", "many": "{0} ми", "one": "{0} ми", "other": "{0} ми"},
+				"length-millimeter":        {"few": "{0} мм", "many": "{0} мм", "one": "{0} мм", "other": "{0} мм"},
+				"mass-gram":                {"few": "{0} г", "many": "{0} г", "one": "{0} г", "other": "{0} г"},
+				"mass-kilogram":            {"few": "{0} кг", "many": "{0} кг", "one": "{0} кг", "other": "{0} кг"},
+				"mass-pound":               {"few": "{0} фнт", "many": "{0} фнт", "one": "{0} фнт", "other": "{0} фнт"},
+				"speed-kilometer-per-hour": {"few": "{0} км/ч", "many": "{0} км/ч", "one": "{0} км/ч", "other": "{0} км/ч"},
+				"temperature-celsius":      {"few": "{0} °C", "many": "{0} °C", "one": "{0} °C", "other": "{0} °C"},
+				"temperature-fahrenheit":   {"few": "{0} °F", "many": "{0} °F", "one": "{0} °F", "other": "{0} °F"},
+				"volume-liter":             {"few": "{0} л", "many": "{0} л", "one": "{0} л", "other": "{0} л"},
+			},
+		},
+	},
+	"zh": {
+		Calendar: &Calendar{
+			MonthsAbbreviated:           [12]string{"1月", "2月", "3月", "4月", "5月", "6月", "7月", "8月", "9月", "10月", "11月", "12月"},
+			MonthsWide:                  [12]string{"一月", "二月", "三月", "四月", "五月", "六月", "七月", "八月", "九月", "十月", "十一月", "十二月"},
+			DaysAbbreviated:             [7]string{"周日", "周一", "周二", "周三", "周四", "周五", "周六"},
+			DaysWide:                    [7]string{"星期日", "星期一", "星期二", "星期三", "星期四", "星期五", "星期六"},
+			MonthsStandAloneAbbreviated: [12]string{"1月", "2月", "3月", "4月", "5月", "6月", "7月", "8月", "9月", "10月", "11月", "12月"},
+			MonthsStandAloneWide:        [12]string{"一月", "二月", "三月", "四月", "五月", "六月", "七月", "八月", "九月", "十月", "十一月", "十二月"},
+			DaysStandAloneAbbreviated:   [7]string{"周日", "周一", "周二", "周三", "周四", "周五", "周六"},
+			DaysStandAloneWide:          [7]string{"星期日", "星期一", "星期二", "星期三", "星期四", "星期五", "星期六"},
+			DayPeriods:                  [2]string{"上午", "下午"},
+			ErasAbbreviated:             [2]string{"公元前", "公元"},
+			ErasWide:                    [2]string{"公元前", "公元"},
+			ErasNarrow:                  [2]string{"公元前", "公元"},
+			DateFormats:                 Styles{Full: "y年M月d日EEEE", Long: "y年M月d日", Medium: "y年M月d日", Short: "y/M/d"},
+			TimeFormats:                 Styles{Full: "zzzz HH:mm:ss", Long: "z HH:mm:ss", Medium: "HH:mm:ss", Short: "HH:mm"},
+			DateTimeFormats:             Styles{Full: "{1} {0}", Long: "{1} {0}", Medium: "{1} {0}", Short: "{1} {0}"},
+			AvailableFormats:            map[string]string{"E": "E", "EEEEd": "d日EEEE", "Ed": "d日E", "H": "HH时", "Hm": "HH:mm", "Hms": "HH:mm:ss", "M": "M月", "MEd": "M/dE", "MMM": "MMM", "MMMEd": "M月d日E", "MMMM": "MMMM", "MMMMd": "M月d日", "MMMd": "M月d日", "Md": "M/d", "d": "d日", "h": "ah时", "hm": "ah:mm", "hms": "ah:mm:ss", "ms": "mm:ss", "y": "y年", "yM": "y/M", "yMEd": "y/M/dE", "yMMM": "y年M月", "yMMMEd": "y年M月d日E", "yMMMM": "y年M月", "yMMMMEEEEd": "y年M月d日EEEE", "yMMMMd": "y年M月d日", "yMMMd": "y年M月d日", "yMd": "y/M/d"},
+		},
+		Numbers: &Numbers{
+			Symbols:               Symbols{Decimal: ".", Group: ",", MinusSign: "-"},
+			MinimumGroupingDigits: 1,
+			Compact: map[string]map[int]map[plural.Form]string{
+				"long": {
+					3:  {"other": "0"},
+					4:  {"other": "0万"},
+					5:  {"other": "00万"},
+					6:  {"other": "000万"},
+					7:  {"other": "0000万"},
+					8:  {"other": "0亿"},
+					9:  {"other": "00亿"},
+					10: {"other": "000亿"},
+					11: {"other": "0000亿"},
+					12: {"other": "0万亿"},
+					13: {"other": "00万亿"},
+					14: {"other": "000万亿"},
+				},
+				"short": {
+					3:  {"other": "0"},
+					4:  {"other": "0万"},
+					5:  {"other": "00万"},
+					6:  {"other": "000万"},
+					7:  {"other": "0000万"},
+					8:  {"other": "0亿"},
+					9:  {"other": "00亿"},
+					10: {"other": "000亿"},
+					11: {"other": "0000亿"},
+					12: {"other": "0万亿"},
+					13: {"other": "00万亿"},
+					14: {"other": "000万亿"},
+				},
+			},
+		},
+		RelativeTimes: map[string]*RelativeTime{
+			"day": {
+				Relative: map[int]string{-2: "前天", -1: "昨天", 0: "今天", 1: "明天", 2: "后天"},
+				Future:   map[plural.Form]string{"other": "{0}天后"},
+				Past:     map[plural.Form]string{"other": "{0}天前"},
+			},
+			"day-narrow": {
+				Relative: map[int]string{-2: "前天", -1: "昨天", 0: "今天", 1: "明天", 2: "后天"},
+				Future:   map[plural.Form]string{"other": "{0}天后"},
+				Past:     map[plural.Form]string{"other": "{0}天前"},
+			},
+			"day-short": {
+				Relative: map[int]string{-2: "前天", -1: "昨天", 0: "今天", 1: "明天", 2: "后天"},
+				Future:   map[plural.Form]string{"other": "{0}天后"},
+				Past:     map[plural.Form]string{"other": "{0}天前"},
+			},
+			"hour": {
+				Relative: map[int]string{0: "这一时间 / 此时"},
+				Future:   map[plural.Form]string{"other": "{0}小时后"},
+				Past:     map[plural.Form]string{"other": "{0}小时前"},
+			},
+			"hour-narrow": {
+				Relative: map[int]string{0: "这一时间 / 此时"},
+				Future:   map[plural.Form]string{"other": "{0}小时后"},
+				Past:     map[plural.Form]string{"other": "{0}小时前"},
+			},
+			"hour-short": {
+				Relative: map[int]string{0: "这一时间 / 此时"},
+				Future:   map[plural.Form]string{"other": "{0}小时后"},
+				Past:     map[plural.Form]string{"other": "{0}小时前"},
+			},
+			"minute": {
+				Relative: map[int]string{0: "此刻"},
+				Future:   map[plural.Form]string{"other": "{0}分钟后"},
+				Past:     map[plural.Form]string{"other": "{0}分钟前"},
+			},
+			"minute-narrow": {
+				Relative: map[int]string{0: "此刻"},
+				Future:   map[plural.Form]string{"other": "{0}分钟后"},
+				Past:     map[plural.Form]string{"other": "{0}分钟前"},
+			},
+			"minute-short": {
+				Relative: map[int]string{0: "此刻"},
+				Future:   map[plural.Form]string{"other": "{0}分钟后"},
+				Past:     map[plural.Form]string{"other": "{0}分钟前"},
+			},
+			"month": {
+				Relative: map[int]string{-1: "上个月", 0: "本月", 1: "下个月"},
+				Future:   map[plural.Form]string{"other": "{0}个月后"},
+				Past:     map[plural.Form]string{"other": "{0}个月前"},
+			},
+			"month-narrow": {
+				Relative: map[int]string{-1: "上个月", 0: "本月", 1: "下个月"},
+				Future:   map[plural.Form]string{"other": "{0}个月后"},
+				Past:     map[plural.Form]string{"other": "{0}个月前"},
+			},
+			"month-short": {
+				Relative: map[int]string{-1: "上个月", 0: "本月", 1: "下个月"},
+				Future:   map[plural.Form]string{"other": "{0}个月后"},
+				Past:     map[plural.Form]string{"other": "{0}个月前"},
+			},
+			"quarter": {
+				Relative: map[int]string{-1: "上季度", 0: "本季度", 1: "下季度"},
+				Future:   map[plural.Form]string{"other": "{0}个季度后"},
+				Past:     map[plural.Form]string{"other": "{0}个季度前"},
+			},
+			"quarter-narrow": {
+				Relative: map[int]string{-1: "上季度", 0: "本季度", 1: "下季度"},
+				Future:   map[plural.Form]string{"other": "{0}个季度后"},
+				Past:     map[plural.Form]string{"other": "{0}个季度前"},
+			},
+			"quarter-short": {
+				Relative: map[int]string{-1: "上季度", 0: "本季度", 1: "下季度"},
+				Future:   map[plural.Form]string{"other": "{0}个季度后"},
+				Past:     map[plural.Form]string{"other": "{0}个季度前"},
+			},
+			"second": {
+				Relative: map[int]string{0: "现在"},
+				Future:   map[plural.Form]string{"other": "{0}秒钟后"},
+				Past:     map[plural.Form]string{"other": "{0}秒钟前"},
+			},
+			"second-narrow": {
+				Relative: map[int]string{0: "现在"},
+				Future:   map[plural.Form]string{"other": "{0}秒后"},
+				Past:     map[plural.Form]string{"other": "{0}秒前"},
+			},
+			"second-short": {
+				Relative: map[int]string{0: "现在"},
+				Future:   map[plural.Form]string{"other": "{0}秒后"},
+				Past:     map[plural.Form]string{"other": "{0}秒前"},
+			},
+			"week": {
+				Relative: map[int]string{-1: "上周", 0: "本周", 1: "下周"},
+				Future:   map[plural.Form]string{"other": "{0}周后"},
+				Past:     map[plural.Form]string{"other": "{0}周前"},
+			},
+			"week-narrow": {
+				Relative: map[int]string{-1: "上周", 0: "本周", 1: "下周"},
+				Future:   map[plural.Form]string{"other": "{0}周后"},
+				Past:     map[plural.Form]string{"other": "{0}周前"},
+			},
+			"week-short": {
+				Relative: map[int]string{-1: "上周", 0: "本周", 1: "下周"},
+				Future:   map[plural.Form]string{"other": "{0}周后"},
+				Past:     map[plural.Form]string{"other": "{0}周前"},
+			},
+			"year": {
+				Relative: map[int]string{-1: "去年", 0: "今年", 1: "明年"},
+				Future:   map[plural.Form]string{"other": "{0}年后"},
+				Past:     map[plural.Form]string{"other": "{0}年前"},
+			},
+			"year-narrow": {
+				Relative: map[int]string{-1: "去年", 0: "今年", 1: "明年"},
+				Future:   map[plural.Form]string{"other": "{0}年后"},
+				Past:     map[plural.Form]string{"other": "{0}年前"},
+			},
+			"year-short": {
+				Relative: map[int]string{-1: "去年", 0: "今年", 1: "明年"},
+				Future:   map[plural.Form]string{"other": "{0}年后"},
+				Past:     map[plural.Form]string{"other": "{0}年前"},
+			},
+		},
+		ListPatterns: map[string]*ListPattern{
+			"or":              {Start: "{0}、{1}", Middle: "{0}、{1}", End: "{0}或{1}", Two: "{0}或{1}"},
+			"or-narrow":       {Start: "{0}、{1}", Middle: "{0}、{1}", End: "{0}或{1}", Two: "{0}或{1}"},
+			"or-short":        {Start: "{0}、{1}", Middle: "{0}、{1}", End: "{0}或{1}", Two: "{0}或{1}"},
+			"standard":        {Start: "{0}、{1}", Middle: "{0}、{1}", End: "{0}和{1}", Two: "{0}和{1}"},
+			"standard-narrow": {Start: "{0}、{1}", Middle: "{0}、{1}", End: "{0}、{1}", Two: "{0}、{1}"},
+			"standard-short":  {Start: "{0}、{1}", Middle: "{0}、{1}", End: "{0}和{1}", Two: "{0}和{1}"},
+			"unit":            {Start: "{0}{1}", Middle: "{0}{1}", End: "{0}{1}", Two: "{0}{1}"},
+			"unit-narrow":     {Start: "{0}{1}", Middle: "{0}{1}", End: "{0}{1}", Two: "{0}{1}"},
+			"unit-short":      {Start: "{0}{1}", Middle: "{0}{1}", End: "{0}{1}", Two: "{0}{1}"},
+		},
+		Units: map[string]map[string]map[plural.Form]string{
+			"long": {
+				"concentr-percent":         {"other": "{0}%"},
+				"digital-bit":              {"other": "{0}比特"},
+				"digital-byte":             {"other": "{0}字节"},
+				"digital-gigabyte":         {"other": "{0}吉字节"},
+				"digital-kilobyte":         {"other": "{0}千字节"},
+				"digital-megabyte":         {"other": "{0}兆字节"},
+				"digital-petabyte":         {"other": "{0}拍字节"},
+				"digital-terabyte":         {"other": "{0}太字节"},
+				"duration-day":             {"other": "{0}天"},
+				"duration-hour":            {"other": "{0}小时"},
+				"duration-millisecond":     {"other": "{0}毫秒"},
+				"duration-minute":          {"other": "{0}分钟"},
+				"duration-month":           {"other": "{0}个月"},
+				"duration-second":          {"other": "{0}秒钟"},
+				"duration-week":            {"other": "{0}周"},
+				"duration-year":            {"other": "{0}年"},
+				"length-centimeter":        {"other": "{0}厘米"},
+				"length-foot":              {"other": "{0}英尺"},
+				"length-inch":              {"other": "{0}英寸"},
+				"length-kilometer":         {"other": "{0}公里"},
+				"length-meter":             {"other": "{0}米"},
+				"length-mile":              {"other": "{0}英里"},
+				"length-millimeter":        {"other": "{0}毫米"},
+				"mass-gram":                {"other": "{0}克"},
+				"mass-kilogram":            {"other": "{0}千克"},
+				"mass-pound":               {"other": "{0}磅"},
+				"speed-kilometer-per-hour": {"other": "每小时{0}公里"},
+				"temperature-celsius":      {"other": "{0}摄氏度"},
+				"temperature-fahrenheit":   {"other": "{0}华氏度"},
+				"volume-liter":             {"other": "{0}升"},
+			},
+			"narrow": {
+				"concentr-percent":         {"other": "{0}%"},
+				"digital-bit":              {"other": "{0} b"},
+				"digital-byte":             {"other": "{0} B"},
+				"digital-gigabyte":         {"other": "{0} GB"},
+				"digital-kilobyte":         {"other": "{0} kB"},
+				"digital-megabyte":         {"other": "{0} MB"},
+				"digital-petabyte":         {"other": "{0} PB"},
+				"digital-terabyte":         {"other": "{0} TB"},
+				"duration-day":             {"other": "{0}天"},
+				"duration-hour":            {"other": "{0}小时"},
+				"duration-millisecond":     {"other": "{0}ms"},
+				"duration-minute":          {"other": "{0}分钟"},
+				"duration-month":           {"other": "{0}个月"},
+				"duration-second":          {"other": "{0}秒"},
+				"duration-week":            {"other": "{0}周"},
+				"duration-year":            {"other": "{0}年"},
+				"length-centimeter":        {"other": "{0}厘米"},
+				"length-foot":              {"other": "{0}′"},
+				"length-inch":              {"other": "{0}″"},
+				"length-kilometer":         {"other": "{0}公里"},
+				"length-meter":             {"other": "{0}米"},
+				"length-mile":              {"other": "{0}mi"},
+				"length-millimeter":        {"other": "{0}毫米"},
+				"mass-gram":                {"other": "{0}g"},
+				"mass-kilogram":            {"other": "{0}kg"},
+				"mass-pound":               {"other": "{0}#"},
+				"speed-kilometer-per-hour": {"other": "{0}km/h"},
+				"temperature-celsius":      {"other": "{0}°C"},
+				"temperature-fahrenheit":   {"other": "{0}°F"},
+				"volume-liter":             {"other": "{0}升"},
+			},
+			"short": {
+				"concentr-percent":         {"other": "{0}%"},
+				"digital-bit":              {"other": "{0} b"},
+				"digital-byte":             {"other": "{0} B"},
+				"digital-gigabyte":         {"other": "{0} GB"},
+				"digital-kilobyte":         {"other": "{0} kB"},
+				"digital-megabyte":         {"other": "{0} MB"},
+				"digital-petabyte":         {"other": "{0} PB"},
+				"digital-terabyte":         {"other": "{0} TB"},
+				"duration-day":             {"other": "{0}天"},
+				"duration-hour":            {"other": "{0}小时"},
+				"duration-millisecond":     {"other": "{0}毫秒"},
+				"duration-minute":          {"other": "{0}分钟"},
+				"duration-month":           {"other": "{0}个月"},
+				"duration-second":          {"other": "{0}秒"},
+				"duration-week":            {"other": "{0}周"},
+				"duration-year":            {"other": "{0}年"},
+				"length-centimeter":        {"other": "{0}厘米"},
+				"length-foot":              {"other": "{0}英尺"},
+				"length-inch":              {"other": "{0}英寸"},
+				"length-kilometer":         {"other": "{0}公里"},
+				"length-meter":             {"other": "{0}米"},
+				"length-mile":              {"other": "{0}英里"},
+				"length-millimeter":        {"other": "{0}毫米"},
+				"mass-gram":                {"other": "{0} g"},
+				"mass-kilogram":            {"other": "{0} kg"},
+				"mass-pound":               {"other": "{0} lb"},
+				"speed-kilometer-per-hour": {"other": "{0} km/h"},
+				"temperature-celsius":      {"other": "{0}°C"},
+				"temperature-fahrenheit":   {"other": "{0}°F"},
+				"volume-liter":             {"other": "{0}升"},
+			},
+		},
+	},
+}
@@ -0,0 +1,278 @@
+// Copyright 2026 Joe Chen. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package cldr
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Calendar contains the Gregorian calendar data of a locale.
+type Calendar struct {
+	MonthsAbbreviated [12]string
+	MonthsWide        [12]string
+	DaysAbbreviated   [7]string // Starts from Sunday
+	DaysWide          [7]string // Starts from Sunday
+	// Names in the stand-alone context, e.g. used by "LLLL" and "cccc" without
+	// the day of month. Some languages inflect month names differently in
+	// the format context (e.g. "января" vs. "январь" in Russian).
+	MonthsStandAloneAbbreviated [12]string
+	MonthsStandAloneWide        [12]string
+	DaysStandAloneAbbreviated   [7]string // Starts from Sunday
+	DaysStandAloneWide          [7]string // Starts from Sunday
+	DayPeriods                  [2]string // AM and PM
+	ErasAbbreviated             [2]string // BC and AD
+	ErasWide                    [2]string // BC and AD
+	ErasNarrow                  [2]string // BC and AD
+	DateFormats                 Styles
+	TimeFormats                 Styles
+	DateTimeFormats             Styles // "{1}" is the date and "{0}" is the time
+	AvailableFormats            map[string]string
+}
+
+// Pattern returns the date pattern for the given style. The style is either
+// one of "full", "long", "medium" and "short", or a skeleton (e.g. "yMMMd").
+// The withDate and withTime control which parts to include for a named style,
+// and must match fields of a skeleton. A skeleton of both date and time fields
+// (e.g. "yMMMdHm") that doesn't exist in the available formats is combined from
+// patterns of its date and time fields. It returns false if no pattern is
+// found.
+func (c *Calendar) Pattern(style string, withDate, withTime bool) (string, bool) {
+	if !withDate && !withTime {
+		return "", false
+	}
+
+	datePattern, ok := c.DateFormats.Get(style)
+	if !ok {
+		return c.skeletonPattern(style, withDate, withTime)
+	}
+	timePattern, _ := c.TimeFormats.Get(style)
+
+	switch {
+	case !withTime:
+		return datePattern, true
+	case !withDate:
+		return timePattern, true
+	}
+	glue, _ := c.DateTimeFormats.Get(style)
+	return strings.NewReplacer("{1}", datePattern, "{0}", timePattern).Replace(glue), true
+}
+
+// timeFields is the list of skeleton fields of the time of day.
+const timeFields = "abBhHkKjJCmsSAzZOvVXx"
+
+// skeletonPattern returns the date pattern for the skeleton, see Pattern.
+func (c *Calendar) skeletonPattern(skeleton string, withDate, withTime bool) (string, bool) {
+	var dateSkeleton, timeSkeleton strings.Builder
+	for _, r := range skeleton {
+		if strings.ContainsRune(timeFields, r) {
+			timeSkeleton.WriteRune(r)
+		} else {
+			dateSkeleton.WriteRune(r)
+		}
+	}
+	if (dateSkeleton.Len() > 0) != withDate || (timeSkeleton.Len() > 0) != withTime {
+		return "", false
+	}
+
+	if pattern, ok := c.AvailableFormats[skeleton]; ok {
+		return pattern, true
+	} else if !withDate || !withTime {
+		return "", false
+	}
+
+	datePattern, ok := c.AvailableFormats[dateSkeleton.String()]
+	if !ok {
+		return "", false
+	}
+	timePattern, ok := c.AvailableFormats[timeSkeleton.String()]
+	if !ok {
+		return "", false
+	}
+
+	// The style to glue the date and the time is decided by the width of the
+	// month and the weekday, see
+	// https://unicode.org/reports/tr35/tr35-dates.html#Missing_Skeleton_Fields.
+	style := "short"
+	switch date := dateSkeleton.String(); {
+	case strings.Contains(date, "MMMM") && strings.Contains(date, "EEEE"):
+		style = "full"
+	case strings.Contains(date, "MMMM"):
+		style = "long"
+	case strings.Contains(date, "MMM"):
+		style = "medium"
+	}
+	glue, _ := c.DateTimeFormats.Get(style)
+	return strings.NewReplacer("{1}", datePattern, "{0}", timePattern).Replace(glue), true
+}
+
+// Format formats the time using the given date pattern, see
+// https://unicode.org/reports/tr35/tr35-dates.html#Date_Field_Symbol_Table.
+// Unsupported fields are written as-is.
+func (c *Calendar) Format(pattern string, t time.Time) string {
+	var b strings.Builder
+	b.Grow(len(pattern) + 16)
+	for i := 0; i < len(pattern); {
+		ch := pattern[i]
+		switch {
+		case ch == '\'':
+			// A pair of single quotes is a literal single quote, either inside or
+			// outside of a quoted text.
+			if i+1 < len(pattern) && pattern[i+1] == '\'' {
+				b.WriteByte('\'')
+				i += 2
+				continue
+			}
+
+			end := i + 1
+			for end < len(pattern) {
+				if pattern[end] == '\'' {
+					if end+1 < len(pattern) && pattern[end+1] == '\'' {
+						b.WriteByte('\'')
+						end += 2
+						continue
+					}
+					break
+				}
+				b.WriteByte(pattern[end])
+				end++
+			}
+			i = end + 1
+
+		case ('a' <= ch && ch <= 'z') || ('A' <= ch && ch <= 'Z'):
+			end := i + 1
+			for end < len(pattern) && pattern[end] == ch {
+				end++
+			}
+			c.appendField(&b, pattern[i:end], t)
+			i = end
+
+		default:
+			b.WriteByte(ch)
+			i++
+		}
+	}
+	return b.String()
+}
+
+func (c *Calendar) appendField(b *strings.Builder, field string, t time.Time) {
+	count := len(field)
+	switch field[0] {
+	case 'G':
+		era := 1 // AD
+		if t.Year() <= 0 {
+			era = 0 // BC
+		}
+		switch {
+		case count <= 3:
+			b.WriteString(c.ErasAbbreviated[era])
+		case count == 4:
+			b.WriteString(c.ErasWide[era])
+		default:
+			b.WriteString(c.ErasNarrow[era])
+		}
+	case 'y':
+		// The year of the era, i.e. 1 BC is the year 0.
+		year := t.Year()
+		if year <= 0 {
+			year = 1 - year
+		}
+		if count == 2 {
+			appendInt(b, year%100, 2)
+		} else {
+			appendInt(b, year, count)
+		}
+	case 'M':
+		switch {
+		case count <= 2:
+			appendInt(b, int(t.Month()), count)
+		case count == 3:
+			b.WriteString(c.MonthsAbbreviated[t.Month()-1])
+		default:
+			b.WriteString(c.MonthsWide[t.Month()-1])
+		}
+	case 'L':
+		switch {
+		case count <= 2:
+			appendInt(b, int(t.Month()), count)
+		case count == 3:
+			b.WriteString(c.MonthsStandAloneAbbreviated[t.Month()-1])
+		default:
+			b.WriteString(c.MonthsStandAloneWide[t.Month()-1])
+		}
+	case 'd':
+		appendInt(b, t.Day(), count)
+	case 'E':
+		if count <= 3 {
+			b.WriteString(c.DaysAbbreviated[t.Weekday()])
+		} else {
+			b.WriteString(c.DaysWide[t.Weekday()])
+		}
+	case 'c':
+		switch {
+		case count <= 2:
+			// NOTE: The first day of week is always Sunday as CLDR week data is
+			//  not included.
+			appendInt(b, int(t.Weekday())+1, count)
+		case count == 3:
+			b.WriteString(c.DaysStandAloneAbbreviated[t.Weekday()])
+		default:
+			b.WriteString(c.DaysStandAloneWide[t.Weekday()])
+		}
+	case 'a', 'b', 'B':
+		// NOTE: CLDR day period rules are not included, thus "noon", "midnight"
+		//  and flexible day periods (e.g. "in the morning") are formatted as AM
+		//  and PM, which is the same fallback as ICU.
+		if t.Hour() < 12 {
+			b.WriteString(c.DayPeriods[0])
+		} else {
+			b.WriteString(c.DayPeriods[1])
+		}
+	case 'h':
+		hour := t.Hour() % 12
+		if hour == 0 {
+			hour = 12
+		}
+		appendInt(b, hour, count)
+	case 'H':
+		appendInt(b, t.Hour(), count)
+	case 'K':
+		appendInt(b, t.Hour()%12, count)
+	case 'k':
+		hour := t.Hour()
+		if hour == 0 {
+			hour = 24
+		}
+		appendInt(b, hour, count)
+	case 'm':
+		appendInt(b, t.Minute(), count)
+	case 's':
+		appendInt(b, t.Second(), count)
+	case 'S':
+		fraction := strconv.Itoa(t.Nanosecond() + 1e9)[1:] // Keep the leading zeros
+		for len(fraction) < count {
+			fraction += "0"
+		}
+		b.WriteString(fraction[:count])
+	case 'z', 'v', 'V':
+		// NOTE: CLDR time zone names are not included, the abbreviation from the
+		//  time zone database is the closest thing we have.
+		b.WriteString(t.Format("MST"))
+	case 'Z', 'x', 'X':
+		b.WriteString(t.Format("-0700"))
+	default:
+		b.WriteString(field)
+	}
+}
+
+// appendInt writes the integer with at least the given number of digits.
+func appendInt(b *strings.Builder, v, digits int) {
+	s := strconv.Itoa(v)
+	for i := len(s); i < digits; i++ {
+		b.WriteByte('0')
+	}
+	b.WriteString(s)
+}
@@ -0,0 +1,170 @@
+// Copyright 2026 Joe Chen. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package cldr
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCalendar_Pattern(t *testing.T) {
+	c := locales["en"].Calendar
+	tests := []struct {
+		name     string
+		style    string
+		withDate bool
+		withTime bool
+		want     string
+		wantOK   bool
+	}{
+		{
+			name:     "date",
+			style:    "medium",
+			withDate: true,
+			want:     "MMM d, y",
+			wantOK:   true,
+		},
+		{
+			name:     "time",
+			style:    "short",
+			withTime: true,
+			want:     "h:mm a",
+			wantOK:   true,
+		},
+		{
+			name:     "date and time",
+			style:    "full",
+			withDate: true,
+			withTime: true,
+			want:     "EEEE, MMMM d, y 'at' h:mm:ss a zzzz",
+			wantOK:   true,
+		},
+		{
+			name:     "skeleton",
+			style:    "yMMMd",
+			withDate: true,
+			want:     "MMM d, y",
+			wantOK:   true,
+		},
+		{
+			name:     "skeleton of date and time",
+			style:    "yMMMdHm",
+			withDate: true,
+			withTime: true,
+			want:     "MMM d, y, HH:mm",
+			wantOK:   true,
+		},
+		{
+			name:     "skeleton of date for time",
+			style:    "yMMMd",
+			withTime: true,
+		},
+		{
+			name:     "skeleton of time for date and time",
+			style:    "Hm",
+			withDate: true,
+			withTime: true,
+		},
+		{
+			name:     "unknown skeleton",
+			style:    "QQQQ",
+			withDate: true,
+		},
+		{
+			name:  "nothing to include",
+			style: "medium",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, ok := c.Pattern(test.style, test.withDate, test.withTime)
+			assert.Equal(t, test.wantOK, ok)
+			assert.Equal(t, test.want, got)
+		})
+	}
+}
+
+func TestCalendar_Format_standAlone(t *testing.T) {
+	tm := time.Date(2024, time.January, 2, 15, 4, 5, 0, time.UTC)
+	tests := []struct {
+		locale  string
+		pattern string
+		want    string
+	}{
+		{locale: "ru", pattern: "d MMMM y", want: "2 января 2024"},
+		{locale: "ru", pattern: "LLLL y 'г'.", want: "январь 2024 г."},
+		{locale: "ru", pattern: "LLL", want: "янв."},
+		{locale: "pl", pattern: "LLLL y", want: "styczeń 2024"},
+		{locale: "en", pattern: "LLLL cccc c", want: "January Tuesday 3"},
+	}
+	for _, test := range tests {
+		t.Run(test.locale+"/"+test.pattern, func(t *testing.T) {
+			c := locales[test.locale].Calendar
+			assert.Equal(t, test.want, c.Format(test.pattern, tm))
+		})
+	}
+
+	t.Run("skeleton", func(t *testing.T) {
+		c := locales["ru"].Calendar
+		pattern, ok := c.Pattern("yMMMM", true, false)
+		assert.True(t, ok)
+		assert.Equal(t, "январь 2024 г.", c.Format(pattern, tm))
+	})
+}
+
+func TestCalendar_Format(t *testing.T) {
+	c := locales["en"].Calendar
+	tm := time.Date(2006, time.January, 2, 15, 4, 5, 120000000, time.UTC)
+	tests := []struct {
+		pattern string
+		want    string
+	}{
+		{pattern: "y yy yyyy", want: "2006 06 2006"},
+		{pattern: "M MM MMM MMMM", want: "1 01 Jan January"},
+		{pattern: "d dd E EEEE", want: "2 02 Mon Monday"},
+		{pattern: "h hh H HH K k a", want: "3 03 15 15 3 15 PM"},
+		{pattern: "h b B", want: "3 PM PM"},
+		{pattern: "G GGGG GGGGG", want: "AD Anno Domini A"},
+		{pattern: "m mm s ss S SSS SSSS", want: "4 04 5 05 1 120 1200"},
+		{pattern: "z Z", want: "UTC +0000"},
+		{pattern: "'at' '' 'o''clock'", want: "at ' o'clock"},
+		{pattern: "y年M月d日", want: "2006年1月2日"},
+	}
+	for _, test := range tests {
+		t.Run(test.pattern, func(t *testing.T) {
+			got := c.Format(test.pattern, tm)
+			assert.Equal(t, test.want, got)
+		})
+	}
+}
+
+func TestCalendar_Format_era(t *testing.T) {
+	tm := time.Date(2024, time.January, 2, 15, 4, 5, 0, time.UTC)
+	tests := []struct {
+		locale string
+		want   string
+	}{
+		{locale: "en", want: "Jan 2, 2024 AD"},
+		{locale: "ja", want: "西暦2024年1月2日"},
+	}
+	for _, test := range tests {
+		t.Run(test.locale, func(t *testing.T) {
+			c := locales[test.locale].Calendar
+			pattern, ok := c.Pattern("GyMMMd", true, false)
+			assert.True(t, ok)
+			assert.Contains(t, pattern, "G")
+			assert.Equal(t, test.want, c.Format(pattern, tm))
+		})
+	}
+
+	t.Run("before Christ", func(t *testing.T) {
+		c := locales["en"].Calendar
+		// The year 0 is 1 BC.
+		assert.Equal(t, "44 BC", c.Format("y G", time.Date(-43, time.March, 15, 0, 0, 0, 0, time.UTC)))
+		assert.Equal(t, "1 Before Christ", c.Format("y GGGG", time.Date(0, time.March, 15, 0, 0, 0, 0, time.UTC)))
+	})
+}
@@ -0,0 +1,7 @@
+// Copyright 2026 Joe Chen. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+// Package cldr provides the subset of CLDR locale data that is needed for
+// formatting values, see http://cldr.unicode.org/.
+package cldr
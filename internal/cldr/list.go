@@ -0,0 +1,25 @@
+// Copyright 2026 Joe Chen. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package cldr
+
+// ListPattern contains the patterns to join a list of items, see
+// https://unicode.org/reports/tr35/tr35-general.html#ListPatterns. In every
+// pattern, "{0}" and "{1}" are the placeholders of the items to join.
+type ListPattern struct {
+	Start  string // Joins the first two items of a list with more than 2 items
+	Middle string // Joins the items in the middle
+	End    string // Joins the last two items of a list with more than 2 items
+	Two    string // Joins the items of a list with exactly 2 items
+}
+
+// ListPattern returns the list pattern of the type (i.e. one of "standard",
+// "or" and "unit") in the width (i.e. one of "long", "short" and "narrow"). It
+// returns nil if no data is found.
+func (l *Locale) ListPattern(typ, width string) *ListPattern {
+	if width != "long" {
+		typ += "-" + width
+	}
+	return l.ListPatterns[typ]
+}
@@ -0,0 +1,66 @@
+// Copyright 2026 Joe Chen. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package cldr
+
+import (
+	"strings"
+
+	"unknwon.dev/i18n/internal/plural"
+)
+
+// Symbols contains the number symbols of a locale.
+type Symbols struct {
+	Decimal   string
+	Group     string
+	MinusSign string
+}
+
+// Numbers contains the number formatting data of a locale.
+type Numbers struct {
+	Symbols Symbols
+	// The minimum number of digits before the first grouping separator, e.g. 2
+	// means "1234" is not grouped but "12,345" is.
+	MinimumGroupingDigits int
+	// The compact decimal patterns by plural forms keyed by the width and then
+	// the exponent of the power of 10, e.g. "short" and 6 for millions.
+	Compact map[string]map[int]map[plural.Form]string
+}
+
+// Format localizes the number in the form of "-1234.5", i.e. the output of
+// strconv.FormatFloat with the 'f' format, by using the symbols and grouping
+// integer digits by thousands.
+func (n *Numbers) Format(number string) string {
+	var b strings.Builder
+	b.Grow(len(number) + len(number)/3*len(n.Symbols.Group))
+	if strings.HasPrefix(number, "-") {
+		b.WriteString(n.Symbols.MinusSign)
+		number = number[1:]
+	}
+
+	integer, fraction := number, ""
+	if i := strings.IndexByte(number, '.'); i >= 0 {
+		integer, fraction = number[:i], number[i+1:]
+	}
+
+	if len(integer) >= 3+n.MinimumGroupingDigits {
+		first := len(integer) % 3
+		if first == 0 {
+			first = 3
+		}
+		b.WriteString(integer[:first])
+		for i := first; i < len(integer); i += 3 {
+			b.WriteString(n.Symbols.Group)
+			b.WriteString(integer[i : i+3])
+		}
+	} else {
+		b.WriteString(integer)
+	}
+
+	if fraction != "" {
+		b.WriteString(n.Symbols.Decimal)
+		b.WriteString(fraction)
+	}
+	return b.String()
+}
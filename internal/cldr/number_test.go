@@ -0,0 +1,34 @@
+// Copyright 2026 Joe Chen. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package cldr
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNumbers_Format(t *testing.T) {
+	tests := []struct {
+		locale string
+		number string
+		want   string
+	}{
+		{locale: "en", number: "0", want: "0"},
+		{locale: "en", number: "123", want: "123"},
+		{locale: "en", number: "1234", want: "1,234"},
+		{locale: "en", number: "-1234567.89", want: "-1,234,567.89"},
+		{locale: "de", number: "1234567.5", want: "1.234.567,5"},
+		{locale: "fr", number: "12345", want: "12 345"},
+		{locale: "es", number: "1234", want: "1234"},
+		{locale: "es", number: "12345", want: "12.345"},
+	}
+	for _, test := range tests {
+		t.Run(test.locale+"/"+test.number, func(t *testing.T) {
+			got := locales[test.locale].Numbers.Format(test.number)
+			assert.Equal(t, test.want, got)
+		})
+	}
+}
@@ -0,0 +1,27 @@
+// Copyright 2026 Joe Chen. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package cldr
+
+import (
+	"unknwon.dev/i18n/internal/plural"
+)
+
+// RelativeTime contains the relative time data of a unit in a width, see
+// https://unicode.org/reports/tr35/tr35-dates.html#Calendar_Fields.
+type RelativeTime struct {
+	Relative map[int]string         // The special wording by offset, e.g. -1 => "yesterday"
+	Future   map[plural.Form]string // e.g. "in {0} days"
+	Past     map[plural.Form]string // e.g. "{0} days ago"
+}
+
+// RelativeTime returns the relative time data of the unit (e.g. "day") in the
+// width, i.e. one of "long", "short" and "narrow". It returns nil if no data is
+// found.
+func (l *Locale) RelativeTime(unit, width string) *RelativeTime {
+	if width != "long" {
+		unit += "-" + width
+	}
+	return l.RelativeTimes[unit]
+}
@@ -0,0 +1,18 @@
+// Copyright 2026 Joe Chen. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package cldr
+
+import (
+	"unknwon.dev/i18n/internal/plural"
+)
+
+// Unit returns the patterns of the unit (e.g. "digital-megabyte") in the width
+// (i.e. one of "long", "short" and "narrow") by plural forms, see
+// https://unicode.org/reports/tr35/tr35-general.html#Unit_Elements. In every
+// pattern, "{0}" is the placeholder of the number. It returns nil if no data is
+// found.
+func (l *Locale) Unit(unit, width string) map[plural.Form]string {
+	return l.Units[width][unit]
+}
@@ -97,6 +97,7 @@ func DefaultRules() Rules {
 {{range .PluralGroups}}
 	addPluralRules(rules, {{printf "%#v" .SplitLocales}}, &Rule{
 		PluralForms: newPluralFormSet({{range $i, $e := .PluralRules}}{{if $i}}, {{end}}{{$e.CountTitle}}{{end}}),
+		GettextPluralForms: {{printf "%q" .GettextPluralForms}},
 		PluralFormFunc: func(ops *Operands) Form { {{range .PluralRules}}{{if .GoCondition}}
 			// {{.Condition}}
 			if {{.GoCondition}} {
@@ -129,9 +130,11 @@ func Test{{.Name}}(t *testing.T) {
 	{{if .IntegerExamples}}tests = appendIntegerTests(tests, {{.CountTitle}}, {{printf "%#v" .IntegerExamples}}){{end}}
 	{{if .DecimalExamples}}tests = appendDecimalTests(tests, {{.CountTitle}}, {{printf "%#v" .DecimalExamples}}){{end}}
 	{{end}}
+	forms := []Form{ {{range $i, $e := .PluralRules}}{{if $i}}, {{end}}{{$e.CountTitle}}{{end}} }
 	locales := {{printf "%#v" .SplitLocales}}
 	for _, locale := range locales {
 	  runTests(t, locale, tests)
+	  runGettextTests(t, locale, {{printf "%q" .GettextPluralForms}}, forms)
   }
 }
 {{end}}
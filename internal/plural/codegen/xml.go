@@ -8,6 +8,7 @@ import (
 	"encoding/xml"
 	"fmt"
 	"regexp"
+	"strconv"
 	"strings"
 )
 
@@ -34,6 +35,31 @@ func (pg *PluralGroup) SplitLocales() []string {
 	return strings.Split(pg.Locales, " ")
 }
 
+// GettextPluralForms returns the "Plural-Forms" header of gettext for integers,
+// where plural forms are indexed in the order of rules.
+func (pg *PluralGroup) GettextPluralForms() (string, error) {
+	n := len(pg.PluralRules)
+	expr := strconv.Itoa(n - 1) // The last rule is always "other"
+	for i := n - 2; i >= 0; i-- {
+		cond, err := pg.PluralRules[i].GettextCondition()
+		if err != nil {
+			return "", err
+		}
+		switch cond {
+		case "0":
+			continue
+		case "1":
+			expr = strconv.Itoa(i)
+			continue
+		}
+		expr = fmt.Sprintf("%s ? %d : %s", cond, i, expr)
+	}
+	if n > 1 {
+		expr = "(" + expr + ")"
+	}
+	return fmt.Sprintf("nplurals=%d; plural=%s;", n, expr), nil
+}
+
 // PluralRule is the rule for a single plural form.
 type PluralRule struct {
 	Count string `xml:"count,attr"`
@@ -82,17 +108,32 @@ func (pr *PluralRule) DecimalExamples() []string {
 
 var relationRegexp = regexp.MustCompile(`([cnieftvw])(?:\s*%\s*([0-9]+))?\s*(!=|=)(.*)`)
 
-// GoCondition converts the XML condition to valid Go code.
-func (pr *PluralRule) GoCondition() string {
+// parseRelation parses the relation of the XML condition, e.g. "n % 10 = 1",
+// into the operand, the modulus, the operator and the right hand side.
+func parseRelation(relation string) (lvar, lmod, op, rhs string, err error) {
+	parts := relationRegexp.FindStringSubmatch(relation)
+	if parts == nil {
+		return "", "", "", "", fmt.Errorf("unrecognized relation %q", strings.TrimSpace(relation))
+	}
+	return parts[1], parts[2], parts[3], strings.TrimSpace(parts[4]), nil
+}
+
+// GoCondition converts the XML condition to valid Go code. It returns an empty
+// string if the condition is empty, i.e. always true.
+func (pr *PluralRule) GoCondition() (string, error) {
+	if strings.TrimSpace(pr.Condition()) == "" {
+		return "", nil
+	}
+
 	var ors []string
 	for _, and := range strings.Split(pr.Condition(), "or") {
 		var ands []string
 		for _, relation := range strings.Split(and, "and") {
-			parts := relationRegexp.FindStringSubmatch(relation)
-			if parts == nil {
-				continue
+			lvar, lmod, op, rhs, err := parseRelation(relation)
+			if err != nil {
+				return "", err
 			}
-			lvar, lmod, op, rhs := strings.Title(parts[1]), parts[2], parts[3], strings.TrimSpace(parts[4])
+			lvar = strings.Title(lvar)
 			if op == "=" {
 				op = "=="
 			}
@@ -143,5 +184,98 @@ func (pr *PluralRule) GoCondition() string {
 		}
 		ors = append(ors, strings.Join(ands, " && "))
 	}
-	return strings.Join(ors, " ||\n")
+	return strings.Join(ors, " ||\n"), nil
+}
+
+// GettextCondition converts the XML condition to the C expression of gettext
+// for integers, where the operand "n" is the integer and other operands than "i"
+// are always 0. It returns "1" if the condition is always true, and "0" if it is
+// never true for integers.
+func (pr *PluralRule) GettextCondition() (string, error) {
+	if strings.TrimSpace(pr.Condition()) == "" {
+		return "1", nil
+	}
+
+	var ors [][]string
+	for _, and := range strings.Split(pr.Condition(), "or") {
+		var ands []string
+		never := false
+		for _, relation := range strings.Split(and, "and") {
+			lvar, lmod, op, rhs, err := parseRelation(relation)
+			if err != nil {
+				return "", err
+			}
+
+			x := "n"
+			if lmod != "" {
+				x = "n%" + lmod
+			}
+			zero := lvar != "n" && lvar != "i"
+			matched := false // Whether 0 is in the right hand side
+			var eqs, nes []string
+			for _, rh := range strings.Split(rhs, ",") {
+				from, to := rh, rh
+				if parts := strings.Split(rh, ".."); len(parts) == 2 {
+					from, to = parts[0], parts[1]
+				}
+				if zero {
+					a, err := strconv.Atoi(strings.TrimSpace(from))
+					if err != nil {
+						return "", fmt.Errorf("parse range %q: %v", rh, err)
+					}
+					b, err := strconv.Atoi(strings.TrimSpace(to))
+					if err != nil {
+						return "", fmt.Errorf("parse range %q: %v", rh, err)
+					}
+					matched = matched || (a <= 0 && 0 <= b)
+				} else if from == to {
+					eqs = append(eqs, x+"=="+from)
+					nes = append(nes, x+"!="+from)
+				} else {
+					eqs = append(eqs, fmt.Sprintf("(%s>=%s && %s<=%s)", x, from, x, to))
+					nes = append(nes, fmt.Sprintf("(%s<%s || %s>%s)", x, from, x, to))
+				}
+			}
+
+			if zero {
+				if matched != (op == "=") {
+					never = true
+					break
+				}
+				continue // Always true
+			}
+			if op == "=" {
+				r := strings.Join(eqs, " || ")
+				if len(eqs) > 1 {
+					r = "(" + r + ")"
+				}
+				ands = append(ands, r)
+			} else {
+				ands = append(ands, nes...)
+			}
+		}
+
+		if never {
+			continue
+		} else if len(ands) == 0 {
+			return "1", nil
+		}
+		ors = append(ors, ands)
+	}
+
+	switch len(ors) {
+	case 0:
+		return "0", nil
+	case 1:
+		return strings.Join(ors[0], " && "), nil
+	}
+	conds := make([]string, 0, len(ors))
+	for _, ands := range ors {
+		cond := strings.Join(ands, " && ")
+		if len(ands) > 1 {
+			cond = "(" + cond + ")"
+		}
+		conds = append(conds, cond)
+	}
+	return "(" + strings.Join(conds, " || ") + ")", nil
 }
@@ -0,0 +1,245 @@
+// Copyright 2026 Joe Chen. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package plural
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ParseGettextPluralForms parses the "Plural-Forms" header of gettext, e.g.
+// "nplurals=2; plural=(n != 1);", and returns the number of plural forms and
+// the function that returns the index of the plural form of an integer.
+func ParseGettextPluralForms(header string) (nplurals int, plural func(n int64) int, err error) {
+	var expr string
+	for _, field := range strings.Split(header, ";") {
+		fields := strings.SplitN(field, "=", 2)
+		if len(fields) != 2 {
+			continue
+		}
+
+		value := strings.TrimSpace(fields[1])
+		switch strings.TrimSpace(fields[0]) {
+		case "nplurals":
+			nplurals, err = strconv.Atoi(value)
+			if err != nil || nplurals < 1 {
+				return 0, nil, fmt.Errorf("invalid nplurals %q", value)
+			}
+		case "plural":
+			expr = value
+		}
+	}
+	if nplurals == 0 {
+		return 0, nil, fmt.Errorf("missing nplurals")
+	} else if expr == "" {
+		return 0, nil, fmt.Errorf("missing plural")
+	}
+
+	p := &gettextParser{s: expr}
+	eval, err := p.parseTernary()
+	if err == nil && p.next() != "" {
+		err = fmt.Errorf("unexpected %q", p.next())
+	}
+	if err != nil {
+		return 0, nil, fmt.Errorf("parse plural %q: %v", expr, err)
+	}
+
+	plural = func(n int64) int {
+		i := eval(n)
+		if i < 0 || i >= int64(nplurals) {
+			return nplurals - 1
+		}
+		return int(i)
+	}
+	return nplurals, plural, nil
+}
+
+// gettextExpr evaluates an expression of gettext with the integer.
+type gettextExpr func(n int64) int64
+
+// gettextParser is a recursive descent parser of C expressions of gettext.
+type gettextParser struct {
+	s string
+}
+
+var gettextOperators = []string{"||", "&&", "==", "!=", "<=", ">=", "<", ">", "?", ":", "!", "+", "-", "*", "/", "%", "(", ")"}
+
+// next returns the next token without consuming it, or "" at the end.
+func (p *gettextParser) next() string {
+	p.s = strings.TrimSpace(p.s)
+	if p.s == "" {
+		return ""
+	}
+
+	if c := p.s[0]; c >= '0' && c <= '9' {
+		i := 1
+		for i < len(p.s) && p.s[i] >= '0' && p.s[i] <= '9' {
+			i++
+		}
+		return p.s[:i]
+	}
+	for _, op := range gettextOperators {
+		if strings.HasPrefix(p.s, op) {
+			return op
+		}
+	}
+	return p.s[:1]
+}
+
+// consume consumes the next token if it is one of the tokens, and returns the
+// token consumed or "" if none.
+func (p *gettextParser) consume(tokens ...string) string {
+	next := p.next()
+	for _, tok := range tokens {
+		if next == tok {
+			p.s = p.s[len(tok):]
+			return tok
+		}
+	}
+	return ""
+}
+
+func (p *gettextParser) parseTernary() (gettextExpr, error) {
+	cond, err := p.parseBinary(0)
+	if err != nil || p.consume("?") == "" {
+		return cond, err
+	}
+
+	then, err := p.parseTernary()
+	if err != nil {
+		return nil, err
+	}
+	if p.consume(":") == "" {
+		return nil, fmt.Errorf("expect %q but got %q", ":", p.next())
+	}
+	els, err := p.parseTernary()
+	if err != nil {
+		return nil, err
+	}
+	return func(n int64) int64 {
+		if cond(n) != 0 {
+			return then(n)
+		}
+		return els(n)
+	}, nil
+}
+
+// gettextPrecedence is binary operators by precedence from low to high.
+var gettextPrecedence = [][]string{
+	{"||"},
+	{"&&"},
+	{"==", "!="},
+	{"<=", ">=", "<", ">"},
+	{"+", "-"},
+	{"*", "/", "%"},
+}
+
+func (p *gettextParser) parseBinary(level int) (gettextExpr, error) {
+	if level == len(gettextPrecedence) {
+		return p.parseUnary()
+	}
+
+	x, err := p.parseBinary(level + 1)
+	if err != nil {
+		return nil, err
+	}
+	for {
+		op := p.consume(gettextPrecedence[level]...)
+		if op == "" {
+			return x, nil
+		}
+		y, err := p.parseBinary(level + 1)
+		if err != nil {
+			return nil, err
+		}
+		x = gettextBinary(op, x, y)
+	}
+}
+
+func gettextBinary(op string, x, y gettextExpr) gettextExpr {
+	bool2int := func(b bool) int64 {
+		if b {
+			return 1
+		}
+		return 0
+	}
+	return func(n int64) int64 {
+		switch op {
+		case "||":
+			return bool2int(x(n) != 0 || y(n) != 0)
+		case "&&":
+			return bool2int(x(n) != 0 && y(n) != 0)
+		case "==":
+			return bool2int(x(n) == y(n))
+		case "!=":
+			return bool2int(x(n) != y(n))
+		case "<=":
+			return bool2int(x(n) <= y(n))
+		case ">=":
+			return bool2int(x(n) >= y(n))
+		case "<":
+			return bool2int(x(n) < y(n))
+		case ">":
+			return bool2int(x(n) > y(n))
+		case "+":
+			return x(n) + y(n)
+		case "-":
+			return x(n) - y(n)
+		case "*":
+			return x(n) * y(n)
+		}
+
+		// Division by zero is evaluated as 0 rather than panicking.
+		d := y(n)
+		if d == 0 {
+			return 0
+		} else if op == "/" {
+			return x(n) / d
+		}
+		return x(n) % d
+	}
+}
+
+func (p *gettextParser) parseUnary() (gettextExpr, error) {
+	if p.consume("!") != "" {
+		x, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return func(n int64) int64 {
+			if x(n) == 0 {
+				return 1
+			}
+			return 0
+		}, nil
+	}
+
+	if p.consume("(") != "" {
+		x, err := p.parseTernary()
+		if err != nil {
+			return nil, err
+		}
+		if p.consume(")") == "" {
+			return nil, fmt.Errorf("expect %q but got %q", ")", p.next())
+		}
+		return x, nil
+	}
+
+	tok := p.next()
+	switch {
+	case tok == "n":
+		p.s = p.s[1:]
+		return func(n int64) int64 { return n }, nil
+	case tok != "" && tok[0] >= '0' && tok[0] <= '9':
+		p.s = p.s[len(tok):]
+		v, err := strconv.ParseInt(tok, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		return func(int64) int64 { return v }, nil
+	}
+	return nil, fmt.Errorf("unexpected %q", tok)
+}
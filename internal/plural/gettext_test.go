@@ -0,0 +1,102 @@
+// Copyright 2026 Joe Chen. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package plural
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+)
+
+func TestParseGettextPluralForms(t *testing.T) {
+	tests := []struct {
+		header   string
+		nplurals int
+		want     map[int64]int
+	}{
+		{
+			header:   "nplurals=1; plural=0;",
+			nplurals: 1,
+			want:     map[int64]int{0: 0, 1: 0, 2: 0},
+		},
+		{
+			header:   "nplurals=2; plural=(n != 1);",
+			nplurals: 2,
+			want:     map[int64]int{0: 1, 1: 0, 2: 1},
+		},
+		{
+			header:   "nplurals=3; plural=(n%10==1 && n%100!=11 ? 0 : n%10>=2 && n%10<=4 && (n%100<10 || n%100>=20) ? 1 : 2);",
+			nplurals: 3,
+			want:     map[int64]int{1: 0, 2: 1, 5: 2, 11: 2, 21: 0, 22: 1, 112: 2},
+		},
+		{
+			header:   "nplurals=2; plural=!(n == 1) * 5;",
+			nplurals: 2,
+			want:     map[int64]int{1: 0, 2: 1}, // Out of range falls back to the last form
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.header, func(t *testing.T) {
+			nplurals, plural, err := ParseGettextPluralForms(test.header)
+			assert.Nil(t, err)
+			assert.Equal(t, test.nplurals, nplurals)
+			for n, want := range test.want {
+				assert.Equal(t, want, plural(n), "n = %d", n)
+			}
+		})
+	}
+
+	t.Run("invalid", func(t *testing.T) {
+		for header, want := range map[string]string{
+			"plural=(n != 1);":               "missing nplurals",
+			"nplurals=2;":                    "missing plural",
+			"nplurals=two; plural=(n != 1);": `invalid nplurals "two"`,
+			"nplurals=2; plural=(n != 1;":    `parse plural "(n != 1": expect ")" but got ""`,
+			"nplurals=2; plural=n ? 1;":      `parse plural "n ? 1": expect ":" but got ""`,
+			"nplurals=2; plural=x;":          `parse plural "x": unexpected "x"`,
+		} {
+			_, _, err := ParseGettextPluralForms(header)
+			assert.EqualError(t, err, want, header)
+		}
+	})
+}
+
+// TestGettextPluralForms verifies the generated "Plural-Forms" headers have the
+// same plural forms as the CLDR rules for integers.
+func TestGettextPluralForms(t *testing.T) {
+	for tag, rule := range DefaultRules() {
+		var forms []Form
+		for _, form := range []Form{Zero, One, Two, Few, Many, Other} {
+			if _, ok := rule.PluralForms[form]; ok {
+				forms = append(forms, form)
+			}
+		}
+
+		nplurals, plural, err := ParseGettextPluralForms(rule.GettextPluralForms)
+		if !assert.Nil(t, err, tag.String()) {
+			continue
+		}
+		assert.Equal(t, len(forms), nplurals, tag.String())
+
+		for _, n := range append(rangeInts(0, 1000), 1000000, 1100000, 2000000, 10000000) {
+			ops, _ := NewOperands(n)
+			if !assert.Equal(t, rule.PluralFormFunc(ops), forms[plural(n)], "%s: n = %d", tag, n) {
+				break
+			}
+		}
+	}
+
+	rule := DefaultRules().Rule(language.English)
+	assert.Equal(t, "nplurals=2; plural=(n==1 ? 0 : 1);", rule.GettextPluralForms)
+}
+
+func rangeInts(from, to int64) []int64 {
+	ints := make([]int64, 0, to-from)
+	for i := from; i < to; i++ {
+		ints = append(ints, i)
+	}
+	return ints
+}
@@ -7,7 +7,6 @@ package plural
 
 import (
 	"fmt"
-	"math"
 	"strconv"
 	"strings"
 )
@@ -99,22 +98,23 @@ func newOperandsString(s string) (*Operands, error) {
 	var n float64
 	var c int64
 	if parts := strings.Split(s, "c"); len(parts) == 2 {
-		n, err = strconv.ParseFloat(parts[0], 64)
-		if err != nil {
+		if _, err = strconv.ParseFloat(parts[0], 64); err != nil {
 			return nil, err
 		}
 		c, err = strconv.ParseInt(parts[1], 10, 64)
 		if err != nil {
 			return nil, err
+		} else if c < 0 {
+			return nil, fmt.Errorf("negative compact exponent %d", c)
 		}
 
-		n *= math.Pow10(int(c))
-		s = fmt.Sprintf("%f", n)
-	} else {
-		n, err = strconv.ParseFloat(s, 64)
-		if err != nil {
-			return nil, err
-		}
+		// NOTE: Shifting the decimal point in the string keeps the visible fraction
+		//  digits exact, e.g. "1.2c6" is "1200000" but not "1200000.000000".
+		s = shiftDecimalPoint(parts[0], int(c))
+	}
+	n, err = strconv.ParseFloat(s, 64)
+	if err != nil {
+		return nil, err
 	}
 	ops := &Operands{
 		N: n,
@@ -154,3 +154,29 @@ func newOperandsString(s string) (*Operands, error) {
 	}
 	return ops, nil
 }
+
+// shiftDecimalPoint moves the decimal point of the number string to the right
+// by the given number of places, e.g. "1.23" becomes "123" for 2 places.
+func shiftDecimalPoint(s string, places int) string {
+	integer, fraction := s, ""
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		integer, fraction = s[:i], s[i+1:]
+	}
+
+	if places >= len(fraction) {
+		integer += fraction + strings.Repeat("0", places-len(fraction))
+		fraction = ""
+	} else {
+		integer += fraction[:places]
+		fraction = fraction[places:]
+	}
+
+	integer = strings.TrimLeft(integer, "0")
+	if integer == "" {
+		integer = "0"
+	}
+	if fraction == "" {
+		return integer
+	}
+	return integer + "." + fraction
+}
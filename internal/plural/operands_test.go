@@ -28,6 +28,11 @@ func TestNewOperands(t *testing.T) {
 		{"1.03", &Operands{1.03, 1, 2, 2, 3, 3, 0, 0}, false},
 		{"1.230", &Operands{1.23, 1, 3, 2, 230, 23, 0, 0}, false},
 		{"20.0230", &Operands{20.023, 20, 4, 3, 230, 23, 0, 0}, false},
+		{"1c3", &Operands{1000.0, 1000, 0, 0, 0, 0, 3, 3}, false},
+		{"1.2c6", &Operands{1200000.0, 1200000, 0, 0, 0, 0, 6, 6}, false},
+		{"1.23c1", &Operands{12.3, 12, 1, 1, 3, 3, 1, 1}, false},
+		{"0.5c3", &Operands{500.0, 500, 0, 0, 0, 0, 3, 3}, false},
+		{"1c-3", nil, true},
 		{20.0230, nil, true},
 	}
 	for _, test := range tests {
@@ -13,6 +13,10 @@ import (
 type Rule struct {
 	PluralForms    map[Form]struct{}
 	PluralFormFunc func(*Operands) Form
+	// The "Plural-Forms" header of gettext for integers, where plural forms are
+	// indexed in the order of zero, one, two, few, many and other, e.g.
+	// "nplurals=2; plural=(n==1 ? 0 : 1);".
+	GettextPluralForms string
 }
 
 func addPluralRules(rules Rules, ids []string, ps *Rule) {
@@ -12,13 +12,15 @@ func DefaultRules() Rules {
 	rules := Rules{}
 
 	addPluralRules(rules, []string{"bm", "bo", "dz", "id", "ig", "ii", "in", "ja", "jbo", "jv", "jw", "kde", "kea", "km", "ko", "lkt", "lo", "ms", "my", "nqo", "osa", "root", "sah", "ses", "sg", "su", "th", "to", "vi", "wo", "yo", "yue", "zh"}, &Rule{
-		PluralForms: newPluralFormSet(Other),
+		PluralForms:        newPluralFormSet(Other),
+		GettextPluralForms: "nplurals=1; plural=0;",
 		PluralFormFunc: func(ops *Operands) Form {
 			return Other
 		},
 	})
 	addPluralRules(rules, []string{"am", "as", "bn", "doi", "fa", "gu", "hi", "kn", "pcm", "zu"}, &Rule{
-		PluralForms: newPluralFormSet(One, Other),
+		PluralForms:        newPluralFormSet(One, Other),
+		GettextPluralForms: "nplurals=2; plural=((n==0 || n==1) ? 0 : 1);",
 		PluralFormFunc: func(ops *Operands) Form {
 			// i = 0 or n = 1
 			if intEqualsAny(ops.I, 0) ||
@@ -29,7 +31,8 @@ func DefaultRules() Rules {
 		},
 	})
 	addPluralRules(rules, []string{"ff", "hy", "kab"}, &Rule{
-		PluralForms: newPluralFormSet(One, Other),
+		PluralForms:        newPluralFormSet(One, Other),
+		GettextPluralForms: "nplurals=2; plural=((n==0 || n==1) ? 0 : 1);",
 		PluralFormFunc: func(ops *Operands) Form {
 			// i = 0,1
 			if intEqualsAny(ops.I, 0, 1) {
@@ -39,7 +42,8 @@ func DefaultRules() Rules {
 		},
 	})
 	addPluralRules(rules, []string{"pt"}, &Rule{
-		PluralForms: newPluralFormSet(One, Other),
+		PluralForms:        newPluralFormSet(One, Other),
+		GettextPluralForms: "nplurals=2; plural=((n>=0 && n<=1) ? 0 : 1);",
 		PluralFormFunc: func(ops *Operands) Form {
 			// i = 0..1
 			if intInRange(ops.I, 0, 1) {
@@ -49,7 +53,8 @@ func DefaultRules() Rules {
 		},
 	})
 	addPluralRules(rules, []string{"ast", "ca", "de", "en", "et", "fi", "fy", "gl", "ia", "io", "it", "ji", "lij", "nl", "pt_PT", "sc", "scn", "sv", "sw", "ur", "yi"}, &Rule{
-		PluralForms: newPluralFormSet(One, Other),
+		PluralForms:        newPluralFormSet(One, Other),
+		GettextPluralForms: "nplurals=2; plural=(n==1 ? 0 : 1);",
 		PluralFormFunc: func(ops *Operands) Form {
 			// i = 1 and v = 0
 			if intEqualsAny(ops.I, 1) && intEqualsAny(ops.V, 0) {
@@ -59,7 +64,8 @@ func DefaultRules() Rules {
 		},
 	})
 	addPluralRules(rules, []string{"si"}, &Rule{
-		PluralForms: newPluralFormSet(One, Other),
+		PluralForms:        newPluralFormSet(One, Other),
+		GettextPluralForms: "nplurals=2; plural=((n==0 || n==1) ? 0 : 1);",
 		PluralFormFunc: func(ops *Operands) Form {
 			// n = 0,1 or i = 0 and f = 1
 			if ops.NEqualsAny(0, 1) ||
@@ -70,7 +76,8 @@ func DefaultRules() Rules {
 		},
 	})
 	addPluralRules(rules, []string{"ak", "bho", "guw", "ln", "mg", "nso", "pa", "ti", "wa"}, &Rule{
-		PluralForms: newPluralFormSet(One, Other),
+		PluralForms:        newPluralFormSet(One, Other),
+		GettextPluralForms: "nplurals=2; plural=((n>=0 && n<=1) ? 0 : 1);",
 		PluralFormFunc: func(ops *Operands) Form {
 			// n = 0..1
 			if ops.NInRange(0, 1) {
@@ -80,7 +87,8 @@ func DefaultRules() Rules {
 		},
 	})
 	addPluralRules(rules, []string{"tzm"}, &Rule{
-		PluralForms: newPluralFormSet(One, Other),
+		PluralForms:        newPluralFormSet(One, Other),
+		GettextPluralForms: "nplurals=2; plural=(((n>=0 && n<=1) || (n>=11 && n<=99)) ? 0 : 1);",
 		PluralFormFunc: func(ops *Operands) Form {
 			// n = 0..1 or n = 11..99
 			if ops.NInRange(0, 1) ||
@@ -91,7 +99,8 @@ func DefaultRules() Rules {
 		},
 	})
 	addPluralRules(rules, []string{"af", "an", "asa", "az", "bem", "bez", "bg", "brx", "ce", "cgg", "chr", "ckb", "dv", "ee", "el", "eo", "es", "eu", "fo", "fur", "gsw", "ha", "haw", "hu", "jgo", "jmc", "ka", "kaj", "kcg", "kk", "kkj", "kl", "ks", "ksb", "ku", "ky", "lb", "lg", "mas", "mgo", "ml", "mn", "mr", "nah", "nb", "nd", "ne", "nn", "nnh", "no", "nr", "ny", "nyn", "om", "or", "os", "pap", "ps", "rm", "rof", "rwk", "saq", "sd", "sdh", "seh", "sn", "so", "sq", "ss", "ssy", "st", "syr", "ta", "te", "teo", "tig", "tk", "tn", "tr", "ts", "ug", "uz", "ve", "vo", "vun", "wae", "xh", "xog"}, &Rule{
-		PluralForms: newPluralFormSet(One, Other),
+		PluralForms:        newPluralFormSet(One, Other),
+		GettextPluralForms: "nplurals=2; plural=(n==1 ? 0 : 1);",
 		PluralFormFunc: func(ops *Operands) Form {
 			// n = 1
 			if ops.NEqualsAny(1) {
@@ -101,7 +110,8 @@ func DefaultRules() Rules {
 		},
 	})
 	addPluralRules(rules, []string{"da"}, &Rule{
-		PluralForms: newPluralFormSet(One, Other),
+		PluralForms:        newPluralFormSet(One, Other),
+		GettextPluralForms: "nplurals=2; plural=(n==1 ? 0 : 1);",
 		PluralFormFunc: func(ops *Operands) Form {
 			// n = 1 or t != 0 and i = 0,1
 			if ops.NEqualsAny(1) ||
@@ -112,7 +122,8 @@ func DefaultRules() Rules {
 		},
 	})
 	addPluralRules(rules, []string{"is"}, &Rule{
-		PluralForms: newPluralFormSet(One, Other),
+		PluralForms:        newPluralFormSet(One, Other),
+		GettextPluralForms: "nplurals=2; plural=(n%10==1 && n%100!=11 ? 0 : 1);",
 		PluralFormFunc: func(ops *Operands) Form {
 			// t = 0 and i % 10 = 1 and i % 100 != 11 or t != 0
 			if intEqualsAny(ops.T, 0) && intEqualsAny(ops.I%10, 1) && !intEqualsAny(ops.I%100, 11) ||
@@ -123,7 +134,8 @@ func DefaultRules() Rules {
 		},
 	})
 	addPluralRules(rules, []string{"mk"}, &Rule{
-		PluralForms: newPluralFormSet(One, Other),
+		PluralForms:        newPluralFormSet(One, Other),
+		GettextPluralForms: "nplurals=2; plural=(n%10==1 && n%100!=11 ? 0 : 1);",
 		PluralFormFunc: func(ops *Operands) Form {
 			// v = 0 and i % 10 = 1 and i % 100 != 11 or f % 10 = 1 and f % 100 != 11
 			if intEqualsAny(ops.V, 0) && intEqualsAny(ops.I%10, 1) && !intEqualsAny(ops.I%100, 11) ||
@@ -134,7 +146,8 @@ func DefaultRules() Rules {
 		},
 	})
 	addPluralRules(rules, []string{"ceb", "fil", "tl"}, &Rule{
-		PluralForms: newPluralFormSet(One, Other),
+		PluralForms:        newPluralFormSet(One, Other),
+		GettextPluralForms: "nplurals=2; plural=(((n==1 || n==2 || n==3) || (n%10!=4 && n%10!=6 && n%10!=9)) ? 0 : 1);",
 		PluralFormFunc: func(ops *Operands) Form {
 			// v = 0 and i = 1,2,3 or v = 0 and i % 10 != 4,6,9 or v != 0 and f % 10 != 4,6,9
 			if intEqualsAny(ops.V, 0) && intEqualsAny(ops.I, 1, 2, 3) ||
@@ -146,7 +159,8 @@ func DefaultRules() Rules {
 		},
 	})
 	addPluralRules(rules, []string{"lv", "prg"}, &Rule{
-		PluralForms: newPluralFormSet(Zero, One, Other),
+		PluralForms:        newPluralFormSet(Zero, One, Other),
+		GettextPluralForms: "nplurals=3; plural=((n%10==0 || (n%100>=11 && n%100<=19)) ? 0 : n%10==1 && n%100!=11 ? 1 : 2);",
 		PluralFormFunc: func(ops *Operands) Form {
 			// n % 10 = 0 or n % 100 = 11..19 or v = 2 and f % 100 = 11..19
 			if ops.NModEqualsAny(10, 0) ||
@@ -164,7 +178,8 @@ func DefaultRules() Rules {
 		},
 	})
 	addPluralRules(rules, []string{"lag"}, &Rule{
-		PluralForms: newPluralFormSet(Zero, One, Other),
+		PluralForms:        newPluralFormSet(Zero, One, Other),
+		GettextPluralForms: "nplurals=3; plural=(n==0 ? 0 : (n==0 || n==1) && n!=0 ? 1 : 2);",
 		PluralFormFunc: func(ops *Operands) Form {
 			// n = 0
 			if ops.NEqualsAny(0) {
@@ -178,7 +193,8 @@ func DefaultRules() Rules {
 		},
 	})
 	addPluralRules(rules, []string{"ksh"}, &Rule{
-		PluralForms: newPluralFormSet(Zero, One, Other),
+		PluralForms:        newPluralFormSet(Zero, One, Other),
+		GettextPluralForms: "nplurals=3; plural=(n==0 ? 0 : n==1 ? 1 : 2);",
 		PluralFormFunc: func(ops *Operands) Form {
 			// n = 0
 			if ops.NEqualsAny(0) {
@@ -192,7 +208,8 @@ func DefaultRules() Rules {
 		},
 	})
 	addPluralRules(rules, []string{"iu", "naq", "sat", "se", "sma", "smi", "smj", "smn", "sms"}, &Rule{
-		PluralForms: newPluralFormSet(One, Two, Other),
+		PluralForms:        newPluralFormSet(One, Two, Other),
+		GettextPluralForms: "nplurals=3; plural=(n==1 ? 0 : n==2 ? 1 : 2);",
 		PluralFormFunc: func(ops *Operands) Form {
 			// n = 1
 			if ops.NEqualsAny(1) {
@@ -206,7 +223,8 @@ func DefaultRules() Rules {
 		},
 	})
 	addPluralRules(rules, []string{"shi"}, &Rule{
-		PluralForms: newPluralFormSet(One, Few, Other),
+		PluralForms:        newPluralFormSet(One, Few, Other),
+		GettextPluralForms: "nplurals=3; plural=((n==0 || n==1) ? 0 : (n>=2 && n<=10) ? 1 : 2);",
 		PluralFormFunc: func(ops *Operands) Form {
 			// i = 0 or n = 1
 			if intEqualsAny(ops.I, 0) ||
@@ -221,7 +239,8 @@ func DefaultRules() Rules {
 		},
 	})
 	addPluralRules(rules, []string{"mo", "ro"}, &Rule{
-		PluralForms: newPluralFormSet(One, Few, Other),
+		PluralForms:        newPluralFormSet(One, Few, Other),
+		GettextPluralForms: "nplurals=3; plural=(n==1 ? 0 : (n==0 || (n%100>=2 && n%100<=19)) ? 1 : 2);",
 		PluralFormFunc: func(ops *Operands) Form {
 			// i = 1 and v = 0
 			if intEqualsAny(ops.I, 1) && intEqualsAny(ops.V, 0) {
@@ -237,7 +256,8 @@ func DefaultRules() Rules {
 		},
 	})
 	addPluralRules(rules, []string{"bs", "hr", "sh", "sr"}, &Rule{
-		PluralForms: newPluralFormSet(One, Few, Other),
+		PluralForms:        newPluralFormSet(One, Few, Other),
+		GettextPluralForms: "nplurals=3; plural=(n%10==1 && n%100!=11 ? 0 : (n%10>=2 && n%10<=4) && (n%100<12 || n%100>14) ? 1 : 2);",
 		PluralFormFunc: func(ops *Operands) Form {
 			// v = 0 and i % 10 = 1 and i % 100 != 11 or f % 10 = 1 and f % 100 != 11
 			if intEqualsAny(ops.V, 0) && intEqualsAny(ops.I%10, 1) && !intEqualsAny(ops.I%100, 11) ||
@@ -253,7 +273,8 @@ func DefaultRules() Rules {
 		},
 	})
 	addPluralRules(rules, []string{"fr"}, &Rule{
-		PluralForms: newPluralFormSet(One, Many, Other),
+		PluralForms:        newPluralFormSet(One, Many, Other),
+		GettextPluralForms: "nplurals=3; plural=((n==0 || n==1) ? 0 : n!=0 && n%1000000==0 ? 1 : 2);",
 		PluralFormFunc: func(ops *Operands) Form {
 			// i = 0,1
 			if intEqualsAny(ops.I, 0, 1) {
@@ -268,7 +289,8 @@ func DefaultRules() Rules {
 		},
 	})
 	addPluralRules(rules, []string{"gd"}, &Rule{
-		PluralForms: newPluralFormSet(One, Two, Few, Other),
+		PluralForms:        newPluralFormSet(One, Two, Few, Other),
+		GettextPluralForms: "nplurals=4; plural=((n==1 || n==11) ? 0 : (n==2 || n==12) ? 1 : ((n>=3 && n<=10) || (n>=13 && n<=19)) ? 2 : 3);",
 		PluralFormFunc: func(ops *Operands) Form {
 			// n = 1,11
 			if ops.NEqualsAny(1, 11) {
@@ -286,7 +308,8 @@ func DefaultRules() Rules {
 		},
 	})
 	addPluralRules(rules, []string{"sl"}, &Rule{
-		PluralForms: newPluralFormSet(One, Two, Few, Other),
+		PluralForms:        newPluralFormSet(One, Two, Few, Other),
+		GettextPluralForms: "nplurals=4; plural=(n%100==1 ? 0 : n%100==2 ? 1 : (n%100>=3 && n%100<=4) ? 2 : 3);",
 		PluralFormFunc: func(ops *Operands) Form {
 			// v = 0 and i % 100 = 1
 			if intEqualsAny(ops.V, 0) && intEqualsAny(ops.I%100, 1) {
@@ -305,7 +328,8 @@ func DefaultRules() Rules {
 		},
 	})
 	addPluralRules(rules, []string{"dsb", "hsb"}, &Rule{
-		PluralForms: newPluralFormSet(One, Two, Few, Other),
+		PluralForms:        newPluralFormSet(One, Two, Few, Other),
+		GettextPluralForms: "nplurals=4; plural=(n%100==1 ? 0 : n%100==2 ? 1 : (n%100>=3 && n%100<=4) ? 2 : 3);",
 		PluralFormFunc: func(ops *Operands) Form {
 			// v = 0 and i % 100 = 1 or f % 100 = 1
 			if intEqualsAny(ops.V, 0) && intEqualsAny(ops.I%100, 1) ||
@@ -326,7 +350,8 @@ func DefaultRules() Rules {
 		},
 	})
 	addPluralRules(rules, []string{"he", "iw"}, &Rule{
-		PluralForms: newPluralFormSet(One, Two, Many, Other),
+		PluralForms:        newPluralFormSet(One, Two, Many, Other),
+		GettextPluralForms: "nplurals=4; plural=(n==1 ? 0 : n==2 ? 1 : (n<0 || n>10) && n%10==0 ? 2 : 3);",
 		PluralFormFunc: func(ops *Operands) Form {
 			// i = 1 and v = 0
 			if intEqualsAny(ops.I, 1) && intEqualsAny(ops.V, 0) {
@@ -344,7 +369,8 @@ func DefaultRules() Rules {
 		},
 	})
 	addPluralRules(rules, []string{"cs", "sk"}, &Rule{
-		PluralForms: newPluralFormSet(One, Few, Many, Other),
+		PluralForms:        newPluralFormSet(One, Few, Many, Other),
+		GettextPluralForms: "nplurals=4; plural=(n==1 ? 0 : (n>=2 && n<=4) ? 1 : 3);",
 		PluralFormFunc: func(ops *Operands) Form {
 			// i = 1 and v = 0
 			if intEqualsAny(ops.I, 1) && intEqualsAny(ops.V, 0) {
@@ -362,7 +388,8 @@ func DefaultRules() Rules {
 		},
 	})
 	addPluralRules(rules, []string{"pl"}, &Rule{
-		PluralForms: newPluralFormSet(One, Few, Many, Other),
+		PluralForms:        newPluralFormSet(One, Few, Many, Other),
+		GettextPluralForms: "nplurals=4; plural=(n==1 ? 0 : (n%10>=2 && n%10<=4) && (n%100<12 || n%100>14) ? 1 : ((n!=1 && (n%10>=0 && n%10<=1)) || (n%10>=5 && n%10<=9) || (n%100>=12 && n%100<=14)) ? 2 : 3);",
 		PluralFormFunc: func(ops *Operands) Form {
 			// i = 1 and v = 0
 			if intEqualsAny(ops.I, 1) && intEqualsAny(ops.V, 0) {
@@ -382,7 +409,8 @@ func DefaultRules() Rules {
 		},
 	})
 	addPluralRules(rules, []string{"be"}, &Rule{
-		PluralForms: newPluralFormSet(One, Few, Many, Other),
+		PluralForms:        newPluralFormSet(One, Few, Many, Other),
+		GettextPluralForms: "nplurals=4; plural=(n%10==1 && n%100!=11 ? 0 : (n%10>=2 && n%10<=4) && (n%100<12 || n%100>14) ? 1 : (n%10==0 || (n%10>=5 && n%10<=9) || (n%100>=11 && n%100<=14)) ? 2 : 3);",
 		PluralFormFunc: func(ops *Operands) Form {
 			// n % 10 = 1 and n % 100 != 11
 			if ops.NModEqualsAny(10, 1) && !ops.NModEqualsAny(100, 11) {
@@ -402,7 +430,8 @@ func DefaultRules() Rules {
 		},
 	})
 	addPluralRules(rules, []string{"lt"}, &Rule{
-		PluralForms: newPluralFormSet(One, Few, Many, Other),
+		PluralForms:        newPluralFormSet(One, Few, Many, Other),
+		GettextPluralForms: "nplurals=4; plural=(n%10==1 && (n%100<11 || n%100>19) ? 0 : (n%10>=2 && n%10<=9) && (n%100<11 || n%100>19) ? 1 : 3);",
 		PluralFormFunc: func(ops *Operands) Form {
 			// n % 10 = 1 and n % 100 != 11..19
 			if ops.NModEqualsAny(10, 1) && !ops.NModInRange(100, 11, 19) {
@@ -420,7 +449,8 @@ func DefaultRules() Rules {
 		},
 	})
 	addPluralRules(rules, []string{"mt"}, &Rule{
-		PluralForms: newPluralFormSet(One, Few, Many, Other),
+		PluralForms:        newPluralFormSet(One, Few, Many, Other),
+		GettextPluralForms: "nplurals=4; plural=(n==1 ? 0 : (n==0 || (n%100>=2 && n%100<=10)) ? 1 : (n%100>=11 && n%100<=19) ? 2 : 3);",
 		PluralFormFunc: func(ops *Operands) Form {
 			// n = 1
 			if ops.NEqualsAny(1) {
@@ -439,7 +469,8 @@ func DefaultRules() Rules {
 		},
 	})
 	addPluralRules(rules, []string{"ru", "uk"}, &Rule{
-		PluralForms: newPluralFormSet(One, Few, Many, Other),
+		PluralForms:        newPluralFormSet(One, Few, Many, Other),
+		GettextPluralForms: "nplurals=4; plural=(n%10==1 && n%100!=11 ? 0 : (n%10>=2 && n%10<=4) && (n%100<12 || n%100>14) ? 1 : (n%10==0 || (n%10>=5 && n%10<=9) || (n%100>=11 && n%100<=14)) ? 2 : 3);",
 		PluralFormFunc: func(ops *Operands) Form {
 			// v = 0 and i % 10 = 1 and i % 100 != 11
 			if intEqualsAny(ops.V, 0) && intEqualsAny(ops.I%10, 1) && !intEqualsAny(ops.I%100, 11) {
@@ -459,7 +490,8 @@ func DefaultRules() Rules {
 		},
 	})
 	addPluralRules(rules, []string{"br"}, &Rule{
-		PluralForms: newPluralFormSet(One, Two, Few, Many, Other),
+		PluralForms:        newPluralFormSet(One, Two, Few, Many, Other),
+		GettextPluralForms: "nplurals=5; plural=(n%10==1 && n%100!=11 && n%100!=71 && n%100!=91 ? 0 : n%10==2 && n%100!=12 && n%100!=72 && n%100!=92 ? 1 : ((n%10>=3 && n%10<=4) || n%10==9) && (n%100<10 || n%100>19) && (n%100<70 || n%100>79) && (n%100<90 || n%100>99) ? 2 : n!=0 && n%1000000==0 ? 3 : 4);",
 		PluralFormFunc: func(ops *Operands) Form {
 			// n % 10 = 1 and n % 100 != 11,71,91
 			if ops.NModEqualsAny(10, 1) && !ops.NModEqualsAny(100, 11, 71, 91) {
@@ -481,7 +513,8 @@ func DefaultRules() Rules {
 		},
 	})
 	addPluralRules(rules, []string{"ga"}, &Rule{
-		PluralForms: newPluralFormSet(One, Two, Few, Many, Other),
+		PluralForms:        newPluralFormSet(One, Two, Few, Many, Other),
+		GettextPluralForms: "nplurals=5; plural=(n==1 ? 0 : n==2 ? 1 : (n>=3 && n<=6) ? 2 : (n>=7 && n<=10) ? 3 : 4);",
 		PluralFormFunc: func(ops *Operands) Form {
 			// n = 1
 			if ops.NEqualsAny(1) {
@@ -503,7 +536,8 @@ func DefaultRules() Rules {
 		},
 	})
 	addPluralRules(rules, []string{"gv"}, &Rule{
-		PluralForms: newPluralFormSet(One, Two, Few, Many, Other),
+		PluralForms:        newPluralFormSet(One, Two, Few, Many, Other),
+		GettextPluralForms: "nplurals=5; plural=(n%10==1 ? 0 : n%10==2 ? 1 : (n%100==0 || n%100==20 || n%100==40 || n%100==60 || n%100==80) ? 2 : 4);",
 		PluralFormFunc: func(ops *Operands) Form {
 			// v = 0 and i % 10 = 1
 			if intEqualsAny(ops.V, 0) && intEqualsAny(ops.I%10, 1) {
@@ -525,7 +559,8 @@ func DefaultRules() Rules {
 		},
 	})
 	addPluralRules(rules, []string{"kw"}, &Rule{
-		PluralForms: newPluralFormSet(Zero, One, Two, Few, Many, Other),
+		PluralForms:        newPluralFormSet(Zero, One, Two, Few, Many, Other),
+		GettextPluralForms: "nplurals=6; plural=(n==0 ? 0 : n==1 ? 1 : ((n%100==2 || n%100==22 || n%100==42 || n%100==62 || n%100==82) || (n%1000==0 && ((n%100000>=1000 && n%100000<=20000) || n%100000==40000 || n%100000==60000 || n%100000==80000)) || (n!=0 && n%1000000==100000)) ? 2 : (n%100==3 || n%100==23 || n%100==43 || n%100==63 || n%100==83) ? 3 : n!=1 && (n%100==1 || n%100==21 || n%100==41 || n%100==61 || n%100==81) ? 4 : 5);",
 		PluralFormFunc: func(ops *Operands) Form {
 			// n = 0
 			if ops.NEqualsAny(0) {
@@ -553,7 +588,8 @@ func DefaultRules() Rules {
 		},
 	})
 	addPluralRules(rules, []string{"ar", "ars"}, &Rule{
-		PluralForms: newPluralFormSet(Zero, One, Two, Few, Many, Other),
+		PluralForms:        newPluralFormSet(Zero, One, Two, Few, Many, Other),
+		GettextPluralForms: "nplurals=6; plural=(n==0 ? 0 : n==1 ? 1 : n==2 ? 2 : (n%100>=3 && n%100<=10) ? 3 : (n%100>=11 && n%100<=99) ? 4 : 5);",
 		PluralFormFunc: func(ops *Operands) Form {
 			// n = 0
 			if ops.NEqualsAny(0) {
@@ -579,7 +615,8 @@ func DefaultRules() Rules {
 		},
 	})
 	addPluralRules(rules, []string{"cy"}, &Rule{
-		PluralForms: newPluralFormSet(Zero, One, Two, Few, Many, Other),
+		PluralForms:        newPluralFormSet(Zero, One, Two, Few, Many, Other),
+		GettextPluralForms: "nplurals=6; plural=(n==0 ? 0 : n==1 ? 1 : n==2 ? 2 : n==3 ? 3 : n==6 ? 4 : 5);",
 		PluralFormFunc: func(ops *Operands) Form {
 			// n = 0
 			if ops.NEqualsAny(0) {
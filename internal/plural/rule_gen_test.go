@@ -15,9 +15,11 @@ func TestBmBoDzIdIgIiInJaJboJvJwKdeKeaKmKoLktLoMsMyNqoOsaRootSahSesSgSuThToViWoY
 	tests = appendIntegerTests(tests, Other, []string{"0~15", "100", "1000", "10000", "100000", "1000000"})
 	tests = appendDecimalTests(tests, Other, []string{"0.0~1.5", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"})
 
+	forms := []Form{Other}
 	locales := []string{"bm", "bo", "dz", "id", "ig", "ii", "in", "ja", "jbo", "jv", "jw", "kde", "kea", "km", "ko", "lkt", "lo", "ms", "my", "nqo", "osa", "root", "sah", "ses", "sg", "su", "th", "to", "vi", "wo", "yo", "yue", "zh"}
 	for _, locale := range locales {
 		runTests(t, locale, tests)
+		runGettextTests(t, locale, "nplurals=1; plural=0;", forms)
 	}
 }
 
@@ -30,9 +32,11 @@ func TestAmAsBnDoiFaGuHiKnPcmZu(t *testing.T) {
 	tests = appendIntegerTests(tests, Other, []string{"2~17", "100", "1000", "10000", "100000", "1000000"})
 	tests = appendDecimalTests(tests, Other, []string{"1.1~2.6", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"})
 
+	forms := []Form{One, Other}
 	locales := []string{"am", "as", "bn", "doi", "fa", "gu", "hi", "kn", "pcm", "zu"}
 	for _, locale := range locales {
 		runTests(t, locale, tests)
+		runGettextTests(t, locale, "nplurals=2; plural=((n==0 || n==1) ? 0 : 1);", forms)
 	}
 }
 
@@ -45,9 +49,11 @@ func TestFfHyKab(t *testing.T) {
 	tests = appendIntegerTests(tests, Other, []string{"2~17", "100", "1000", "10000", "100000", "1000000"})
 	tests = appendDecimalTests(tests, Other, []string{"2.0~3.5", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"})
 
+	forms := []Form{One, Other}
 	locales := []string{"ff", "hy", "kab"}
 	for _, locale := range locales {
 		runTests(t, locale, tests)
+		runGettextTests(t, locale, "nplurals=2; plural=((n==0 || n==1) ? 0 : 1);", forms)
 	}
 }
 
@@ -60,9 +66,11 @@ func TestPt(t *testing.T) {
 	tests = appendIntegerTests(tests, Other, []string{"2~17", "100", "1000", "10000", "100000", "1000000"})
 	tests = appendDecimalTests(tests, Other, []string{"2.0~3.5", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"})
 
+	forms := []Form{One, Other}
 	locales := []string{"pt"}
 	for _, locale := range locales {
 		runTests(t, locale, tests)
+		runGettextTests(t, locale, "nplurals=2; plural=((n>=0 && n<=1) ? 0 : 1);", forms)
 	}
 }
 
@@ -74,9 +82,11 @@ func TestAstCaDeEnEtFiFyGlIaIoItJiLijNlPt_PTScScnSvSwUrYi(t *testing.T) {
 	tests = appendIntegerTests(tests, Other, []string{"0", "2~16", "100", "1000", "10000", "100000", "1000000"})
 	tests = appendDecimalTests(tests, Other, []string{"0.0~1.5", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"})
 
+	forms := []Form{One, Other}
 	locales := []string{"ast", "ca", "de", "en", "et", "fi", "fy", "gl", "ia", "io", "it", "ji", "lij", "nl", "pt_PT", "sc", "scn", "sv", "sw", "ur", "yi"}
 	for _, locale := range locales {
 		runTests(t, locale, tests)
+		runGettextTests(t, locale, "nplurals=2; plural=(n==1 ? 0 : 1);", forms)
 	}
 }
 
@@ -89,9 +99,11 @@ func TestSi(t *testing.T) {
 	tests = appendIntegerTests(tests, Other, []string{"2~17", "100", "1000", "10000", "100000", "1000000"})
 	tests = appendDecimalTests(tests, Other, []string{"0.2~0.9", "1.1~1.8", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"})
 
+	forms := []Form{One, Other}
 	locales := []string{"si"}
 	for _, locale := range locales {
 		runTests(t, locale, tests)
+		runGettextTests(t, locale, "nplurals=2; plural=((n==0 || n==1) ? 0 : 1);", forms)
 	}
 }
 
@@ -104,9 +116,11 @@ func TestAkBhoGuwLnMgNsoPaTiWa(t *testing.T) {
 	tests = appendIntegerTests(tests, Other, []string{"2~17", "100", "1000", "10000", "100000", "1000000"})
 	tests = appendDecimalTests(tests, Other, []string{"0.1~0.9", "1.1~1.7", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"})
 
+	forms := []Form{One, Other}
 	locales := []string{"ak", "bho", "guw", "ln", "mg", "nso", "pa", "ti", "wa"}
 	for _, locale := range locales {
 		runTests(t, locale, tests)
+		runGettextTests(t, locale, "nplurals=2; plural=((n>=0 && n<=1) ? 0 : 1);", forms)
 	}
 }
 
@@ -119,9 +133,11 @@ func TestTzm(t *testing.T) {
 	tests = appendIntegerTests(tests, Other, []string{"2~10", "100~106", "1000", "10000", "100000", "1000000"})
 	tests = appendDecimalTests(tests, Other, []string{"0.1~0.9", "1.1~1.7", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"})
 
+	forms := []Form{One, Other}
 	locales := []string{"tzm"}
 	for _, locale := range locales {
 		runTests(t, locale, tests)
+		runGettextTests(t, locale, "nplurals=2; plural=(((n>=0 && n<=1) || (n>=11 && n<=99)) ? 0 : 1);", forms)
 	}
 }
 
@@ -134,9 +150,11 @@ func TestAfAnAsaAzBemBezBgBrxCeCggChrCkbDvEeElEoEsEuFoFurGswHaHawHuJgoJmcKaKajKc
 	tests = appendIntegerTests(tests, Other, []string{"0", "2~16", "100", "1000", "10000", "100000", "1000000"})
 	tests = appendDecimalTests(tests, Other, []string{"0.0~0.9", "1.1~1.6", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"})
 
+	forms := []Form{One, Other}
 	locales := []string{"af", "an", "asa", "az", "bem", "bez", "bg", "brx", "ce", "cgg", "chr", "ckb", "dv", "ee", "el", "eo", "es", "eu", "fo", "fur", "gsw", "ha", "haw", "hu", "jgo", "jmc", "ka", "kaj", "kcg", "kk", "kkj", "kl", "ks", "ksb", "ku", "ky", "lb", "lg", "mas", "mgo", "ml", "mn", "mr", "nah", "nb", "nd", "ne", "nn", "nnh", "no", "nr", "ny", "nyn", "om", "or", "os", "pap", "ps", "rm", "rof", "rwk", "saq", "sd", "sdh", "seh", "sn", "so", "sq", "ss", "ssy", "st", "syr", "ta", "te", "teo", "tig", "tk", "tn", "tr", "ts", "ug", "uz", "ve", "vo", "vun", "wae", "xh", "xog"}
 	for _, locale := range locales {
 		runTests(t, locale, tests)
+		runGettextTests(t, locale, "nplurals=2; plural=(n==1 ? 0 : 1);", forms)
 	}
 }
 
@@ -149,9 +167,11 @@ func TestDa(t *testing.T) {
 	tests = appendIntegerTests(tests, Other, []string{"0", "2~16", "100", "1000", "10000", "100000", "1000000"})
 	tests = appendDecimalTests(tests, Other, []string{"0.0", "2.0~3.4", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"})
 
+	forms := []Form{One, Other}
 	locales := []string{"da"}
 	for _, locale := range locales {
 		runTests(t, locale, tests)
+		runGettextTests(t, locale, "nplurals=2; plural=(n==1 ? 0 : 1);", forms)
 	}
 }
 
@@ -164,9 +184,11 @@ func TestIs(t *testing.T) {
 	tests = appendIntegerTests(tests, Other, []string{"0", "2~16", "100", "1000", "10000", "100000", "1000000"})
 	tests = appendDecimalTests(tests, Other, []string{"0.0", "2.0", "3.0", "4.0", "5.0", "6.0", "7.0", "8.0", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"})
 
+	forms := []Form{One, Other}
 	locales := []string{"is"}
 	for _, locale := range locales {
 		runTests(t, locale, tests)
+		runGettextTests(t, locale, "nplurals=2; plural=(n%10==1 && n%100!=11 ? 0 : 1);", forms)
 	}
 }
 
@@ -179,9 +201,11 @@ func TestMk(t *testing.T) {
 	tests = appendIntegerTests(tests, Other, []string{"0", "2~16", "100", "1000", "10000", "100000", "1000000"})
 	tests = appendDecimalTests(tests, Other, []string{"0.0", "0.2~1.0", "1.2~1.7", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"})
 
+	forms := []Form{One, Other}
 	locales := []string{"mk"}
 	for _, locale := range locales {
 		runTests(t, locale, tests)
+		runGettextTests(t, locale, "nplurals=2; plural=(n%10==1 && n%100!=11 ? 0 : 1);", forms)
 	}
 }
 
@@ -194,9 +218,11 @@ func TestCebFilTl(t *testing.T) {
 	tests = appendIntegerTests(tests, Other, []string{"4", "6", "9", "14", "16", "19", "24", "26", "104", "1004"})
 	tests = appendDecimalTests(tests, Other, []string{"0.4", "0.6", "0.9", "1.4", "1.6", "1.9", "2.4", "2.6", "10.4", "100.4", "1000.4"})
 
+	forms := []Form{One, Other}
 	locales := []string{"ceb", "fil", "tl"}
 	for _, locale := range locales {
 		runTests(t, locale, tests)
+		runGettextTests(t, locale, "nplurals=2; plural=(((n==1 || n==2 || n==3) || (n%10!=4 && n%10!=6 && n%10!=9)) ? 0 : 1);", forms)
 	}
 }
 
@@ -212,9 +238,11 @@ func TestLvPrg(t *testing.T) {
 	tests = appendIntegerTests(tests, Other, []string{"2~9", "22~29", "102", "1002"})
 	tests = appendDecimalTests(tests, Other, []string{"0.2~0.9", "1.2~1.9", "10.2", "100.2", "1000.2"})
 
+	forms := []Form{Zero, One, Other}
 	locales := []string{"lv", "prg"}
 	for _, locale := range locales {
 		runTests(t, locale, tests)
+		runGettextTests(t, locale, "nplurals=3; plural=((n%10==0 || (n%100>=11 && n%100<=19)) ? 0 : n%10==1 && n%100!=11 ? 1 : 2);", forms)
 	}
 }
 
@@ -230,9 +258,11 @@ func TestLag(t *testing.T) {
 	tests = appendIntegerTests(tests, Other, []string{"2~17", "100", "1000", "10000", "100000", "1000000"})
 	tests = appendDecimalTests(tests, Other, []string{"2.0~3.5", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"})
 
+	forms := []Form{Zero, One, Other}
 	locales := []string{"lag"}
 	for _, locale := range locales {
 		runTests(t, locale, tests)
+		runGettextTests(t, locale, "nplurals=3; plural=(n==0 ? 0 : (n==0 || n==1) && n!=0 ? 1 : 2);", forms)
 	}
 }
 
@@ -248,9 +278,11 @@ func TestKsh(t *testing.T) {
 	tests = appendIntegerTests(tests, Other, []string{"2~17", "100", "1000", "10000", "100000", "1000000"})
 	tests = appendDecimalTests(tests, Other, []string{"0.1~0.9", "1.1~1.7", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"})
 
+	forms := []Form{Zero, One, Other}
 	locales := []string{"ksh"}
 	for _, locale := range locales {
 		runTests(t, locale, tests)
+		runGettextTests(t, locale, "nplurals=3; plural=(n==0 ? 0 : n==1 ? 1 : 2);", forms)
 	}
 }
 
@@ -266,9 +298,11 @@ func TestIuNaqSatSeSmaSmiSmjSmnSms(t *testing.T) {
 	tests = appendIntegerTests(tests, Other, []string{"0", "3~17", "100", "1000", "10000", "100000", "1000000"})
 	tests = appendDecimalTests(tests, Other, []string{"0.0~0.9", "1.1~1.6", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"})
 
+	forms := []Form{One, Two, Other}
 	locales := []string{"iu", "naq", "sat", "se", "sma", "smi", "smj", "smn", "sms"}
 	for _, locale := range locales {
 		runTests(t, locale, tests)
+		runGettextTests(t, locale, "nplurals=3; plural=(n==1 ? 0 : n==2 ? 1 : 2);", forms)
 	}
 }
 
@@ -284,9 +318,11 @@ func TestShi(t *testing.T) {
 	tests = appendIntegerTests(tests, Other, []string{"11~26", "100", "1000", "10000", "100000", "1000000"})
 	tests = appendDecimalTests(tests, Other, []string{"1.1~1.9", "2.1~2.7", "10.1", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"})
 
+	forms := []Form{One, Few, Other}
 	locales := []string{"shi"}
 	for _, locale := range locales {
 		runTests(t, locale, tests)
+		runGettextTests(t, locale, "nplurals=3; plural=((n==0 || n==1) ? 0 : (n>=2 && n<=10) ? 1 : 2);", forms)
 	}
 }
 
@@ -300,9 +336,11 @@ func TestMoRo(t *testing.T) {
 
 	tests = appendIntegerTests(tests, Other, []string{"20~35", "100", "1000", "10000", "100000", "1000000"})
 
+	forms := []Form{One, Few, Other}
 	locales := []string{"mo", "ro"}
 	for _, locale := range locales {
 		runTests(t, locale, tests)
+		runGettextTests(t, locale, "nplurals=3; plural=(n==1 ? 0 : (n==0 || (n%100>=2 && n%100<=19)) ? 1 : 2);", forms)
 	}
 }
 
@@ -318,9 +356,11 @@ func TestBsHrShSr(t *testing.T) {
 	tests = appendIntegerTests(tests, Other, []string{"0", "5~19", "100", "1000", "10000", "100000", "1000000"})
 	tests = appendDecimalTests(tests, Other, []string{"0.0", "0.5~1.0", "1.5~2.0", "2.5~2.7", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"})
 
+	forms := []Form{One, Few, Other}
 	locales := []string{"bs", "hr", "sh", "sr"}
 	for _, locale := range locales {
 		runTests(t, locale, tests)
+		runGettextTests(t, locale, "nplurals=3; plural=(n%10==1 && n%100!=11 ? 0 : (n%10>=2 && n%10<=4) && (n%100<12 || n%100>14) ? 1 : 2);", forms)
 	}
 }
 
@@ -336,9 +376,11 @@ func TestFr(t *testing.T) {
 	tests = appendIntegerTests(tests, Other, []string{"2~17", "100", "1000", "10000", "100000", "1c3", "2c3", "3c3", "4c3", "5c3", "6c3"})
 	tests = appendDecimalTests(tests, Other, []string{"2.0~3.5", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0", "1.0001c3", "1.1c3", "2.0001c3", "2.1c3", "3.0001c3", "3.1c3"})
 
+	forms := []Form{One, Many, Other}
 	locales := []string{"fr"}
 	for _, locale := range locales {
 		runTests(t, locale, tests)
+		runGettextTests(t, locale, "nplurals=3; plural=((n==0 || n==1) ? 0 : n!=0 && n%1000000==0 ? 1 : 2);", forms)
 	}
 }
 
@@ -357,9 +399,11 @@ func TestGd(t *testing.T) {
 	tests = appendIntegerTests(tests, Other, []string{"0", "20~34", "100", "1000", "10000", "100000", "1000000"})
 	tests = appendDecimalTests(tests, Other, []string{"0.0~0.9", "1.1~1.6", "10.1", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"})
 
+	forms := []Form{One, Two, Few, Other}
 	locales := []string{"gd"}
 	for _, locale := range locales {
 		runTests(t, locale, tests)
+		runGettextTests(t, locale, "nplurals=4; plural=((n==1 || n==11) ? 0 : (n==2 || n==12) ? 1 : ((n>=3 && n<=10) || (n>=13 && n<=19)) ? 2 : 3);", forms)
 	}
 }
 
@@ -375,9 +419,11 @@ func TestSl(t *testing.T) {
 
 	tests = appendIntegerTests(tests, Other, []string{"0", "5~19", "100", "1000", "10000", "100000", "1000000"})
 
+	forms := []Form{One, Two, Few, Other}
 	locales := []string{"sl"}
 	for _, locale := range locales {
 		runTests(t, locale, tests)
+		runGettextTests(t, locale, "nplurals=4; plural=(n%100==1 ? 0 : n%100==2 ? 1 : (n%100>=3 && n%100<=4) ? 2 : 3);", forms)
 	}
 }
 
@@ -396,9 +442,11 @@ func TestDsbHsb(t *testing.T) {
 	tests = appendIntegerTests(tests, Other, []string{"0", "5~19", "100", "1000", "10000", "100000", "1000000"})
 	tests = appendDecimalTests(tests, Other, []string{"0.0", "0.5~1.0", "1.5~2.0", "2.5~2.7", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"})
 
+	forms := []Form{One, Two, Few, Other}
 	locales := []string{"dsb", "hsb"}
 	for _, locale := range locales {
 		runTests(t, locale, tests)
+		runGettextTests(t, locale, "nplurals=4; plural=(n%100==1 ? 0 : n%100==2 ? 1 : (n%100>=3 && n%100<=4) ? 2 : 3);", forms)
 	}
 }
 
@@ -414,9 +462,11 @@ func TestHeIw(t *testing.T) {
 	tests = appendIntegerTests(tests, Other, []string{"0", "3~17", "101", "1001"})
 	tests = appendDecimalTests(tests, Other, []string{"0.0~1.5", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"})
 
+	forms := []Form{One, Two, Many, Other}
 	locales := []string{"he", "iw"}
 	for _, locale := range locales {
 		runTests(t, locale, tests)
+		runGettextTests(t, locale, "nplurals=4; plural=(n==1 ? 0 : n==2 ? 1 : (n<0 || n>10) && n%10==0 ? 2 : 3);", forms)
 	}
 }
 
@@ -431,9 +481,11 @@ func TestCsSk(t *testing.T) {
 
 	tests = appendIntegerTests(tests, Other, []string{"0", "5~19", "100", "1000", "10000", "100000", "1000000"})
 
+	forms := []Form{One, Few, Many, Other}
 	locales := []string{"cs", "sk"}
 	for _, locale := range locales {
 		runTests(t, locale, tests)
+		runGettextTests(t, locale, "nplurals=4; plural=(n==1 ? 0 : (n>=2 && n<=4) ? 1 : 3);", forms)
 	}
 }
 
@@ -448,9 +500,11 @@ func TestPl(t *testing.T) {
 
 	tests = appendDecimalTests(tests, Other, []string{"0.0~1.5", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"})
 
+	forms := []Form{One, Few, Many, Other}
 	locales := []string{"pl"}
 	for _, locale := range locales {
 		runTests(t, locale, tests)
+		runGettextTests(t, locale, "nplurals=4; plural=(n==1 ? 0 : (n%10>=2 && n%10<=4) && (n%100<12 || n%100>14) ? 1 : ((n!=1 && (n%10>=0 && n%10<=1)) || (n%10>=5 && n%10<=9) || (n%100>=12 && n%100<=14)) ? 2 : 3);", forms)
 	}
 }
 
@@ -468,9 +522,11 @@ func TestBe(t *testing.T) {
 
 	tests = appendDecimalTests(tests, Other, []string{"0.1~0.9", "1.1~1.7", "10.1", "100.1", "1000.1"})
 
+	forms := []Form{One, Few, Many, Other}
 	locales := []string{"be"}
 	for _, locale := range locales {
 		runTests(t, locale, tests)
+		runGettextTests(t, locale, "nplurals=4; plural=(n%10==1 && n%100!=11 ? 0 : (n%10>=2 && n%10<=4) && (n%100<12 || n%100>14) ? 1 : (n%10==0 || (n%10>=5 && n%10<=9) || (n%100>=11 && n%100<=14)) ? 2 : 3);", forms)
 	}
 }
 
@@ -488,9 +544,11 @@ func TestLt(t *testing.T) {
 	tests = appendIntegerTests(tests, Other, []string{"0", "10~20", "30", "40", "50", "60", "100", "1000", "10000", "100000", "1000000"})
 	tests = appendDecimalTests(tests, Other, []string{"0.0", "10.0", "11.0", "12.0", "13.0", "14.0", "15.0", "16.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"})
 
+	forms := []Form{One, Few, Many, Other}
 	locales := []string{"lt"}
 	for _, locale := range locales {
 		runTests(t, locale, tests)
+		runGettextTests(t, locale, "nplurals=4; plural=(n%10==1 && (n%100<11 || n%100>19) ? 0 : (n%10>=2 && n%10<=9) && (n%100<11 || n%100>19) ? 1 : 3);", forms)
 	}
 }
 
@@ -509,9 +567,11 @@ func TestMt(t *testing.T) {
 	tests = appendIntegerTests(tests, Other, []string{"20~35", "100", "1000", "10000", "100000", "1000000"})
 	tests = appendDecimalTests(tests, Other, []string{"0.1~0.9", "1.1~1.7", "10.1", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"})
 
+	forms := []Form{One, Few, Many, Other}
 	locales := []string{"mt"}
 	for _, locale := range locales {
 		runTests(t, locale, tests)
+		runGettextTests(t, locale, "nplurals=4; plural=(n==1 ? 0 : (n==0 || (n%100>=2 && n%100<=10)) ? 1 : (n%100>=11 && n%100<=19) ? 2 : 3);", forms)
 	}
 }
 
@@ -526,9 +586,11 @@ func TestRuUk(t *testing.T) {
 
 	tests = appendDecimalTests(tests, Other, []string{"0.0~1.5", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"})
 
+	forms := []Form{One, Few, Many, Other}
 	locales := []string{"ru", "uk"}
 	for _, locale := range locales {
 		runTests(t, locale, tests)
+		runGettextTests(t, locale, "nplurals=4; plural=(n%10==1 && n%100!=11 ? 0 : (n%10>=2 && n%10<=4) && (n%100<12 || n%100>14) ? 1 : (n%10==0 || (n%10>=5 && n%10<=9) || (n%100>=11 && n%100<=14)) ? 2 : 3);", forms)
 	}
 }
 
@@ -550,9 +612,11 @@ func TestBr(t *testing.T) {
 	tests = appendIntegerTests(tests, Other, []string{"0", "5~8", "10~20", "100", "1000", "10000", "100000"})
 	tests = appendDecimalTests(tests, Other, []string{"0.0~0.9", "1.1~1.6", "10.0", "100.0", "1000.0", "10000.0", "100000.0"})
 
+	forms := []Form{One, Two, Few, Many, Other}
 	locales := []string{"br"}
 	for _, locale := range locales {
 		runTests(t, locale, tests)
+		runGettextTests(t, locale, "nplurals=5; plural=(n%10==1 && n%100!=11 && n%100!=71 && n%100!=91 ? 0 : n%10==2 && n%100!=12 && n%100!=72 && n%100!=92 ? 1 : ((n%10>=3 && n%10<=4) || n%10==9) && (n%100<10 || n%100>19) && (n%100<70 || n%100>79) && (n%100<90 || n%100>99) ? 2 : n!=0 && n%1000000==0 ? 3 : 4);", forms)
 	}
 }
 
@@ -574,9 +638,11 @@ func TestGa(t *testing.T) {
 	tests = appendIntegerTests(tests, Other, []string{"0", "11~25", "100", "1000", "10000", "100000", "1000000"})
 	tests = appendDecimalTests(tests, Other, []string{"0.0~0.9", "1.1~1.6", "10.1", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"})
 
+	forms := []Form{One, Two, Few, Many, Other}
 	locales := []string{"ga"}
 	for _, locale := range locales {
 		runTests(t, locale, tests)
+		runGettextTests(t, locale, "nplurals=5; plural=(n==1 ? 0 : n==2 ? 1 : (n>=3 && n<=6) ? 2 : (n>=7 && n<=10) ? 3 : 4);", forms)
 	}
 }
 
@@ -593,9 +659,11 @@ func TestGv(t *testing.T) {
 
 	tests = appendIntegerTests(tests, Other, []string{"3~10", "13~19", "23", "103", "1003"})
 
+	forms := []Form{One, Two, Few, Many, Other}
 	locales := []string{"gv"}
 	for _, locale := range locales {
 		runTests(t, locale, tests)
+		runGettextTests(t, locale, "nplurals=5; plural=(n%10==1 ? 0 : n%10==2 ? 1 : (n%100==0 || n%100==20 || n%100==40 || n%100==60 || n%100==80) ? 2 : 4);", forms)
 	}
 }
 
@@ -620,9 +688,11 @@ func TestKw(t *testing.T) {
 	tests = appendIntegerTests(tests, Other, []string{"4~19", "100", "1004", "1000000"})
 	tests = appendDecimalTests(tests, Other, []string{"0.1~0.9", "1.1~1.7", "10.0", "100.0", "1000.1", "1000000.0"})
 
+	forms := []Form{Zero, One, Two, Few, Many, Other}
 	locales := []string{"kw"}
 	for _, locale := range locales {
 		runTests(t, locale, tests)
+		runGettextTests(t, locale, "nplurals=6; plural=(n==0 ? 0 : n==1 ? 1 : ((n%100==2 || n%100==22 || n%100==42 || n%100==62 || n%100==82) || (n%1000==0 && ((n%100000>=1000 && n%100000<=20000) || n%100000==40000 || n%100000==60000 || n%100000==80000)) || (n!=0 && n%1000000==100000)) ? 2 : (n%100==3 || n%100==23 || n%100==43 || n%100==63 || n%100==83) ? 3 : n!=1 && (n%100==1 || n%100==21 || n%100==41 || n%100==61 || n%100==81) ? 4 : 5);", forms)
 	}
 }
 
@@ -647,9 +717,11 @@ func TestArArs(t *testing.T) {
 	tests = appendIntegerTests(tests, Other, []string{"100~102", "200~202", "300~302", "400~402", "500~502", "600", "1000", "10000", "100000", "1000000"})
 	tests = appendDecimalTests(tests, Other, []string{"0.1~0.9", "1.1~1.7", "10.1", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"})
 
+	forms := []Form{Zero, One, Two, Few, Many, Other}
 	locales := []string{"ar", "ars"}
 	for _, locale := range locales {
 		runTests(t, locale, tests)
+		runGettextTests(t, locale, "nplurals=6; plural=(n==0 ? 0 : n==1 ? 1 : n==2 ? 2 : (n%100>=3 && n%100<=10) ? 3 : (n%100>=11 && n%100<=99) ? 4 : 5);", forms)
 	}
 }
 
@@ -674,8 +746,10 @@ func TestCy(t *testing.T) {
 	tests = appendIntegerTests(tests, Other, []string{"4", "5", "7~20", "100", "1000", "10000", "100000", "1000000"})
 	tests = appendDecimalTests(tests, Other, []string{"0.1~0.9", "1.1~1.7", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"})
 
+	forms := []Form{Zero, One, Two, Few, Many, Other}
 	locales := []string{"cy"}
 	for _, locale := range locales {
 		runTests(t, locale, tests)
+		runGettextTests(t, locale, "nplurals=6; plural=(n==0 ? 0 : n==1 ? 1 : n==2 ? 2 : n==3 ? 3 : n==6 ? 4 : 5);", forms)
 	}
 }
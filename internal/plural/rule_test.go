@@ -39,6 +39,42 @@ func runTests(t *testing.T, pluralRuleID string, tests []pluralFormTest) {
 	}
 }
 
+// runGettextTests verifies the "Plural-Forms" header of gettext, whose plural
+// forms are indexed in the order of forms, returns the same plural forms as the
+// CLDR rule of the locale for integers.
+func runGettextTests(t *testing.T, pluralRuleID string, header string, forms []Form) {
+	if pluralRuleID == "root" {
+		return
+	}
+	rule := DefaultRules().Rule(language.MustParse(pluralRuleID))
+	if rule == nil {
+		t.Errorf("could not find plural rule for locale %s", pluralRuleID)
+		return
+	}
+	if rule.GettextPluralForms != header {
+		t.Errorf("%s: GettextPluralForms is %q; expected %q", pluralRuleID, rule.GettextPluralForms, header)
+	}
+
+	nplurals, plural, err := ParseGettextPluralForms(header)
+	if err != nil {
+		t.Errorf("%s: ParseGettextPluralForms(%q) errored with %s", pluralRuleID, header, err)
+		return
+	} else if nplurals != len(forms) {
+		t.Errorf("%s: ParseGettextPluralForms(%q) returned %d plural forms; expected %d", pluralRuleID, header, nplurals, len(forms))
+		return
+	}
+	for n := int64(0); n <= 200; n++ {
+		ops, err := NewOperands(n)
+		if err != nil {
+			t.Errorf("%s: NewOperands(%d) errored with %s", pluralRuleID, n, err)
+			break
+		}
+		if form, want := forms[plural(n)], rule.PluralFormFunc(ops); form != want {
+			t.Errorf("%s: plural form of %d by %q is %q; expected %q", pluralRuleID, n, header, form, want)
+		}
+	}
+}
+
 func appendIntegerTests(tests []pluralFormTest, form Form, examples []string) []pluralFormTest {
 	for _, ex := range expandExamples(examples) {
 		if strings.Contains(ex, "c") {
@@ -0,0 +1,101 @@
+// Copyright 2026 Joe Chen. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package i18n
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// LazyMessage is a message that is translated when being rendered, which can
+// be defined before any locale is available, e.g.
+//
+//	var msgWelcome = i18n.Lazy("notifications::welcome")
+//
+//	msgWelcome.In(l)
+type LazyMessage struct {
+	key  string
+	args []interface{}
+}
+
+// Lazy returns a new lazy message with the key and arguments.
+func Lazy(key string, args ...interface{}) LazyMessage {
+	return LazyMessage{
+		key:  key,
+		args: args,
+	}
+}
+
+// Key returns the key of the message.
+func (m LazyMessage) Key() string {
+	return m.key
+}
+
+// In returns the message translated in the locale. Arguments that are also
+// LazyMessage or *Error are localized in the same locale.
+func (m LazyMessage) In(l *Locale) string {
+	return localize(l, m.key, m.args)
+}
+
+// String returns the message translated in the default locale of the default
+// store (see SetDefaultStore). The key is used when the message is not
+// available.
+func (m LazyMessage) String() string {
+	return m.In(defaultLocale())
+}
+
+// Format implements fmt.Formatter to format the message translated in the
+// default locale as a string, e.g. "%-10s" and "%q".
+func (m LazyMessage) Format(f fmt.State, verb rune) {
+	switch verb {
+	case 's', 'v', 'q', 'x', 'X':
+	default:
+		verb = 's'
+	}
+	_, _ = fmt.Fprintf(f, formatDirective(f, verb), m.String())
+}
+
+// formatDirective returns the directive of the verb with flags, width and
+// precision of the state, e.g. "%-10s".
+func formatDirective(f fmt.State, verb rune) string {
+	directive := []byte{'%'}
+	for _, flag := range "+-# 0" {
+		if f.Flag(int(flag)) {
+			directive = append(directive, byte(flag))
+		}
+	}
+	if width, ok := f.Width(); ok {
+		directive = strconv.AppendInt(directive, int64(width), 10)
+	}
+	if precision, ok := f.Precision(); ok {
+		directive = append(directive, '.')
+		directive = strconv.AppendInt(directive, int64(precision), 10)
+	}
+	return string(append(directive, string(verb)...))
+}
+
+// localize returns the message of the key translated in the locale. Arguments
+// that are also LazyMessage or *Error are localized in the same locale. The
+// key and arguments are returned as-is when the message is not available.
+func localize(l *Locale, key string, args []interface{}) string {
+	localized := make([]interface{}, len(args))
+	for i, arg := range args {
+		switch v := arg.(type) {
+		case LazyMessage:
+			arg = v.In(l)
+		case *Error:
+			arg = v.Localize(l)
+		}
+		localized[i] = arg
+	}
+
+	if l == nil || !l.Has(key) {
+		if len(localized) == 0 {
+			return key
+		}
+		return fmt.Sprintf("%s %v", key, localized)
+	}
+	return l.Translate(key, localized...)
+}
@@ -0,0 +1,59 @@
+// Copyright 2026 Joe Chen. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package i18n
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var msgWelcome = Lazy("notifications::welcome", "Joe")
+
+func TestLazyMessage_In(t *testing.T) {
+	_, enUS, zhCN := newTestStore(t)
+
+	assert.Equal(t, "notifications::welcome", msgWelcome.Key())
+	assert.Equal(t, "Welcome, Joe!", msgWelcome.In(enUS))
+	assert.Equal(t, "欢迎，Joe！", msgWelcome.In(zhCN))
+
+	t.Run("localized arguments", func(t *testing.T) {
+		m := Lazy("notifications::invited", "Joe", msgWelcome)
+		assert.Equal(t, "Joe 邀请了你：欢迎，Joe！", m.In(zhCN))
+	})
+
+	t.Run("no message", func(t *testing.T) {
+		assert.Equal(t, "notifications::unknown", Lazy("notifications::unknown").In(zhCN))
+		assert.Equal(t, "notifications::welcome [Joe]", msgWelcome.In(nil))
+	})
+}
+
+func TestLazyMessage_String(t *testing.T) {
+	s, _, _ := newTestStore(t)
+	assert.Equal(t, "notifications::welcome [Joe]", msgWelcome.String())
+
+	SetDefaultStore(s)
+	defer SetDefaultStore(nil)
+
+	tests := []struct {
+		format string
+		want   string
+	}{
+		{format: "%s", want: "Welcome, Joe!"},
+		{format: "%v", want: "Welcome, Joe!"},
+		{format: "%q", want: `"Welcome, Joe!"`},
+		{format: "[%16s]", want: "[   Welcome, Joe!]"},
+		{format: "[%-16s]", want: "[Welcome, Joe!   ]"},
+		{format: "%.7s", want: "Welcome"},
+		{format: "%d", want: "Welcome, Joe!"},
+	}
+	for _, test := range tests {
+		t.Run(test.format, func(t *testing.T) {
+			assert.Equal(t, test.want, fmt.Sprintf(test.format, msgWelcome))
+		})
+	}
+	assert.Equal(t, "Welcome, Joe!", msgWelcome.String())
+}
@@ -0,0 +1,91 @@
+// Copyright 2026 Joe Chen. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package i18n
+
+import (
+	"fmt"
+	"strings"
+)
+
+// listTypes maps list types to the type names of CLDR list patterns.
+var listTypes = map[string]string{
+	"conjunction": "standard",
+	"disjunction": "or",
+	"unit":        "unit",
+}
+
+// FormatList joins the items with the CLDR list patterns of the locale, e.g.
+// "A, B, and C" in English and "A、B和C" in Chinese.
+//
+// The style is the type of "conjunction" (i.e. "and"), "disjunction" (i.e.
+// "or") or "unit" (e.g. "3 feet, 7 inches"), optionally followed by the width
+// of ":long" (the default), ":short" or ":narrow", e.g. "disjunction:short".
+func (l *Locale) FormatList(items []string, style string) string {
+	typ, width := style, "long"
+	if i := strings.IndexByte(style, ':'); i >= 0 {
+		typ, width = style[:i], style[i+1:]
+	}
+
+	lp := l.cldr.ListPattern(listTypes[typ], width)
+	if lp == nil {
+		return fmt.Sprintf("<no such list style: %s>", style)
+	}
+
+	switch len(items) {
+	case 0:
+		return ""
+	case 1:
+		return items[0]
+	case 2:
+		return l.joinList(lp.Two, items[0], items[1], items[1])
+	}
+
+	n := len(items)
+	list := l.joinList(lp.End, items[n-2], items[n-1], items[n-1])
+	for i := n - 3; i > 0; i-- {
+		list = l.joinList(lp.Middle, items[i], list, items[i+1])
+	}
+	return l.joinList(lp.Start, items[0], list, items[1])
+}
+
+// joinList joins two parts with the pattern, the next is the first item of the
+// second part to determine the contextual forms of conjunctions.
+func (l *Locale) joinList(pattern, first, second, next string) string {
+	base, _ := l.tag.Base()
+	if base.String() == "es" {
+		pattern = spanishListPattern(pattern, next)
+	}
+	return strings.NewReplacer("{0}", first, "{1}", second).Replace(pattern)
+}
+
+// spanishListPattern returns the pattern with "y" replaced by "e" when the next
+// item starts with an "i" sound, and "o" replaced by "u" when the next item
+// starts with an "o" sound.
+func spanishListPattern(pattern, next string) string {
+	lower := strings.ToLower(next)
+	switch {
+	case strings.Contains(pattern, " y "):
+		// "hie" and "hia" are pronounced with a "y" sound, e.g. "agua y hielo".
+		if strings.HasPrefix(lower, "i") ||
+			(strings.HasPrefix(lower, "hi") && !strings.HasPrefix(lower, "hie") && !strings.HasPrefix(lower, "hia")) {
+			return strings.Replace(pattern, " y ", " e ", 1)
+		}
+	case strings.Contains(pattern, " o "):
+		if strings.HasPrefix(lower, "o") || strings.HasPrefix(lower, "ho") ||
+			strings.HasPrefix(lower, "8") || next == "11" || strings.HasPrefix(lower, "11 ") {
+			return strings.Replace(pattern, " o ", " u ", 1)
+		}
+	}
+	return pattern
+}
+
+// formatListArg formats a []string argument as a list.
+func formatListArg(l *Locale, style string, arg interface{}, _ []interface{}) string {
+	items, ok := arg.([]string)
+	if !ok {
+		return fmt.Sprintf("<invalid type %T; expected []string>", arg)
+	}
+	return l.FormatList(items, style)
+}
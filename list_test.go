@@ -0,0 +1,67 @@
+// Copyright 2026 Joe Chen. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package i18n
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLocale_FormatList(t *testing.T) {
+	s := NewStore()
+	en, err := s.AddLocale("en-US", "English", []byte(``))
+	assert.Nil(t, err)
+	zh, err := s.AddLocale("zh-CN", "简体中文", []byte(``))
+	assert.Nil(t, err)
+	es, err := s.AddLocale("es-ES", "Español", []byte(``))
+	assert.Nil(t, err)
+
+	tests := []struct {
+		name   string
+		locale *Locale
+		items  []string
+		style  string
+		want   string
+	}{
+		{name: "empty", locale: en, items: nil, style: "conjunction", want: ""},
+		{name: "one", locale: en, items: []string{"A"}, style: "conjunction", want: "A"},
+		{name: "two", locale: en, items: []string{"A", "B"}, style: "conjunction", want: "A and B"},
+		{name: "three", locale: en, items: []string{"A", "B", "C"}, style: "conjunction", want: "A, B, and C"},
+		{name: "four", locale: en, items: []string{"A", "B", "C", "D"}, style: "conjunction", want: "A, B, C, and D"},
+		{name: "short", locale: en, items: []string{"A", "B", "C"}, style: "conjunction:short", want: "A, B, & C"},
+		{name: "disjunction", locale: en, items: []string{"A", "B", "C"}, style: "disjunction", want: "A, B, or C"},
+		{name: "unit", locale: en, items: []string{"3 feet", "7 inches"}, style: "unit", want: "3 feet, 7 inches"},
+		{name: "zh", locale: zh, items: []string{"甲", "乙", "丙"}, style: "conjunction", want: "甲、乙和丙"},
+		{name: "es y", locale: es, items: []string{"agua", "sal", "hielo"}, style: "conjunction", want: "agua, sal y hielo"},
+		{name: "es e", locale: es, items: []string{"Francia", "Italia"}, style: "conjunction", want: "Francia e Italia"},
+		{name: "es e with h", locale: es, items: []string{"padres", "hijos"}, style: "conjunction", want: "padres e hijos"},
+		{name: "es u", locale: es, items: []string{"siete", "ocho"}, style: "disjunction", want: "siete u ocho"},
+		{name: "es u with digits", locale: es, items: []string{"7", "8"}, style: "disjunction", want: "7 u 8"},
+		{name: "es o", locale: es, items: []string{"uno", "dos", "tres"}, style: "disjunction", want: "uno, dos o tres"},
+		{name: "bad type", locale: en, items: []string{"A"}, style: "both", want: "<no such list style: both>"},
+		{name: "bad width", locale: en, items: []string{"A"}, style: "conjunction:wide", want: "<no such list style: conjunction:wide>"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := test.locale.FormatList(test.items, test.style)
+			assert.Equal(t, test.want, got)
+		})
+	}
+}
+
+func TestLocale_Translate_List(t *testing.T) {
+	l, err := NewStore().AddLocale("en-US", "English", []byte(`
+[messages]
+reviewers = Waiting for ${list:conjunction, 1} to review %[2]d%% of the changes
+`))
+	assert.Nil(t, err)
+
+	got := l.Translate("messages::reviewers", []string{"Joe", "Jane", "Jim"}, 50)
+	assert.Equal(t, "Waiting for Joe, Jane, and Jim to review 50% of the changes", got)
+
+	got = l.Translate("messages::reviewers", "Joe", 50)
+	assert.Equal(t, "Waiting for <invalid type string; expected []string> to review 50% of the changes", got)
+}
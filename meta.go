@@ -0,0 +1,125 @@
+// Copyright 2026 Joe Chen. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package i18n
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"gopkg.in/ini.v1"
+)
+
+// MessageMeta is the metadata of a message for translators and tooling. It is
+// read from comments above the key, where lines in the form of "@<field>:
+// <value>" set fields and other lines are kept as the comment, e.g.
+//
+//	# Shown on the home page
+//	# @description: Greeting to the signed in user
+//	# @context: home
+//	# @max_length: 20
+//	hello = Hello, %s!
+//
+// Or from the "<key>@meta" key in the same section as a JSON object, which
+// takes precedence over comments, e.g.
+//
+//	hello@meta = {"description": "Greeting to the signed in user", "max_length": 20}
+//
+// Metadata is advisory, a malformed "@max_length" line is kept as the comment
+// and a malformed "<key>@meta" key is ignored, neither fails loading the
+// locale.
+type MessageMeta struct {
+	// The comment lines without comment markers and metadata fields.
+	Comment string `json:"comment,omitempty"`
+	// The description of the message.
+	Description string `json:"description,omitempty"`
+	// The context to disambiguate messages with the same text.
+	Context string `json:"context,omitempty"`
+	// The maximum length of the translated message, 0 means no limit.
+	MaxLength int `json:"max_length,omitempty"`
+}
+
+// metaKeySuffix is the suffix of keys that define metadata of messages.
+const metaKeySuffix = "@meta"
+
+// isMetaKey returns true if the key name defines metadata of a message.
+func isMetaKey(name string) bool {
+	return strings.HasSuffix(name, metaKeySuffix)
+}
+
+// newMessageMeta returns the metadata of the key read from its comment and the
+// "<key>@meta" key of the section, or nil if none.
+func newMessageMeta(s *ini.Section, k *ini.Key) *MessageMeta {
+	var object string
+	if s.HasKey(k.Name() + metaKeySuffix) {
+		object = s.Key(k.Name() + metaKeySuffix).Value()
+	}
+
+	meta := ParseMessageMeta(k.Comment, object)
+	if meta == (MessageMeta{}) {
+		return nil
+	}
+	return &meta
+}
+
+// ParseMessageMeta parses the metadata of a message from its comment, whose
+// lines may or may not have comment markers, and the JSON object of its
+// "<key>@meta" key, which is empty if none. Malformed metadata is ignored (see
+// MessageMeta).
+func ParseMessageMeta(comment, object string) MessageMeta {
+	var meta MessageMeta
+	var comments []string
+	for _, line := range strings.Split(comment, "\n") {
+		line = strings.TrimSpace(line)
+		if line != "" && (line[0] == '#' || line[0] == ';') {
+			line = strings.TrimSpace(line[1:])
+		}
+		if line == "" {
+			continue
+		}
+
+		if strings.HasPrefix(line, "@") {
+			fields := strings.SplitN(line[1:], ":", 2)
+			if len(fields) == 2 {
+				value := strings.TrimSpace(fields[1])
+				switch strings.TrimSpace(fields[0]) {
+				case "description":
+					meta.Description = value
+					continue
+				case "context":
+					meta.Context = value
+					continue
+				case "max_length":
+					n, err := strconv.Atoi(value)
+					if err != nil {
+						break
+					}
+					meta.MaxLength = n
+					continue
+				}
+			}
+		}
+		comments = append(comments, line)
+	}
+	meta.Comment = strings.Join(comments, "\n")
+
+	if object != "" {
+		// Decode into a copy, so that a malformed object leaves no partial fields.
+		override := meta
+		err := json.Unmarshal([]byte(object), &override)
+		if err == nil {
+			meta = override
+		}
+	}
+	return meta
+}
+
+// Meta returns the metadata of the message.
+func (m *Message) Meta() MessageMeta {
+	if m.meta == nil {
+		return MessageMeta{}
+	}
+	return *m.meta
+}
@@ -0,0 +1,152 @@
+// Copyright 2026 Joe Chen. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package i18n
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMessage_Meta(t *testing.T) {
+	s := NewStore()
+	l, err := s.AddLocale("en-US", "English", []byte(`
+; The title of the site
+title = Welcome
+
+[messages]
+# Shown on the home page
+# @description: Greeting to the signed in user
+# @context: home
+# @max_length: 20
+hello = Hello, %s!
+
+# The verb
+; @unknown: kept as comment
+open = Open
+open@meta = {"context": "button", "max_length": 10}
+
+# Overridden by JSON
+# @description: From comment
+closed = Closed
+closed@meta = {"description": "From JSON"}
+
+plain = Plain
+`))
+	require.Nil(t, err)
+
+	tests := []struct {
+		key  string
+		want MessageMeta
+	}{
+		{
+			key:  "title",
+			want: MessageMeta{Comment: "The title of the site"},
+		},
+		{
+			key: "messages::hello",
+			want: MessageMeta{
+				Comment:     "Shown on the home page",
+				Description: "Greeting to the signed in user",
+				Context:     "home",
+				MaxLength:   20,
+			},
+		},
+		{
+			key: "messages::open",
+			want: MessageMeta{
+				Comment:   "The verb\n@unknown: kept as comment",
+				Context:   "button",
+				MaxLength: 10,
+			},
+		},
+		{
+			key: "messages::closed",
+			want: MessageMeta{
+				Comment:     "Overridden by JSON",
+				Description: "From JSON",
+			},
+		},
+		{
+			key:  "messages::plain",
+			want: MessageMeta{},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.key, func(t *testing.T) {
+			m, ok := l.Message(test.key)
+			require.True(t, ok)
+			assert.Equal(t, test.want, m.Meta())
+		})
+	}
+
+	t.Run("meta keys are not messages", func(t *testing.T) {
+		_, ok := l.Message("messages::open@meta")
+		assert.False(t, ok)
+		assert.Equal(t, "Open", l.Translate("messages::open"))
+	})
+
+	t.Run("malformed metadata", func(t *testing.T) {
+		l, err := NewStore().AddLocale("en-US", "English", []byte(`
+# @max_length: ten
+hello = Hello
+
+# @context: greeting
+bye = Bye
+bye@meta = {"description": "Farewell", "max_length": "ten"}
+`))
+		assert.Nil(t, err)
+
+		m, ok := l.Message("hello")
+		assert.True(t, ok)
+		assert.Equal(t, MessageMeta{Comment: "@max_length: ten"}, m.Meta())
+
+		m, ok = l.Message("bye")
+		assert.True(t, ok)
+		assert.Equal(t, MessageMeta{Context: "greeting"}, m.Meta())
+	})
+}
+
+func TestParseMessageMeta(t *testing.T) {
+	tests := []struct {
+		name    string
+		comment string
+		object  string
+		want    MessageMeta
+	}{
+		{
+			name:    "with comment markers",
+			comment: "# Shown on the home page\n; @context: home",
+			want:    MessageMeta{Comment: "Shown on the home page", Context: "home"},
+		},
+		{
+			name:    "without comment markers",
+			comment: "Shown on the home page\n\n@max_length: 20",
+			want:    MessageMeta{Comment: "Shown on the home page", MaxLength: 20},
+		},
+		{
+			name:    "object",
+			comment: "@description: From comment",
+			object:  `{"description": "From JSON"}`,
+			want:    MessageMeta{Description: "From JSON"},
+		},
+		{
+			name:    "malformed object",
+			comment: "@description: From comment",
+			object:  `{"description": 1}`,
+			want:    MessageMeta{Description: "From comment"},
+		},
+		{
+			name: "empty",
+			want: MessageMeta{},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.want, ParseMessageMeta(test.comment, test.object))
+		})
+	}
+}
@@ -0,0 +1,174 @@
+// Copyright 2026 Joe Chen. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package i18n
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// TranslateNamed translates the message with the named arguments. Placeholders
+// refer to arguments by name instead of index:
+//   - "{count}" prints the argument as-is.
+//   - "${file, count}" is a plural placeholder.
+//   - "${date:medium, created}" is a format placeholder, see Message.Translate
+//     for the supported kinds.
+//
+// A name that is used by the message but is missing from the arguments is
+// reported as "<no arg for name count>". Unlike Translate, the message is not
+// processed by the fmt package, thus format verbs are left as-is, but "%%" is
+// still printed as "%" so that the same text works with both. Literal braces
+// are written as "{{" and "}}", e.g. "{{count}}" prints "{count}".
+func (m *Message) TranslateNamed(args map[string]interface{}) string {
+	return m.translateNamed(args, args, nil)
+}
+
+// translateNamed translates the message with the named arguments for plural and
+// format placeholders, and the named arguments for other placeholders, see
+// Message.translate for the wrap function.
+func (m *Message) translateNamed(args, printArgs map[string]interface{}, wrap func(string) string) string {
+	// The full list of arguments is only needed by format placeholders.
+	var values []interface{}
+	replaces := make([]string, 0, len(m.named)*2+6)
+	replaces = append(replaces, "%%", "%", "{{", "{", "}}", "}")
+	for _, placeholder := range m.named {
+		arg, ok := args[placeholder.arg]
+		if !ok {
+			replaces = append(replaces, placeholder.name, fmt.Sprintf("<no arg for name %s>", placeholder.arg))
+			continue
+		}
+
+		var value string
+		switch {
+		case placeholder.plural != nil:
+			value = m.pluralize(placeholder.plural, arg)
+		case placeholder.format != nil:
+			if values == nil {
+				values = m.namedValues(args)
+			}
+			value = placeholder.format.format(m.locale, placeholder.format.style, arg, values)
+		default:
+			value = fmt.Sprint(printArgs[placeholder.arg])
+		}
+		if wrap != nil && (placeholder.plural != nil || placeholder.format != nil) {
+			value = wrap(value)
+		}
+		replaces = append(replaces, placeholder.name, value)
+	}
+
+	// NOTE: Replacing all placeholders in one pass so that values containing
+	//  text of other placeholders are not replaced again.
+	return strings.NewReplacer(replaces...).Replace(m.format)
+}
+
+// namedValues returns the list of named arguments for format placeholders in a
+// deterministic order, i.e. arguments that are used by the message in the order
+// of placeholders, then the rest sorted by name.
+func (m *Message) namedValues(args map[string]interface{}) []interface{} {
+	values := make([]interface{}, 0, len(args))
+	seen := make(map[string]bool, len(args))
+	for _, placeholder := range m.named {
+		arg, ok := args[placeholder.arg]
+		if !ok || seen[placeholder.arg] {
+			continue
+		}
+		seen[placeholder.arg] = true
+		values = append(values, arg)
+	}
+
+	rest := make([]string, 0, len(args)-len(seen))
+	for name := range args {
+		if !seen[name] {
+			rest = append(rest, name)
+		}
+	}
+	sort.Strings(rest)
+	for _, name := range rest {
+		values = append(values, args[name])
+	}
+	return values
+}
+
+// NamedArgs returns the named arguments of the struct (or pointer to struct)
+// for TranslateNamed. Exported fields are named by the "i18n" tag or the field
+// name, and fields tagged with "-" are skipped. Fields of embedded structs
+// without a tag are promoted with the same rules as Go, i.e. the shallowest
+// field takes precedence, and names defined by multiple fields at the same
+// depth are skipped. It returns nil if the value is not a struct.
+func NamedArgs(v interface{}) map[string]interface{} {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Ptr {
+		if rv.IsNil() {
+			return nil
+		}
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return nil
+	}
+
+	fields := make(map[string]*namedField, rv.NumField())
+	addNamedFields(fields, rv, 0)
+	args := make(map[string]interface{}, len(fields))
+	for name, field := range fields {
+		if !field.ambiguous {
+			args[name] = field.value
+		}
+	}
+	return args
+}
+
+// namedField is a field of a struct for NamedArgs.
+type namedField struct {
+	value     interface{}
+	depth     int  // The depth of embedding, 0 for fields of the outer struct
+	ambiguous bool // Whether multiple fields have the same name at the depth
+}
+
+// addNamedFields adds fields of the struct value at the depth to the fields.
+// Fields at a shallower depth take precedence over promoted fields of embedded
+// structs.
+func addNamedFields(fields map[string]*namedField, rv reflect.Value, depth int) {
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		field := rt.Field(i)
+		tag := field.Tag.Get("i18n")
+		if tag == "-" {
+			continue
+		}
+
+		if field.Anonymous && tag == "" {
+			fv := rv.Field(i)
+			if fv.Kind() == reflect.Ptr {
+				if fv.IsNil() {
+					continue
+				}
+				fv = fv.Elem()
+			}
+			if fv.Kind() == reflect.Struct {
+				addNamedFields(fields, fv, depth+1)
+				continue
+			}
+		}
+
+		if field.PkgPath != "" { // Unexported
+			continue
+		}
+
+		name := field.Name
+		if tag != "" {
+			name = tag
+		}
+		f, ok := fields[name]
+		switch {
+		case !ok || depth < f.depth:
+			fields[name] = &namedField{value: rv.Field(i).Interface(), depth: depth}
+		case depth == f.depth:
+			f.ambiguous = true
+		}
+	}
+}
@@ -0,0 +1,271 @@
+// Copyright 2026 Joe Chen. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package i18n
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLocale_TranslateNamed(t *testing.T) {
+	l, err := NewStore().AddLocale(
+		"en-US",
+		"English",
+		[]byte(`
+[plurals]
+file.one = file
+file.other = files
+
+[messages]
+simple = Hello, {name}!
+plural = {user} changed {count} ${file, count}
+repeated = {count} ${file, count}, {count} ${file, count}
+format = Created on ${date:medium, created}
+compact = ${compact:short, count} ${file, count}
+no-plural = {count} ${dog, count}
+no-names = 100% done
+percent = {progress}%% done for {name}
+escaped = {{name}} is replaced by {name}, {{{name}}}
+positional = I have %[1]d ${file, 1}
+zoned = ${time:short, at} {zone}
+`),
+	)
+	assert.Nil(t, err)
+
+	created := time.Date(2021, time.March, 4, 5, 6, 7, 0, time.UTC)
+	tests := []struct {
+		name string
+		key  string
+		args map[string]interface{}
+		want string
+	}{
+		{
+			name: "simple",
+			key:  "messages::simple",
+			args: map[string]interface{}{"name": "Joe"},
+			want: "Hello, Joe!",
+		},
+		{
+			name: "plural",
+			key:  "messages::plural",
+			args: map[string]interface{}{"user": "Joe", "count": 2},
+			want: "Joe changed 2 files",
+		},
+		{
+			name: "repeated",
+			key:  "messages::repeated",
+			args: map[string]interface{}{"count": 1},
+			want: "1 file, 1 file",
+		},
+		{
+			name: "format",
+			key:  "messages::format",
+			args: map[string]interface{}{"created": created},
+			want: "Created on Mar 4, 2021",
+		},
+		{
+			name: "compact",
+			key:  "messages::compact",
+			args: map[string]interface{}{"count": 1200},
+			want: "1.2K files",
+		},
+		{
+			name: "no such plural",
+			key:  "messages::no-plural",
+			args: map[string]interface{}{"count": 2},
+			want: "2 <no such plural: dog>",
+		},
+		{
+			name: "no arg for name",
+			key:  "messages::plural",
+			args: map[string]interface{}{"count": 1},
+			want: "<no arg for name user> changed 1 file",
+		},
+		{
+			name: "no names",
+			key:  "messages::no-names",
+			args: nil,
+			want: "100% done",
+		},
+		{
+			name: "percent",
+			key:  "messages::percent",
+			args: map[string]interface{}{"progress": 100, "name": "Joe"},
+			want: "100% done for Joe",
+		},
+		{
+			name: "escaped braces",
+			key:  "messages::escaped",
+			args: map[string]interface{}{"name": "Joe"},
+			want: "{name} is replaced by Joe, {Joe}",
+		},
+		{
+			name: "value contains placeholder",
+			key:  "messages::plural",
+			args: map[string]interface{}{"user": "{count}", "count": 1},
+			want: "{count} changed 1 file",
+		},
+		{
+			name: "positional",
+			key:  "messages::positional",
+			args: map[string]interface{}{"count": 1},
+			want: "I have %[1]d ${1}",
+		},
+		{
+			name: "no such key",
+			key:  "messages::404",
+			args: nil,
+			want: "<no such key: messages::404>",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := l.TranslateNamed(test.key, test.args)
+			assert.Equal(t, test.want, got)
+		})
+	}
+
+	t.Run("same text as Translate", func(t *testing.T) {
+		l, err := NewStore().AddLocale("en-US", "English", []byte(`done = %d%% done`))
+		assert.Nil(t, err)
+		assert.Equal(t, "100% done", l.Translate("done", 100))
+		assert.Equal(t, "%d% done", l.TranslateNamed("done", nil))
+	})
+
+	t.Run("escaped braces are not placeholders", func(t *testing.T) {
+		m, ok := l.Message("messages::escaped")
+		assert.True(t, ok)
+		assert.Equal(t, []Placeholder{{Text: "{name}", Kind: "named", Arg: "name"}}, m.Placeholders())
+	})
+
+	t.Run("arguments in order of placeholders", func(t *testing.T) {
+		args := map[string]interface{}{
+			"at":    created,
+			"other": time.FixedZone("EST", -5*60*60),
+			"zone":  time.FixedZone("JST", 9*60*60),
+		}
+		// The location used by the message is looked up before others.
+		for i := 0; i < 20; i++ {
+			assert.Equal(t, "2:06 PM JST", l.TranslateNamed("messages::zoned", args))
+		}
+
+		delete(args, "zone")
+		args["another"] = time.FixedZone("CST", 8*60*60)
+		// Others are looked up by their names.
+		for i := 0; i < 20; i++ {
+			assert.Equal(t, "1:06 PM <no arg for name zone>", l.TranslateNamed("messages::zoned", args))
+		}
+	})
+
+	t.Run("positional translate is unaffected", func(t *testing.T) {
+		assert.Equal(t, "{user} changed {count} ${file, count}", l.Translate("messages::plural"))
+		assert.Equal(t, "I have 2 files", l.Translate("messages::positional", 2))
+	})
+}
+
+func TestNamedArgs(t *testing.T) {
+	type Base struct {
+		User  string
+		Count int
+	}
+	type args struct {
+		Base
+		Count   int    `i18n:"count"`
+		File    string `i18n:"file"`
+		Ignored string `i18n:"-"`
+		private string
+	}
+
+	tests := []struct {
+		name string
+		v    interface{}
+		want map[string]interface{}
+	}{
+		{
+			name: "struct",
+			v: args{
+				Base:    Base{User: "Joe", Count: 1},
+				Count:   2,
+				File:    "a.txt",
+				Ignored: "ignored",
+				private: "private",
+			},
+			want: map[string]interface{}{
+				"User":  "Joe",
+				"Count": 1,
+				"count": 2,
+				"file":  "a.txt",
+			},
+		},
+		{
+			name: "pointer",
+			v:    &Base{User: "Joe", Count: 1},
+			want: map[string]interface{}{
+				"User":  "Joe",
+				"Count": 1,
+			},
+		},
+		{
+			name: "nil pointer",
+			v:    (*Base)(nil),
+			want: nil,
+		},
+		{
+			name: "not a struct",
+			v:    1,
+			want: nil,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.want, NamedArgs(test.v))
+		})
+	}
+
+	t.Run("outer fields take precedence", func(t *testing.T) {
+		type outer struct {
+			Base
+			User string
+		}
+		got := NamedArgs(outer{Base: Base{User: "inner"}, User: "outer"})
+		assert.Equal(t, "outer", got["User"])
+	})
+
+	t.Run("shallowest promoted fields take precedence", func(t *testing.T) {
+		type Inner struct {
+			User string
+		}
+		type Middle struct {
+			Inner
+		}
+		type Other struct {
+			User  string
+			Count int
+		}
+		type outer struct {
+			Middle
+			Other
+			*Base
+		}
+		for i := 0; i < 20; i++ {
+			got := NamedArgs(outer{
+				Middle: Middle{Inner: Inner{User: "deep"}},
+				Other:  Other{User: "shallow", Count: 1},
+				Base:   &Base{User: "ambiguous", Count: 2},
+			})
+			// Both "Other" and "Base" define "User" and "Count" at the same
+			// depth, thus they are ambiguous as in Go.
+			assert.Equal(t, map[string]interface{}{}, got)
+		}
+
+		got := NamedArgs(outer{
+			Middle: Middle{Inner: Inner{User: "deep"}},
+			Other:  Other{User: "shallow", Count: 1},
+		})
+		assert.Equal(t, map[string]interface{}{"User": "shallow", "Count": 1}, got)
+	})
+}
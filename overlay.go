@@ -0,0 +1,246 @@
+// Copyright 2026 Joe Chen. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package i18n
+
+import (
+	"container/list"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"unknwon.dev/i18n/internal/plural"
+)
+
+// Overlay returns a new locale that resolves messages and plural nouns from the
+// overrides loaded from the list of sources first, and from the base locale
+// otherwise. Compiled messages of the base locale are shared rather than
+// copied, except those that use plural nouns being overridden. The returned
+// locale has the same language as the base locale, and it is not added to the
+// store.
+func (s *Store) Overlay(base *Locale, source interface{}, others ...interface{}) (*Locale, error) {
+	file, err := loadFile(base.Lang(), source, others...)
+	if err != nil {
+		return nil, errors.Wrap(err, "load sources")
+	}
+
+	overrides := newPluralForms(file.Section(pluralsSection))
+	l := &Locale{
+		tag:        base.tag,
+		desc:       base.desc,
+		pluralRule: base.pluralRule,
+		cldr:       base.cldr,
+		plurals:    make(map[string]map[plural.Form]string, len(base.plurals)+len(overrides)),
+		messages:   make(map[string]*Message),
+		base:       base,
+	}
+	for noun, forms := range base.plurals {
+		l.plurals[noun] = forms
+	}
+	for noun, forms := range overrides {
+		merged := make(map[plural.Form]string, len(l.plurals[noun])+len(forms))
+		for form, text := range l.plurals[noun] {
+			merged[form] = text
+		}
+		for form, text := range forms {
+			merged[form] = text
+		}
+		l.plurals[noun] = merged
+	}
+
+	err = l.addMessages(file)
+	if err != nil {
+		return nil, errors.Wrap(err, "new locale")
+	}
+
+	// Messages of the base locale that use overridden plural nouns have to be
+	// compiled again with plurals of the overlay.
+	if len(overrides) == 0 {
+		return l, nil
+	}
+	for _, key := range base.Keys() {
+		if _, ok := l.messages[key]; ok {
+			continue
+		}
+
+		m, _ := base.message(key)
+		for _, p := range m.Placeholders() {
+			if _, ok := overrides[p.Noun]; !ok || p.Kind != "plural" {
+				continue
+			}
+
+			om, err := newMessage(l, m.raw)
+			if err != nil {
+				return nil, errors.Wrapf(err, "compile %q", key)
+			}
+			om.meta = m.meta
+			l.messages[key] = om
+			break
+		}
+	}
+	return l, nil
+}
+
+// OverlayCache is a cache of overlay locales keyed by tenants, which allows
+// many tenants to customize messages while sharing compiled messages of base
+// locales of the store. Overlay locales are created again when their base
+// locales are replaced in the store (see Store.ReplaceLocale), and the least
+// recently used ones are evicted when the cache is full. It is safe for
+// concurrent use.
+type OverlayCache struct {
+	store *Store
+	size  int
+	load  func(tenant, lang string) (interface{}, error)
+
+	mu       sync.Mutex
+	overlays map[overlayKey]*list.Element // Values are *overlayEntry
+	recent   *list.List                   // The most recently used first
+
+	// Generations are bumped by Invalidate and Purge, so that overlay locales
+	// loaded concurrently with them are not cached, see generation.
+	seq         uint64            // The last generation
+	purged      uint64            // The generation of the last purge
+	generations map[string]uint64 // The generation of the last invalidation of tenants
+}
+
+type overlayKey struct {
+	tenant string
+	lang   string
+}
+
+// overlayEntry is a cached overlay locale.
+type overlayEntry struct {
+	key    overlayKey
+	base   *Locale // The locale of the store that the overlay is created from
+	locale *Locale
+}
+
+// NewOverlayCache returns a new cache of overlay locales of the store, which
+// keeps at most the size of overlay locales, or unlimited if the size is not
+// positive. The load function returns the data source of overrides of the
+// tenant for the language, or nil if the tenant has no overrides.
+func NewOverlayCache(s *Store, size int, load func(tenant, lang string) (interface{}, error)) *OverlayCache {
+	return &OverlayCache{
+		store:       s,
+		size:        size,
+		load:        load,
+		overlays:    make(map[overlayKey]*list.Element),
+		recent:      list.New(),
+		generations: make(map[string]uint64),
+	}
+}
+
+// Locale returns the overlay locale of the tenant for the language, which is
+// created on first use or when the locale of the store has been replaced since
+// then. The locale of the store is returned as-is if the tenant has no
+// overrides. Errors are not cached, so the next call tries again, and neither
+// is the overlay locale if the tenant is invalidated (see Invalidate) while it
+// is being loaded.
+func (c *OverlayCache) Locale(tenant, lang string) (*Locale, error) {
+	key := overlayKey{tenant: tenant, lang: lang}
+	base, err := c.store.Locale(lang)
+	if err != nil {
+		// The overlay locale is stale if the locale has been removed.
+		c.mu.Lock()
+		c.remove(key)
+		c.mu.Unlock()
+		return nil, err
+	}
+
+	c.mu.Lock()
+	if e, ok := c.get(key); ok && e.base == base {
+		c.mu.Unlock()
+		return e.locale, nil
+	}
+	generation := c.generation(tenant)
+	c.mu.Unlock()
+
+	source, err := c.load(tenant, base.Lang())
+	if err != nil {
+		return nil, errors.Wrapf(err, "load overrides of %q", tenant)
+	}
+
+	l := base
+	if source != nil {
+		l, err = c.store.Overlay(base, source)
+		if err != nil {
+			return nil, errors.Wrapf(err, "overlay of %q", tenant)
+		}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.generation(tenant) != generation {
+		// The overrides may have been changed after they were loaded.
+		return l, nil
+	}
+	if e, ok := c.get(key); ok {
+		// Keep the one that is cached by a concurrent call.
+		if e.base == base {
+			return e.locale, nil
+		}
+		e.base, e.locale = base, l
+		return l, nil
+	}
+
+	c.overlays[key] = c.recent.PushFront(&overlayEntry{key: key, base: base, locale: l})
+	if c.size > 0 && c.recent.Len() > c.size {
+		c.remove(c.recent.Back().Value.(*overlayEntry).key)
+	}
+	return l, nil
+}
+
+// generation returns the generation of cached overlay locales of the tenant,
+// which changes whenever they are invalidated. The caller must hold the lock.
+func (c *OverlayCache) generation(tenant string) uint64 {
+	if g := c.generations[tenant]; g > c.purged {
+		return g
+	}
+	return c.purged
+}
+
+// get returns the cached entry of the key and marks it as the most recently
+// used. The caller must hold the lock.
+func (c *OverlayCache) get(key overlayKey) (*overlayEntry, bool) {
+	elem, ok := c.overlays[key]
+	if !ok {
+		return nil, false
+	}
+	c.recent.MoveToFront(elem)
+	return elem.Value.(*overlayEntry), true
+}
+
+// remove removes the cached entry of the key if any. The caller must hold the
+// lock.
+func (c *OverlayCache) remove(key overlayKey) {
+	if elem, ok := c.overlays[key]; ok {
+		c.recent.Remove(elem)
+		delete(c.overlays, key)
+	}
+}
+
+// Invalidate removes all cached overlay locales of the tenant, e.g. after the
+// overrides have been changed.
+func (c *OverlayCache) Invalidate(tenant string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.seq++
+	c.generations[tenant] = c.seq
+	for key := range c.overlays {
+		if key.tenant == tenant {
+			c.remove(key)
+		}
+	}
+}
+
+// Purge removes all cached overlay locales.
+func (c *OverlayCache) Purge() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.overlays = make(map[overlayKey]*list.Element)
+	c.recent.Init()
+	c.seq++
+	c.purged = c.seq
+	c.generations = make(map[string]uint64)
+}
@@ -0,0 +1,270 @@
+// Copyright 2026 Joe Chen. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package i18n
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newOverlayStore(t *testing.T) (*Store, *Locale) {
+	s := NewStore()
+	l, err := s.AddLocale("en-US", "English", []byte(`
+title = Issue tracker
+
+[plurals]
+issue.one = issue
+issue.other = issues
+
+[messages]
+hello = Hello, %s!
+issues = %d open ${issue, 1}
+`))
+	require.Nil(t, err)
+	return s, l
+}
+
+func TestStore_Overlay(t *testing.T) {
+	s, base := newOverlayStore(t)
+
+	l, err := s.Overlay(base, []byte(`
+title = Ticket desk
+
+[plurals]
+issue.one = ticket
+issue.other = tickets
+
+[messages]
+bye = Bye, %s!
+`))
+	require.Nil(t, err)
+
+	assert.Equal(t, "en-US", l.Lang())
+	assert.Equal(t, "English", l.Description())
+
+	assert.Equal(t, "Ticket desk", l.Translate("title"))
+	assert.Equal(t, "Hello, Joe!", l.Translate("messages::hello", "Joe"))
+	assert.Equal(t, "Bye, Joe!", l.Translate("messages::bye", "Joe"))
+	assert.Equal(t, "2 open tickets", l.Translate("messages::issues", 2))
+	assert.Equal(t, "ticket", l.Plural("issue", 1))
+
+	t.Run("base locale is intact", func(t *testing.T) {
+		assert.Equal(t, "Issue tracker", base.Translate("title"))
+		assert.Equal(t, "2 open issues", base.Translate("messages::issues", 2))
+		assert.False(t, base.Has("messages::bye"))
+		_, err := s.Locale("en-US")
+		assert.Nil(t, err)
+		langs, _ := s.Locales()
+		assert.Equal(t, []string{"en-US"}, langs)
+	})
+
+	t.Run("messages are shared", func(t *testing.T) {
+		want, _ := base.Message("messages::hello")
+		got, ok := l.Message("messages::hello")
+		require.True(t, ok)
+		assert.Same(t, want, got)
+	})
+
+	t.Run("introspection", func(t *testing.T) {
+		assert.Equal(t, []string{"messages::bye", "messages::hello", "messages::issues", "title"}, l.Keys())
+		assert.True(t, l.Has("messages::hello"))
+		assert.Equal(t, []string{"DEFAULT", "messages"}, l.Sections())
+	})
+
+	t.Run("invalid overrides", func(t *testing.T) {
+		_, err := s.Overlay(base, []byte(`
+title = I have %[1]d ${cat, 0}
+`))
+		assert.Equal(t, `new locale: the smallest index is 1 but got 0 for "${cat, 0}"`, fmt.Sprintf("%v", err))
+	})
+
+	t.Run("empty overrides", func(t *testing.T) {
+		l, err := s.Overlay(base, []byte(`title = `))
+		assert.Nil(t, err)
+		assert.Equal(t, "", l.Translate("title"))
+		assert.Equal(t, "Issue tracker", base.Translate("title"))
+	})
+
+	t.Run("malformed metadata", func(t *testing.T) {
+		l, err := s.Overlay(base, []byte(`
+# @max_length: ten
+title = Ticket desk
+`))
+		assert.Nil(t, err)
+		assert.Equal(t, "Ticket desk", l.Translate("title"))
+	})
+}
+
+func TestOverlayCache(t *testing.T) {
+	s, base := newOverlayStore(t)
+
+	loads := 0
+	c := NewOverlayCache(s, 0, func(tenant, lang string) (interface{}, error) {
+		loads++
+		switch tenant {
+		case "acme":
+			return []byte(`title = ACME ` + lang), nil
+		case "broken":
+			return nil, fmt.Errorf("database is down")
+		}
+		return nil, nil
+	})
+
+	l1, err := c.Locale("acme", "en-US")
+	require.Nil(t, err)
+	assert.Equal(t, "ACME en-US", l1.Translate("title"))
+
+	l2, err := c.Locale("acme", "en-US")
+	require.Nil(t, err)
+	assert.Same(t, l1, l2)
+	assert.Equal(t, 1, loads)
+
+	t.Run("no overrides", func(t *testing.T) {
+		l, err := c.Locale("globex", "en-US")
+		require.Nil(t, err)
+		assert.Same(t, base, l)
+	})
+
+	t.Run("invalidate", func(t *testing.T) {
+		c.Invalidate("acme")
+		l3, err := c.Locale("acme", "en-US")
+		require.Nil(t, err)
+		assert.NotSame(t, l1, l3)
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		_, err := c.Locale("acme", "fr-FR")
+		assert.Equal(t, ErrLocalNotFound, err)
+
+		_, err = c.Locale("broken", "en-US")
+		assert.Equal(t, `load overrides of "broken": database is down`, fmt.Sprintf("%v", err))
+	})
+
+	t.Run("replaced locale", func(t *testing.T) {
+		l1, err := c.Locale("acme", "en-US")
+		require.Nil(t, err)
+
+		_, err = s.ReplaceLocale("en-US", "English", []byte(`
+title = Issue tracker
+
+[messages]
+hello = Hi, %s!
+`))
+		require.Nil(t, err)
+
+		loads = 0
+		l2, err := c.Locale("acme", "en-US")
+		require.Nil(t, err)
+		assert.NotSame(t, l1, l2)
+		assert.Equal(t, 1, loads)
+		assert.Equal(t, "ACME en-US", l2.Translate("title"))
+		assert.Equal(t, "Hi, Joe!", l2.Translate("messages::hello", "Joe"))
+
+		l, err := c.Locale("globex", "en-US")
+		require.Nil(t, err)
+		assert.Equal(t, "Hi, Joe!", l.Translate("messages::hello", "Joe"))
+	})
+
+	t.Run("removed locale", func(t *testing.T) {
+		_, err := s.AddLocale("zh-CN", "简体中文", []byte(`title = 问题追踪`))
+		require.Nil(t, err)
+		_, err = c.Locale("acme", "zh-CN")
+		require.Nil(t, err)
+
+		require.Nil(t, s.RemoveLocale("zh-CN"))
+		_, err = c.Locale("acme", "zh-CN")
+		assert.Equal(t, ErrLocalNotFound, err)
+	})
+}
+
+func TestOverlayCache_InvalidateDuringLoad(t *testing.T) {
+	s, _ := newOverlayStore(t)
+
+	var (
+		mu       sync.Mutex
+		title    string
+		blocking chan chan struct{} // Receives the channel to release the next load
+	)
+	setTitle := func(s string) {
+		mu.Lock()
+		defer mu.Unlock()
+		title = s
+	}
+	c := NewOverlayCache(s, 0, func(tenant, lang string) (interface{}, error) {
+		mu.Lock()
+		source := []byte(`title = ` + title)
+		blocked := blocking
+		blocking = nil
+		mu.Unlock()
+
+		if blocked != nil {
+			release := make(chan struct{})
+			blocked <- release
+			<-release
+		}
+		return source, nil
+	})
+
+	for _, invalidate := range []func(){
+		func() { c.Invalidate("acme") },
+		c.Purge,
+	} {
+		c.Purge()
+		setTitle("old")
+		blocked := make(chan chan struct{})
+		mu.Lock()
+		blocking = blocked
+		mu.Unlock()
+
+		done := make(chan *Locale)
+		go func() {
+			l, err := c.Locale("acme", "en-US")
+			assert.Nil(t, err)
+			done <- l
+		}()
+
+		release := <-blocked
+		// The overrides are changed and invalidated while the old ones are being
+		// loaded.
+		setTitle("new")
+		invalidate()
+		close(release)
+		assert.Equal(t, "old", (<-done).Translate("title"))
+
+		// The overlay locale of the old overrides is not cached.
+		l, err := c.Locale("acme", "en-US")
+		require.Nil(t, err)
+		assert.Equal(t, "new", l.Translate("title"))
+	}
+}
+
+func TestOverlayCache_Size(t *testing.T) {
+	s, _ := newOverlayStore(t)
+
+	var loads []string
+	c := NewOverlayCache(s, 2, func(tenant, lang string) (interface{}, error) {
+		loads = append(loads, tenant)
+		return []byte(`title = ` + tenant), nil
+	})
+	for _, tenant := range []string{"acme", "globex", "acme", "initech", "acme", "globex"} {
+		l, err := c.Locale(tenant, "en-US")
+		require.Nil(t, err)
+		assert.Equal(t, tenant, l.Translate("title"))
+	}
+	// The least recently used "globex" is evicted by "initech".
+	assert.Equal(t, []string{"acme", "globex", "initech", "globex"}, loads)
+
+	t.Run("purge", func(t *testing.T) {
+		loads = nil
+		c.Purge()
+		_, err := c.Locale("acme", "en-US")
+		require.Nil(t, err)
+		assert.Equal(t, []string{"acme"}, loads)
+	})
+}
@@ -0,0 +1,206 @@
+// Copyright 2026 Joe Chen. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package i18n
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+	"golang.org/x/text/language"
+
+	"unknwon.dev/i18n/internal/plural"
+)
+
+// PseudoOptions contains options of deriving a pseudo locale.
+type PseudoOptions struct {
+	// Accents replaces ASCII letters with accented ones, e.g. "Ĥéļļö" for "Hello",
+	// to reveal hard-coded strings and missing glyphs.
+	Accents bool
+	// Expansion pads messages by the percentage of their length, e.g. 30 pads
+	// 30% more characters, to reveal layout truncation.
+	Expansion int
+	// Brackets wraps messages with "[" and "]" to reveal truncation and
+	// concatenated messages.
+	Brackets bool
+	// Bidi mirrors words with right-to-left override characters for testing
+	// right-to-left layouts. It is always enabled for the "ar-XB" locale.
+	Bidi bool
+}
+
+// pseudoAccents is the mapping of ASCII letters to the accented ones.
+var pseudoAccents = map[rune]rune{
+	'A': 'Å', 'B': 'Ɓ', 'C': 'Ç', 'D': 'Đ', 'E': 'É', 'F': 'Ƒ', 'G': 'Ĝ', 'H': 'Ĥ', 'I': 'Î',
+	'J': 'Ĵ', 'K': 'Ķ', 'L': 'Ļ', 'M': 'Ṁ', 'N': 'Ñ', 'O': 'Ö', 'P': 'Þ', 'Q': 'Ǫ', 'R': 'Ŕ',
+	'S': 'Š', 'T': 'Ţ', 'U': 'Û', 'V': 'Ṽ', 'W': 'Ŵ', 'X': 'Ẋ', 'Y': 'Ý', 'Z': 'Ž',
+	'a': 'å', 'b': 'ƀ', 'c': 'ç', 'd': 'ð', 'e': 'é', 'f': 'ƒ', 'g': 'ĝ', 'h': 'ĥ', 'i': 'î',
+	'j': 'ĵ', 'k': 'ķ', 'l': 'ļ', 'm': 'ɱ', 'n': 'ñ', 'o': 'ö', 'p': 'þ', 'q': 'ǫ', 'r': 'ŕ',
+	's': 'š', 't': 'ţ', 'u': 'û', 'v': 'ṽ', 'w': 'ŵ', 'x': 'ẋ', 'y': 'ý', 'z': 'ž',
+}
+
+// Each word is wrapped with the right-to-left mark (U+200F), the right-to-left
+// override (U+202E) and the pop directional formatting (U+202C), which displays
+// the word mirrored in a right-to-left context.
+const (
+	bidiWordStart = "\u200F\u202E"
+	bidiWordEnd   = "\u202C\u200F"
+)
+
+// pseudoProtectedRe matches parts of messages that must be kept intact, i.e.
+// format verbs (e.g. "%[1]d" and "%%"), placeholders (e.g. "${1}",
+// "${date:medium, 1}" and "{count}"), tags of rich text (e.g. "<link>") and HTML
+// entities (e.g. "&amp;" and "&#39;").
+var pseudoProtectedRe = regexp.MustCompile(
+	`%(?:%|(?:\[\d+\])?[-+# 0]*(?:\*|\d+)?(?:\.(?:\*|\d+))?(?:\[\d+\])?[a-zA-Z])` +
+		`|\$\{[^}]*\}` +
+		`|\{[a-zA-Z_]\w*\}` +
+		`|</?[a-zA-Z][\w-]*\s*/?>` +
+		`|&(?:[a-zA-Z][a-zA-Z0-9]*|#[0-9]+|#[xX][0-9a-fA-F]+);`,
+)
+
+// pseudolocalize returns the pseudo text of the message. Padding and brackets
+// are only applied when the text is a whole message rather than a plural form.
+func (o PseudoOptions) pseudolocalize(text string, whole bool) string {
+	var buf strings.Builder
+	n := 0 // The number of characters that are pseudolocalized
+	last := 0
+	for _, loc := range pseudoProtectedRe.FindAllStringIndex(text, -1) {
+		n += o.writePseudo(&buf, text[last:loc[0]])
+		buf.WriteString(text[loc[0]:loc[1]])
+		last = loc[1]
+	}
+	n += o.writePseudo(&buf, text[last:])
+
+	if !whole {
+		return buf.String()
+	}
+
+	if o.Expansion > 0 && n > 0 {
+		buf.WriteString(strings.Repeat("~", (n*o.Expansion+99)/100))
+	}
+	if o.Brackets {
+		return "[" + buf.String() + "]"
+	}
+	return buf.String()
+}
+
+// writePseudo writes the pseudo text of the plain text to the buffer and
+// returns the number of characters of the plain text.
+func (o PseudoOptions) writePseudo(buf *strings.Builder, text string) int {
+	if o.Accents {
+		text = strings.Map(func(r rune) rune {
+			if accent, ok := pseudoAccents[r]; ok {
+				return accent
+			}
+			return r
+		}, text)
+	}
+
+	if !o.Bidi {
+		buf.WriteString(text)
+		return utf8.RuneCountInString(text)
+	}
+
+	inWord := false
+	for _, r := range text {
+		if unicode.IsSpace(r) {
+			if inWord {
+				buf.WriteString(bidiWordEnd)
+				inWord = false
+			}
+		} else if !inWord {
+			buf.WriteString(bidiWordStart)
+			inWord = true
+		}
+		buf.WriteRune(r)
+	}
+	if inWord {
+		buf.WriteString(bidiWordEnd)
+	}
+	return utf8.RuneCountInString(text)
+}
+
+// pseudoForms returns the pseudo plural forms.
+func (o PseudoOptions) pseudoForms(forms map[plural.Form]string) map[plural.Form]string {
+	pseudo := make(map[plural.Form]string, len(forms))
+	for form, text := range forms {
+		pseudo[form] = o.pseudolocalize(text, false)
+	}
+	return pseudo
+}
+
+// AddPseudoLocale adds a pseudo locale with given language name (e.g. "en-XA"
+// and "ar-XB") that is derived from the base locale. All messages and plurals
+// of the base locale (including those inherited by an overlay locale) are
+// pseudolocalized with the options, while format verbs, placeholders, tags of
+// rich text and HTML entities are kept intact.
+func (s *Store) AddPseudoLocale(lang string, base *Locale, opts PseudoOptions) (*Locale, error) {
+	tag, err := language.Parse(lang)
+	if err != nil {
+		return nil, errors.Wrap(err, "parse lang")
+	}
+	if tag == language.MustParse("ar-XB") {
+		opts.Bidi = true
+	}
+
+	keys := base.Keys()
+	l := &Locale{
+		tag:        tag,
+		desc:       base.desc + " (Pseudo)",
+		pluralRule: base.pluralRule,
+		cldr:       base.cldr,
+		plurals:    make(map[string]map[plural.Form]string, len(base.plurals)),
+		messages:   make(map[string]*Message, len(keys)),
+		sections:   base.Sections(),
+	}
+	for noun, forms := range base.plurals {
+		l.plurals[noun] = opts.pseudoForms(forms)
+	}
+
+	for _, key := range keys {
+		m, _ := base.message(key)
+		pm := &Message{
+			locale:     l,
+			raw:        opts.pseudolocalize(m.raw, true),
+			pluralRule: m.pluralRule,
+			format:     opts.pseudolocalize(m.format, true),
+			formatters: m.formatters,
+			argc:       m.argc,
+			meta:       m.meta,
+		}
+
+		if m.placeholders != nil {
+			pm.placeholders = make(map[int]*pluralPlaceholder, len(m.placeholders))
+			for index, p := range m.placeholders {
+				pm.placeholders[index] = &pluralPlaceholder{
+					name:    p.name,
+					forms:   opts.pseudoForms(p.forms),
+					compact: p.compact,
+				}
+			}
+		}
+
+		for _, p := range m.named {
+			named := *p
+			if p.plural != nil {
+				named.plural = &pluralPlaceholder{
+					name:    p.plural.name,
+					forms:   opts.pseudoForms(p.plural.forms),
+					compact: p.plural.compact,
+				}
+			}
+			pm.named = append(pm.named, &named)
+		}
+
+		l.messages[key] = pm
+	}
+
+	if !s.add(l) {
+		return nil, errors.Errorf("duplicated locales for %q", lang)
+	}
+	return l, nil
+}
@@ -0,0 +1,137 @@
+// Copyright 2026 Joe Chen. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package i18n
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStore_AddPseudoLocale(t *testing.T) {
+	s := NewStore()
+	base, err := s.AddLocale(
+		"en-US",
+		"English",
+		[]byte(`
+[plurals]
+file.one = file
+file.other = files
+
+[messages]
+hello = Hello
+verbs = %-8s has %[2]d%% of %.2f
+plural = I have %[1]d ${file, 1}
+named = {user} has {count} ${file, count}
+format = Created ${date:medium, 1}
+rich = Read the <link>terms</link><br/>
+entity = Terms &amp; conditions&#39;
+`),
+	)
+	assert.Nil(t, err)
+
+	t.Run("en-XA", func(t *testing.T) {
+		l, err := s.AddPseudoLocale("en-XA", base, PseudoOptions{
+			Accents:   true,
+			Expansion: 40,
+			Brackets:  true,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, "en-XA", l.Lang())
+		assert.Equal(t, "English (Pseudo)", l.Description())
+		assert.Equal(t, "ltr", l.Dir())
+
+		tests := []struct {
+			name string
+			key  string
+			args []interface{}
+			want string
+		}{
+			{
+				name: "accents",
+				key:  "messages::hello",
+				want: "[Ĥéļļö~~]",
+			},
+			{
+				name: "verbs",
+				key:  "messages::verbs",
+				want: "[%-8s ĥåš %[2]d%% öƒ %.2f~~~~]",
+			},
+			{
+				name: "verbs with args",
+				key:  "messages::verbs",
+				args: []interface{}{"Joe", 10, 1.5},
+				want: "[Joe      ĥåš 10% öƒ 1.50~~~~]",
+			},
+			{
+				name: "plural",
+				key:  "messages::plural",
+				args: []interface{}{2},
+				want: "[Î ĥåṽé 2 ƒîļéš~~~~]",
+			},
+			{
+				name: "format",
+				key:  "messages::format",
+				args: []interface{}{time.Date(2021, time.March, 4, 5, 6, 7, 0, time.UTC)},
+				want: "[Çŕéåţéð Mar 4, 2021~~~~]",
+			},
+			{
+				name: "rich",
+				key:  "messages::rich",
+				want: "[Ŕéåð ţĥé <link>ţéŕɱš</link><br/>~~~~~~]",
+			},
+			{
+				name: "entity",
+				key:  "messages::entity",
+				want: "[Ţéŕɱš &amp; çöñðîţîöñš&#39;~~~~~~~]",
+			},
+		}
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				assert.Equal(t, test.want, l.Translate(test.key, test.args...))
+			})
+		}
+
+		got := l.TranslateNamed("messages::named", map[string]interface{}{"user": "Joe", "count": 1})
+		assert.Equal(t, "[Joe ĥåš 1 ƒîļé~~~]", got)
+		assert.Equal(t, "ƒîļéš", l.Plural("file", 2))
+
+		// The base locale is not modified.
+		assert.Equal(t, "I have 2 files", base.Translate("messages::plural", 2))
+	})
+
+	t.Run("ar-XB", func(t *testing.T) {
+		// Bidi is always enabled for "ar-XB".
+		l, err := s.AddPseudoLocale("ar-XB", base, PseudoOptions{})
+		assert.Nil(t, err)
+		assert.Equal(t, "rtl", l.Dir())
+
+		want := "\u200F\u202EI\u202C\u200F \u200F\u202Ehave\u202C\u200F 2 \u200F\u202Efiles\u202C\u200F"
+		assert.Equal(t, want, l.Translate("messages::plural", 2))
+	})
+
+	t.Run("overlay", func(t *testing.T) {
+		overlay, err := s.Overlay(base, []byte(`
+[messages]
+hello = Howdy
+`))
+		assert.Nil(t, err)
+
+		l, err := s.AddPseudoLocale("en-XO", overlay, PseudoOptions{Accents: true})
+		assert.Nil(t, err)
+		assert.Equal(t, "Ĥöŵðý", l.Translate("messages::hello"))
+		assert.Equal(t, "Î ĥåṽé 2 ƒîļéš", l.Translate("messages::plural", 2))
+		assert.Equal(t, overlay.Keys(), l.Keys())
+	})
+
+	t.Run("duplicated locales", func(t *testing.T) {
+		_, err := s.AddPseudoLocale("en-XA", base, PseudoOptions{})
+		got := fmt.Sprintf("%v", err)
+		want := `duplicated locales for "en-XA"`
+		assert.Equal(t, want, got)
+	})
+}
@@ -0,0 +1,99 @@
+// Copyright 2026 Joe Chen. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package i18n
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"unknwon.dev/i18n/internal/plural"
+)
+
+// relativeTimeUnits is the list of units to use for relative time formatting,
+// from the largest to the smallest.
+var relativeTimeUnits = []struct {
+	name     string
+	duration time.Duration
+}{
+	{"year", 365 * 24 * time.Hour},
+	{"month", 30 * 24 * time.Hour},
+	{"week", 7 * 24 * time.Hour},
+	{"day", 24 * time.Hour},
+	{"hour", time.Hour},
+	{"minute", time.Minute},
+	{"second", time.Second},
+}
+
+// FormatRelativeTime formats the duration relative to now with the CLDR data of
+// the locale, e.g. "3 hours ago" for -3*time.Hour and "in 2 days" for
+// 48*time.Hour. The duration is truncated to the largest unit that fits, from
+// years (365 days) down to seconds.
+//
+// The style is the width of "long", "short" or "narrow", optionally followed by
+// the numeric mode of ":always" (the default) or ":auto", e.g. "short:auto".
+// The "auto" mode uses special wording like "yesterday" and "tomorrow" whenever
+// available, the same as the Intl.RelativeTimeFormat in JavaScript.
+func (l *Locale) FormatRelativeTime(d time.Duration, style string) string {
+	width, numeric := style, "always"
+	if i := strings.IndexByte(style, ':'); i >= 0 {
+		width, numeric = style[:i], style[i+1:]
+	}
+	switch width {
+	case "long", "short", "narrow":
+	default:
+		return fmt.Sprintf("<no such relative time style: %s>", style)
+	}
+	switch numeric {
+	case "always", "auto":
+	default:
+		return fmt.Sprintf("<no such relative time style: %s>", style)
+	}
+
+	unit := relativeTimeUnits[len(relativeTimeUnits)-1]
+	for _, u := range relativeTimeUnits {
+		if d >= u.duration || d <= -u.duration {
+			unit = u
+			break
+		}
+	}
+	value := int64(d / unit.duration)
+
+	rt := l.cldr.RelativeTime(unit.name, width)
+	if rt == nil {
+		return fmt.Sprintf("<no such relative time unit: %s>", unit.name)
+	}
+	if numeric == "auto" && -2 <= value && value <= 2 {
+		if s, ok := rt.Relative[int(value)]; ok {
+			return s
+		}
+	}
+
+	patterns := rt.Future
+	if d < 0 {
+		patterns = rt.Past
+		value = -value
+	}
+	form := l.pluralForm(&plural.Operands{N: float64(value), I: value})
+	pattern, ok := patterns[form]
+	if !ok {
+		pattern = patterns[plural.Other]
+	}
+	return strings.Replace(pattern, "{0}", l.cldr.Numbers.Format(strconv.FormatInt(value, 10)), 1)
+}
+
+// formatRelativeTimeArg formats a time.Duration or a time.Time argument
+// relative to now.
+func formatRelativeTimeArg(l *Locale, style string, arg interface{}, _ []interface{}) string {
+	switch v := arg.(type) {
+	case time.Duration:
+		return l.FormatRelativeTime(v, style)
+	case time.Time:
+		// Round off the time passed since the message is being translated.
+		return l.FormatRelativeTime(time.Until(v).Round(time.Second), style)
+	}
+	return fmt.Sprintf("<invalid type %T; expected time.Duration or time.Time>", arg)
+}
@@ -0,0 +1,93 @@
+// Copyright 2026 Joe Chen. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package i18n
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLocale_FormatRelativeTime(t *testing.T) {
+	s := NewStore()
+	en, err := s.AddLocale("en-US", "English", []byte(``))
+	assert.Nil(t, err)
+	de, err := s.AddLocale("de-DE", "Deutsch", []byte(``))
+	assert.Nil(t, err)
+	ru, err := s.AddLocale("ru-RU", "Русский", []byte(``))
+	assert.Nil(t, err)
+
+	const day = 24 * time.Hour
+	tests := []struct {
+		name   string
+		locale *Locale
+		d      time.Duration
+		style  string
+		want   string
+	}{
+		{name: "past", locale: en, d: -3 * time.Hour, style: "long", want: "3 hours ago"},
+		{name: "future", locale: en, d: 2 * day, style: "long", want: "in 2 days"},
+		{name: "singular", locale: en, d: -time.Minute, style: "long", want: "1 minute ago"},
+		{name: "truncated", locale: en, d: -(time.Hour + 59*time.Minute), style: "long", want: "1 hour ago"},
+		{name: "weeks", locale: en, d: 15 * day, style: "long", want: "in 2 weeks"},
+		{name: "years", locale: en, d: -2000 * day, style: "long", want: "5 years ago"},
+		{name: "short", locale: en, d: -3 * time.Hour, style: "short", want: "3 hr. ago"},
+		{name: "always", locale: en, d: -day, style: "long:always", want: "1 day ago"},
+		{name: "yesterday", locale: en, d: -day, style: "long:auto", want: "yesterday"},
+		{name: "tomorrow", locale: en, d: day, style: "short:auto", want: "tomorrow"},
+		{name: "now", locale: en, d: 0, style: "long:auto", want: "now"},
+		{name: "no special wording", locale: en, d: -3 * day, style: "long:auto", want: "3 days ago"},
+		{name: "de day before yesterday", locale: de, d: -2 * day, style: "long:auto", want: "vorgestern"},
+		{name: "de future", locale: de, d: 3 * day, style: "long", want: "in 3 Tagen"},
+		{name: "ru few", locale: ru, d: -3 * time.Hour, style: "long", want: "3 часа назад"},
+		{name: "ru many", locale: ru, d: -5 * time.Hour, style: "long", want: "5 часов назад"},
+		{name: "ru one", locale: ru, d: 21 * time.Minute, style: "long", want: "через 21 минуту"},
+		{name: "bad width", locale: en, d: day, style: "wide", want: "<no such relative time style: wide>"},
+		{name: "bad numeric", locale: en, d: day, style: "long:never", want: "<no such relative time style: long:never>"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := test.locale.FormatRelativeTime(test.d, test.style)
+			assert.Equal(t, test.want, got)
+		})
+	}
+}
+
+func TestLocale_Translate_RelativeTime(t *testing.T) {
+	l, err := NewStore().AddLocale("en-US", "English", []byte(`
+[messages]
+pushed = %s pushed ${relative:long:auto, 2}
+`))
+	assert.Nil(t, err)
+
+	tests := []struct {
+		name string
+		args []interface{}
+		want string
+	}{
+		{
+			name: "duration",
+			args: []interface{}{"Joe", -3 * time.Hour},
+			want: "Joe pushed 3 hours ago",
+		},
+		{
+			name: "time",
+			args: []interface{}{"Joe", time.Now().Add(-24 * time.Hour)},
+			want: "Joe pushed yesterday",
+		},
+		{
+			name: "invalid type",
+			args: []interface{}{"Joe", 3},
+			want: "Joe pushed <invalid type int; expected time.Duration or time.Time>",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := l.Translate("messages::pushed", test.args...)
+			assert.Equal(t, test.want, got)
+		})
+	}
+}
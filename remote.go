@@ -0,0 +1,412 @@
+// Copyright 2026 Joe Chen. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package i18n
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/text/language"
+	"gopkg.in/ini.v1"
+)
+
+// RemoteOptions contains options of a RemoteSource.
+type RemoteOptions struct {
+	// The URL of bundles, where "{lang}" is replaced by the language name, e.g.
+	// "https://cdn.example.com/i18n/locale_{lang}.ini".
+	URL string
+	// The format of bundles, either "ini" or "json" (see RemoteSource). Default
+	// is "json" if the path of the URL ends with ".json", and "ini" otherwise.
+	Format string
+	// The HTTP client to fetch bundles. Default is a client that times out after
+	// 30 seconds.
+	Client *http.Client
+	// The maximum size of bundles in bytes, larger bundles are rejected. Default
+	// is 10 MiB.
+	MaxSize int64
+	// The path of the file to keep the last good bundle, where "{lang}" is
+	// replaced by the language name. The file is used when the bundle can't be
+	// fetched on the first load. Bundles are not kept on disk if empty.
+	CacheFile string
+	// The URL of the hex-encoded SHA-256 checksum of the bundle, where "{lang}"
+	// is replaced by the language name, e.g. the output of "sha256sum". The
+	// checksum is not verified if empty.
+	ChecksumURL string
+	// Verify is called to verify the bundle fetched with the response header,
+	// e.g. checking the signature of the bundle. The bundle is rejected if an
+	// error is returned.
+	Verify func(lang string, bundle []byte, header http.Header) error
+}
+
+// RemoteSource is a Source that fetches bundles of locales over HTTP, which
+// allows shipping changes of translations without redeploying, e.g.
+//
+//	src := i18n.NewRemoteSource(i18n.RemoteOptions{
+//		URL:       "https://cdn.example.com/i18n/locale_{lang}.ini",
+//		CacheFile: "data/i18n/locale_{lang}.ini",
+//	})
+//	_, err := s.AddLocale("en-US", "English", "locale_en-US.ini", src)
+//	...
+//	go s.PollLocale(ctx, "en-US", 5*time.Minute, onError, "locale_en-US.ini", src)
+//
+// Bundles are fetched with conditional requests (i.e. "If-None-Match" and
+// "If-Modified-Since"), and verified before being used. A bundle is only used
+// and kept on disk if it is a valid locale. The last good bundle stays in use
+// when a fetch fails.
+//
+// Bundles in INI are in the same format as locale files, but comments are not
+// kept. Bundles in JSON have keys of the default section at the top level, and
+// other sections as nested objects, keys start with "@" are ignored, e.g.
+//
+//	{
+//	  "title": "Welcome",
+//	  "plurals": {"file.one": "file", "file.other": "files"},
+//	  "messages": {"hello": "Hello, %s!"}
+//	}
+type RemoteSource struct {
+	opts RemoteOptions
+
+	mu      sync.Mutex               // Guards bundles, but not fetching
+	bundles map[string]*remoteBundle // Keyed by language names
+}
+
+// remoteBundle is a verified bundle of a language.
+type remoteBundle struct {
+	entries      []SourceEntry
+	etag         string
+	lastModified string
+}
+
+// NewRemoteSource returns a new Source that fetches bundles of locales over
+// HTTP with the options.
+func NewRemoteSource(opts RemoteOptions) *RemoteSource {
+	if opts.Format == "" {
+		opts.Format = "ini"
+		if path.Ext(strings.SplitN(opts.URL, "?", 2)[0]) == ".json" {
+			opts.Format = "json"
+		}
+	}
+	if opts.Client == nil {
+		opts.Client = &http.Client{Timeout: 30 * time.Second}
+	}
+	if opts.MaxSize <= 0 {
+		opts.MaxSize = 10 << 20
+	}
+	return &RemoteSource{
+		opts:    opts,
+		bundles: make(map[string]*remoteBundle),
+	}
+}
+
+// Entries fetches the bundle of the language and returns its entries. It falls
+// back to the bundle kept on disk when the bundle can't be fetched on the first
+// load.
+func (s *RemoteSource) Entries(lang string) ([]SourceEntry, error) {
+	s.mu.Lock()
+	last := s.bundles[lang]
+	s.mu.Unlock()
+
+	b, err := s.fetch(lang, last)
+	if err == nil {
+		s.setBundle(lang, b)
+		return b.entries, nil
+	} else if last != nil || s.opts.CacheFile == "" {
+		return nil, err
+	}
+
+	data, rerr := ioutil.ReadFile(expandLang(s.opts.CacheFile, lang))
+	if rerr != nil {
+		return nil, err
+	}
+	entries, rerr := s.parse(lang, data)
+	if rerr != nil {
+		return nil, err
+	}
+
+	// The bundle on disk has no validators, so that the next fetch gets the
+	// latest bundle.
+	s.setBundle(lang, &remoteBundle{entries: entries})
+	return entries, nil
+}
+
+// setBundle sets the verified bundle of the language.
+func (s *RemoteSource) setBundle(lang string, b *remoteBundle) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.bundles[lang] = b
+}
+
+// fetch fetches the bundle of the language. The last bundle is returned as-is
+// if it has not been modified.
+func (s *RemoteSource) fetch(lang string, last *remoteBundle) (*remoteBundle, error) {
+	req, err := http.NewRequest(http.MethodGet, expandLang(s.opts.URL, lang), nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "new request")
+	}
+	if last != nil {
+		if last.etag != "" {
+			req.Header.Set("If-None-Match", last.etag)
+		}
+		if last.lastModified != "" {
+			req.Header.Set("If-Modified-Since", last.lastModified)
+		}
+	}
+
+	resp, err := s.opts.Client.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "fetch bundle")
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == http.StatusNotModified && last != nil {
+		return last, nil
+	} else if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("fetch bundle: unexpected status %s", resp.Status)
+	}
+
+	// Read one more byte to tell whether the bundle exceeds the maximum size.
+	data, err := ioutil.ReadAll(io.LimitReader(resp.Body, s.opts.MaxSize+1))
+	if err != nil {
+		return nil, errors.Wrap(err, "read bundle")
+	} else if int64(len(data)) > s.opts.MaxSize {
+		return nil, errors.Errorf("read bundle: exceeds the maximum size of %d bytes", s.opts.MaxSize)
+	}
+
+	if s.opts.ChecksumURL != "" {
+		err = s.verifyChecksum(lang, data)
+		if err != nil {
+			return nil, errors.Wrap(err, "verify checksum")
+		}
+	}
+	if s.opts.Verify != nil {
+		err = s.opts.Verify(lang, data, resp.Header)
+		if err != nil {
+			return nil, errors.Wrap(err, "verify bundle")
+		}
+	}
+
+	entries, err := s.parse(lang, data)
+	if err != nil {
+		return nil, errors.Wrap(err, "parse bundle")
+	}
+
+	if s.opts.CacheFile != "" {
+		err = writeFileAtomic(expandLang(s.opts.CacheFile, lang), data)
+		if err != nil {
+			return nil, errors.Wrap(err, "write cache file")
+		}
+	}
+	return &remoteBundle{
+		entries:      entries,
+		etag:         resp.Header.Get("ETag"),
+		lastModified: resp.Header.Get("Last-Modified"),
+	}, nil
+}
+
+// verifyChecksum fetches the checksum of the bundle of the language, and
+// compares it with the SHA-256 checksum of the data.
+func (s *RemoteSource) verifyChecksum(lang string, data []byte) error {
+	resp, err := s.opts.Client.Get(expandLang(s.opts.ChecksumURL, lang))
+	if err != nil {
+		return errors.Wrap(err, "fetch")
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return errors.Errorf("fetch: unexpected status %s", resp.Status)
+	}
+
+	body, err := ioutil.ReadAll(io.LimitReader(resp.Body, 1024))
+	if err != nil {
+		return errors.Wrap(err, "read")
+	}
+
+	// The checksum may be followed by the file name, e.g. the output of
+	// "sha256sum".
+	fields := strings.Fields(string(body))
+	if len(fields) == 0 {
+		return errors.New("empty checksum")
+	}
+
+	sum := sha256.Sum256(data)
+	if !strings.EqualFold(fields[0], hex.EncodeToString(sum[:])) {
+		return errors.Errorf("mismatched checksum %q", fields[0])
+	}
+	return nil
+}
+
+// parse parses the bundle of the language, and verifies that it is a valid
+// locale.
+func (s *RemoteSource) parse(lang string, data []byte) ([]SourceEntry, error) {
+	var entries []SourceEntry
+	var err error
+	switch s.opts.Format {
+	case "ini":
+		entries, err = parseINIBundle(data)
+	case "json":
+		entries, err = parseJSONBundle(data)
+	default:
+		return nil, errors.Errorf("unsupported format %q", s.opts.Format)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	file := ini.Empty()
+	err = addEntries(file, entries)
+	if err != nil {
+		return nil, err
+	}
+	tag, _ := language.Parse(lang)
+	_, err = newLocale(tag, "", nil, file)
+	if err != nil {
+		return nil, errors.Wrap(err, "new locale")
+	}
+	return entries, nil
+}
+
+// parseINIBundle returns entries of the bundle in INI.
+func parseINIBundle(data []byte) ([]SourceEntry, error) {
+	file, err := loadFile("", data)
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []SourceEntry
+	for _, s := range file.Sections() {
+		section := s.Name()
+		if section == ini.DefaultSection {
+			section = ""
+		}
+		for _, k := range s.Keys() {
+			entries = append(entries, SourceEntry{
+				Section: section,
+				Key:     k.Name(),
+				Value:   k.Value(),
+			})
+		}
+	}
+	return entries, nil
+}
+
+// parseJSONBundle returns entries of the bundle in JSON in the order of keys.
+func parseJSONBundle(data []byte) ([]SourceEntry, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+
+	var entries []SourceEntry
+	err := decodeJSONObject(dec, func(name string) error {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+
+		switch v := tok.(type) {
+		case string:
+			entries = append(entries, SourceEntry{Key: name, Value: v})
+			return nil
+		case json.Delim:
+			if v != '{' {
+				break
+			}
+
+			// The opening delimiter of the section has been consumed.
+			return decodeJSONMembers(dec, func(key string) error {
+				var value string
+				err := dec.Decode(&value)
+				if err != nil {
+					return errors.Wrapf(err, "decode %q of section %q", key, name)
+				}
+				entries = append(entries, SourceEntry{Section: name, Key: key, Value: value})
+				return nil
+			})
+		}
+		return errors.Errorf("unexpected value of %q: %v", name, tok)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// decodeJSONObject decodes an object from the decoder, and calls the decode
+// function with the name of each member to decode its value. Members with names
+// start with "@" are skipped.
+func decodeJSONObject(dec *json.Decoder, decode func(name string) error) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if tok != json.Delim('{') {
+		return errors.Errorf("expect an object but got %v", tok)
+	}
+	return decodeJSONMembers(dec, decode)
+}
+
+// decodeJSONMembers decodes members of an object whose opening delimiter has
+// been consumed, see decodeJSONObject.
+func decodeJSONMembers(dec *json.Decoder, decode func(name string) error) error {
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		name := tok.(string)
+
+		if strings.HasPrefix(name, "@") {
+			var skipped json.RawMessage
+			err = dec.Decode(&skipped)
+		} else {
+			err = decode(name)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err := dec.Token() // The closing delimiter
+	return err
+}
+
+// expandLang replaces "{lang}" in the string with the language name.
+func expandLang(s, lang string) string {
+	return strings.Replace(s, "{lang}", lang, -1)
+}
+
+// writeFileAtomic writes data to the file through a temporary file in the same
+// directory, so that the file is never partially written.
+func writeFileAtomic(name string, data []byte) error {
+	dir := filepath.Dir(name)
+	err := os.MkdirAll(dir, 0755)
+	if err != nil {
+		return err
+	}
+
+	f, err := ioutil.TempFile(dir, filepath.Base(name)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer func() { _ = os.Remove(f.Name()) }()
+
+	_, err = f.Write(data)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), name)
+}
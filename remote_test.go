@@ -0,0 +1,318 @@
+// Copyright 2026 Joe Chen. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package i18n
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// bundleServer serves bundles of locales with ETags and checksums.
+type bundleServer struct {
+	*httptest.Server
+
+	mu          sync.Mutex
+	bundles     map[string]string // Keyed by paths
+	checksums   map[string]string // Keyed by paths, overrides computed ones
+	requests    int
+	notModified int
+}
+
+func newBundleServer(t *testing.T, bundles map[string]string) *bundleServer {
+	s := &bundleServer{
+		bundles:   bundles,
+		checksums: make(map[string]string),
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serve))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *bundleServer) serve(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if path := r.URL.Path; filepath.Ext(path) == ".sha256" {
+		bundle, ok := s.bundles[path[:len(path)-len(".sha256")]]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		sum, ok := s.checksums[path]
+		if !ok {
+			h := sha256.Sum256([]byte(bundle))
+			sum = hex.EncodeToString(h[:])
+		}
+		_, _ = fmt.Fprintf(w, "%s  %s\n", sum, filepath.Base(path))
+		return
+	}
+
+	s.requests++
+	bundle, ok := s.bundles[r.URL.Path]
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+
+	h := sha256.Sum256([]byte(bundle))
+	etag := `"` + hex.EncodeToString(h[:8]) + `"`
+	if r.Header.Get("If-None-Match") == etag {
+		s.notModified++
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+	w.Header().Set("ETag", etag)
+	_, _ = w.Write([]byte(bundle))
+}
+
+func (s *bundleServer) set(path, bundle string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.bundles[path] = bundle
+}
+
+func TestRemoteSource(t *testing.T) {
+	srv := newBundleServer(t, map[string]string{
+		"/locale_en-US.ini": `
+title = Issue tracker
+
+[plurals]
+issue.one = issue
+issue.other = issues
+
+[messages]
+issues = %d open ${issue, 1}
+`,
+		"/locale_zh-CN.json": `{
+  "@@locale": "zh-CN",
+  "title": "问题追踪",
+  "@title": {"description": "The title"},
+  "plurals": {"issue.other": "个问题"},
+  "messages": {"issues": "%d ${issue, 1}"}
+}`,
+	})
+
+	t.Run("INI", func(t *testing.T) {
+		src := NewRemoteSource(RemoteOptions{URL: srv.URL + "/locale_{lang}.ini"})
+		l, err := NewStore().AddLocale("en-US", "English", src)
+		require.Nil(t, err)
+		assert.Equal(t, "Issue tracker", l.Translate("title"))
+		assert.Equal(t, "2 open issues", l.Translate("messages::issues", 2))
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		src := NewRemoteSource(RemoteOptions{URL: srv.URL + "/locale_{lang}.json"})
+		l, err := NewStore().AddLocale("zh-CN", "简体中文", src)
+		require.Nil(t, err)
+		assert.Equal(t, "问题追踪", l.Translate("title"))
+		assert.Equal(t, "2 个问题", l.Translate("messages::issues", 2))
+		assert.Equal(t, []string{"DEFAULT", "messages"}, l.Sections())
+	})
+
+	t.Run("not modified", func(t *testing.T) {
+		src := NewRemoteSource(RemoteOptions{URL: srv.URL + "/locale_{lang}.ini"})
+		want, err := src.Entries("en-US")
+		require.Nil(t, err)
+
+		srv.mu.Lock()
+		srv.notModified = 0
+		srv.mu.Unlock()
+
+		got, err := src.Entries("en-US")
+		require.Nil(t, err)
+		assert.Equal(t, want, got)
+		assert.Equal(t, 1, srv.notModified)
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		src := NewRemoteSource(RemoteOptions{URL: srv.URL + "/locale_{lang}.ini"})
+		_, err := src.Entries("fr-FR")
+		assert.Equal(t, "fetch bundle: unexpected status 404 Not Found", fmt.Sprintf("%v", err))
+
+		srv.set("/bad_en-US.ini", "title = %d ${issue, 0}")
+		src = NewRemoteSource(RemoteOptions{URL: srv.URL + "/bad_{lang}.ini"})
+		_, err = src.Entries("en-US")
+		assert.Equal(t, `parse bundle: new locale: the smallest index is 1 but got 0 for "${issue, 0}"`, fmt.Sprintf("%v", err))
+
+		srv.set("/bad_en-US.json", `{"messages": ["hello"]}`)
+		src = NewRemoteSource(RemoteOptions{URL: srv.URL + "/bad_{lang}.json"})
+		_, err = src.Entries("en-US")
+		assert.Equal(t, `parse bundle: unexpected value of "messages": [`, fmt.Sprintf("%v", err))
+
+		src = NewRemoteSource(RemoteOptions{URL: srv.URL + "/locale_{lang}.ini", MaxSize: 16})
+		_, err = src.Entries("en-US")
+		assert.Equal(t, "read bundle: exceeds the maximum size of 16 bytes", fmt.Sprintf("%v", err))
+	})
+}
+
+func TestRemoteSource_Timeout(t *testing.T) {
+	assert.NotZero(t, NewRemoteSource(RemoteOptions{}).opts.Client.Timeout)
+
+	srv := newBundleServer(t, map[string]string{
+		"/locale_en-US.ini": "title = Issue tracker",
+	})
+	hang := make(chan struct{})
+	hanging := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/locale_zh-CN.ini" {
+			<-hang
+			return
+		}
+		srv.Config.Handler.ServeHTTP(w, r)
+	}))
+	defer hanging.Close()
+	defer close(hang)
+
+	src := NewRemoteSource(RemoteOptions{
+		URL:    hanging.URL + "/locale_{lang}.ini",
+		Client: &http.Client{Timeout: 500 * time.Millisecond},
+	})
+	errs := make(chan error)
+	go func() {
+		_, err := src.Entries("zh-CN")
+		errs <- err
+	}()
+
+	// Fetching other languages is not blocked by the hanging one.
+	entries, err := src.Entries("en-US")
+	require.Nil(t, err)
+	assert.Equal(t, []SourceEntry{{Key: "title", Value: "Issue tracker"}}, entries)
+	select {
+	case err = <-errs:
+		t.Fatalf("fetched after the hanging one timed out: %v", err)
+	default:
+	}
+
+	err = <-errs
+	assert.Contains(t, fmt.Sprintf("%v", err), "fetch bundle: ")
+	assert.Contains(t, fmt.Sprintf("%v", err), "Client.Timeout exceeded")
+}
+
+func TestRemoteSource_Verify(t *testing.T) {
+	srv := newBundleServer(t, map[string]string{
+		"/locale_en-US.ini": "title = Issue tracker",
+	})
+
+	t.Run("checksum", func(t *testing.T) {
+		src := NewRemoteSource(RemoteOptions{
+			URL:         srv.URL + "/locale_{lang}.ini",
+			ChecksumURL: srv.URL + "/locale_{lang}.ini.sha256",
+		})
+		_, err := src.Entries("en-US")
+		require.Nil(t, err)
+
+		srv.mu.Lock()
+		srv.checksums["/locale_en-US.ini.sha256"] = "deadbeef"
+		srv.mu.Unlock()
+
+		src = NewRemoteSource(RemoteOptions{
+			URL:         srv.URL + "/locale_{lang}.ini",
+			ChecksumURL: srv.URL + "/locale_{lang}.ini.sha256",
+		})
+		_, err = src.Entries("en-US")
+		assert.Equal(t, `verify checksum: mismatched checksum "deadbeef"`, fmt.Sprintf("%v", err))
+	})
+
+	t.Run("signature", func(t *testing.T) {
+		src := NewRemoteSource(RemoteOptions{
+			URL: srv.URL + "/locale_{lang}.ini",
+			Verify: func(lang string, bundle []byte, header http.Header) error {
+				assert.Equal(t, "en-US", lang)
+				assert.Equal(t, "title = Issue tracker", string(bundle))
+				assert.NotEmpty(t, header.Get("ETag"))
+				return fmt.Errorf("bad signature")
+			},
+		})
+		_, err := src.Entries("en-US")
+		assert.Equal(t, "verify bundle: bad signature", fmt.Sprintf("%v", err))
+	})
+}
+
+func TestRemoteSource_CacheFile(t *testing.T) {
+	srv := newBundleServer(t, map[string]string{
+		"/locale_en-US.ini": "title = Issue tracker",
+	})
+	cacheFile := filepath.Join(t.TempDir(), "i18n", "locale_{lang}.ini")
+
+	src := NewRemoteSource(RemoteOptions{
+		URL:       srv.URL + "/locale_{lang}.ini",
+		CacheFile: cacheFile,
+	})
+	_, err := src.Entries("en-US")
+	require.Nil(t, err)
+
+	data, err := ioutil.ReadFile(expandLang(cacheFile, "en-US"))
+	require.Nil(t, err)
+	assert.Equal(t, "title = Issue tracker", string(data))
+
+	// Bad bundles are not kept on disk
+	srv.set("/locale_en-US.ini", "title = %d ${issue, 0}")
+	_, err = src.Entries("en-US")
+	assert.NotNil(t, err)
+	data, err = ioutil.ReadFile(expandLang(cacheFile, "en-US"))
+	require.Nil(t, err)
+	assert.Equal(t, "title = Issue tracker", string(data))
+
+	t.Run("server is down", func(t *testing.T) {
+		src := NewRemoteSource(RemoteOptions{
+			URL:       srv.URL + "/missing_{lang}.ini",
+			CacheFile: cacheFile,
+		})
+		l, err := NewStore().AddLocale("en-US", "English", src)
+		require.Nil(t, err)
+		assert.Equal(t, "Issue tracker", l.Translate("title"))
+
+		// Later failures are reported
+		_, err = src.Entries("en-US")
+		assert.Equal(t, "fetch bundle: unexpected status 404 Not Found", fmt.Sprintf("%v", err))
+	})
+
+	t.Run("no cache file", func(t *testing.T) {
+		src := NewRemoteSource(RemoteOptions{
+			URL:       srv.URL + "/missing_{lang}.ini",
+			CacheFile: cacheFile,
+		})
+		_, err := src.Entries("zh-CN")
+		assert.Equal(t, "fetch bundle: unexpected status 404 Not Found", fmt.Sprintf("%v", err))
+	})
+}
+
+func TestRemoteSource_PollLocale(t *testing.T) {
+	srv := newBundleServer(t, map[string]string{
+		"/locale_en-US.ini": "hello = Hello",
+	})
+	src := NewRemoteSource(RemoteOptions{URL: srv.URL + "/locale_{lang}.ini"})
+
+	s := NewStore()
+	_, err := s.AddLocale("en-US", "English", src)
+	require.Nil(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go s.PollLocale(ctx, "en-US", time.Millisecond, nil, src)
+
+	// Wait for polling to start with the current bundle.
+	assert.Eventually(t, func() bool {
+		srv.mu.Lock()
+		defer srv.mu.Unlock()
+		return srv.requests > 1
+	}, time.Second, time.Millisecond)
+	srv.set("/locale_en-US.ini", "hello = Howdy")
+	assert.Eventually(t, func() bool {
+		l, err := s.Locale("en-US")
+		return err == nil && l.Translate("hello") == "Howdy"
+	}, time.Second, time.Millisecond)
+}
@@ -0,0 +1,153 @@
+// Copyright 2026 Joe Chen. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package i18n
+
+import (
+	"bytes"
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/text/language"
+	"gopkg.in/ini.v1"
+)
+
+// Source is a source of messages and plurals other than INI data sources, e.g.
+// a database, which can be passed to Store.AddLocale along with INI data
+// sources.
+type Source interface {
+	// Entries returns all entries of the language, sections of messages are in
+	// the order of their first entries.
+	Entries(lang string) ([]SourceEntry, error)
+}
+
+// SourceEntry is an entry of a message or a plural form.
+type SourceEntry struct {
+	// The section of the message, empty for the default section.
+	Section string
+	// The key of the message, or the noun of the plural form.
+	Key string
+	// The plural form of the noun, e.g. "one" and "other". It is empty for
+	// messages.
+	Form string
+	// The text of the message or the plural form.
+	Value string
+}
+
+// addSourceEntries adds entries of the language from the source to the locale
+// file, entries overwrite existing keys.
+func addSourceEntries(file *ini.File, lang string, src Source) error {
+	entries, err := src.Entries(lang)
+	if err != nil {
+		return errors.Wrap(err, "load entries")
+	}
+	return addEntries(file, entries)
+}
+
+// addEntries adds entries to the locale file, entries overwrite existing keys.
+func addEntries(file *ini.File, entries []SourceEntry) error {
+	for _, e := range entries {
+		section, key := e.Section, e.Key
+		if e.Form != "" {
+			section, key = pluralsSection, e.Key+"."+e.Form
+		} else if section == "" {
+			section = ini.DefaultSection
+		}
+
+		_, err := file.Section(section).NewKey(key, e.Value)
+		if err != nil {
+			return errors.Wrapf(err, "add entry %q of section %q", key, section)
+		}
+	}
+	return nil
+}
+
+// PollLocale reloads the locale with the given language name from the list of
+// sources at every interval, and replaces the locale in the store when any of
+// messages and plurals has changed since polling starts, until the context is
+// done. Errors are reported to the onError (if not nil) and the locale in the
+// store is left unchanged, e.g.
+//
+//	src := i18n.NewSQLSource(db, "")
+//	_, err := s.AddLocale("en-US", "English", "locale_en-US.ini", src)
+//	...
+//	go s.PollLocale(ctx, "en-US", time.Minute, onError, "locale_en-US.ini", src)
+func (s *Store) PollLocale(ctx context.Context, lang string, interval time.Duration, onError func(error), source interface{}, others ...interface{}) {
+	// The content at startup, so that the locale is not replaced until any of
+	// sources has changed.
+	_, last, err := localeContent(lang, source, others...)
+	if err != nil && onError != nil {
+		onError(errors.Wrapf(err, "load locale %q", lang))
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		current, err := s.reloadLocale(lang, last, source, others...)
+		if err != nil {
+			if onError != nil {
+				onError(errors.Wrapf(err, "reload locale %q", lang))
+			}
+			continue
+		}
+		last = current
+	}
+}
+
+// reloadLocale reloads the locale with the given language name from the list of
+// sources, and replaces the locale in the store if the content of the locale
+// file is different from the last one. It returns the content of the locale
+// file.
+func (s *Store) reloadLocale(lang string, last []byte, source interface{}, others ...interface{}) ([]byte, error) {
+	tag, err := language.Parse(lang)
+	if err != nil {
+		return nil, errors.Wrap(err, "parse lang")
+	}
+
+	file, content, err := localeContent(lang, source, others...)
+	if err != nil {
+		return nil, err
+	}
+	if last != nil && bytes.Equal(content, last) {
+		return last, nil
+	}
+
+	old, err := s.Locale(lang)
+	if err != nil {
+		return nil, err
+	}
+
+	l, err := s.compileLocale(tag, old.Description(), file)
+	if err != nil {
+		return nil, err
+	}
+	if err = s.replace(l); err != nil {
+		return nil, err
+	}
+	return content, nil
+}
+
+// localeContent loads the locale file of the language from the list of
+// sources, and returns the file with its content.
+func localeContent(lang string, source interface{}, others ...interface{}) (*ini.File, []byte, error) {
+	file, err := loadFile(lang, source, others...)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "load sources")
+	}
+
+	var buf bytes.Buffer
+	_, err = file.WriteTo(&buf)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "write locale file")
+	}
+	return file, buf.Bytes(), nil
+}
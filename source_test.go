@@ -0,0 +1,180 @@
+// Copyright 2026 Joe Chen. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package i18n
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// memorySource is a Source that keeps entries in memory.
+type memorySource struct {
+	mu      sync.Mutex
+	entries map[string][]SourceEntry
+	err     error
+	loads   int
+}
+
+func (s *memorySource) Entries(lang string) ([]SourceEntry, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.loads++
+	return s.entries[lang], s.err
+}
+
+func (s *memorySource) loaded() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.loads
+}
+
+func (s *memorySource) set(lang string, entries []SourceEntry, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.entries[lang] = entries
+	s.err = err
+}
+
+func TestStore_AddLocale_Source(t *testing.T) {
+	src := &memorySource{
+		entries: map[string][]SourceEntry{
+			"en-US": {
+				{Key: "title", Value: "Issue tracker"},
+				{Key: "issue", Form: "one", Value: "issue"},
+				{Key: "issue", Form: "other", Value: "issues"},
+				{Section: "messages", Key: "issues", Value: "%d open ${issue, 1}"},
+				{Section: "messages", Key: "hello", Value: "Howdy, %s!"},
+			},
+		},
+	}
+
+	t.Run("source only", func(t *testing.T) {
+		l, err := NewStore().AddLocale("en-US", "English", src)
+		require.Nil(t, err)
+
+		assert.Equal(t, "Issue tracker", l.Translate("title"))
+		assert.Equal(t, "2 open issues", l.Translate("messages::issues", 2))
+		assert.Equal(t, "issue", l.Plural("issue", 1))
+		assert.Equal(t, []string{"DEFAULT", "messages"}, l.Sections())
+	})
+
+	t.Run("with INI data sources", func(t *testing.T) {
+		l, err := NewStore().AddLocale("en-US", "English", []byte(`
+[messages]
+hello = Hello, %s!
+bye = Bye, %s!
+`), src)
+		require.Nil(t, err)
+
+		// Entries of the source take precedence
+		assert.Equal(t, "Howdy, Joe!", l.Translate("messages::hello", "Joe"))
+		assert.Equal(t, "Bye, Joe!", l.Translate("messages::bye", "Joe"))
+		assert.Equal(t, "2 open issues", l.Translate("messages::issues", 2))
+	})
+
+	t.Run("overlay", func(t *testing.T) {
+		s := NewStore()
+		base, err := s.AddLocale("en-US", "English", []byte(`
+[messages]
+hello = Hello, %s!
+`))
+		require.Nil(t, err)
+
+		l, err := s.Overlay(base, src)
+		require.Nil(t, err)
+		assert.Equal(t, "Howdy, Joe!", l.Translate("messages::hello", "Joe"))
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		_, err := NewStore().AddLocale("en-US", "English", &memorySource{err: fmt.Errorf("database is down")})
+		assert.Equal(t, "load sources: load entries: database is down", fmt.Sprintf("%v", err))
+
+		_, err = NewStore().AddLocale("en-US", "English", &memorySource{
+			entries: map[string][]SourceEntry{
+				"en-US": {{Section: "messages", Value: "Hello"}},
+			},
+		})
+		assert.Equal(t, `load sources: add entry "" of section "messages": error creating new key: empty key name`, fmt.Sprintf("%v", err))
+	})
+}
+
+func TestStore_PollLocale(t *testing.T) {
+	src := &memorySource{
+		entries: map[string][]SourceEntry{
+			"en-US": {{Key: "hello", Value: "Hello"}},
+		},
+	}
+
+	s := NewStore()
+	l, err := s.AddLocale("en-US", "English", src)
+	require.Nil(t, err)
+
+	errs := make(chan error, 10)
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		s.PollLocale(ctx, "en-US", time.Millisecond, func(err error) { errs <- err }, src)
+		close(done)
+	}()
+
+	translate := func() string {
+		l, err := s.Locale("en-US")
+		require.Nil(t, err)
+		return l.Translate("hello")
+	}
+
+	// The locale is not replaced until the source has changed.
+	assert.Eventually(t, func() bool { return src.loaded() > 3 }, time.Second, time.Millisecond)
+	current, err := s.Locale("en-US")
+	require.Nil(t, err)
+	assert.Same(t, l, current)
+
+	src.set("en-US", []SourceEntry{{Key: "hello", Value: "Howdy"}}, nil)
+	assert.Eventually(t, func() bool { return translate() == "Howdy" }, time.Second, time.Millisecond)
+
+	src.set("en-US", []SourceEntry{{Key: "hello", Value: "Hi"}}, fmt.Errorf("database is down"))
+	assert.Equal(t, `reload locale "en-US": load sources: load entries: database is down`, fmt.Sprintf("%v", <-errs))
+	assert.Equal(t, "Howdy", translate())
+
+	cancel()
+	<-done
+}
+
+func TestStore_reloadLocale(t *testing.T) {
+	src := &memorySource{
+		entries: map[string][]SourceEntry{
+			"en-US": {{Key: "hello", Value: "Hello"}},
+		},
+	}
+
+	s := NewStore()
+	l1, err := s.AddLocale("en-US", "English", src)
+	require.Nil(t, err)
+
+	last, err := s.reloadLocale("en-US", nil, src)
+	require.Nil(t, err)
+	l2, err := s.Locale("en-US")
+	require.Nil(t, err)
+	assert.NotSame(t, l1, l2)
+	assert.Equal(t, "English", l2.Description())
+
+	// Nothing has changed
+	_, err = s.reloadLocale("en-US", last, src)
+	require.Nil(t, err)
+	l3, err := s.Locale("en-US")
+	require.Nil(t, err)
+	assert.Same(t, l2, l3)
+
+	t.Run("non-existent locale", func(t *testing.T) {
+		_, err := s.reloadLocale("zh-CN", nil, src)
+		assert.Equal(t, ErrLocalNotFound, err)
+	})
+}
@@ -0,0 +1,69 @@
+// Copyright 2026 Joe Chen. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package i18n
+
+import (
+	"database/sql"
+
+	"github.com/pkg/errors"
+)
+
+// DefaultSQLQuery is the default query of SQLSource, which selects entries from
+// the table with the following schema:
+//
+//	CREATE TABLE i18n_messages (
+//		id      INTEGER PRIMARY KEY,
+//		lang    TEXT NOT NULL,
+//		section TEXT NOT NULL DEFAULT '',
+//		key     TEXT NOT NULL,
+//		form    TEXT NOT NULL DEFAULT '',
+//		value   TEXT NOT NULL
+//	);
+const DefaultSQLQuery = `SELECT section, key, form, value FROM i18n_messages WHERE lang = ? ORDER BY id`
+
+// SQLSource is a Source that loads entries from a database.
+type SQLSource struct {
+	db    *sql.DB
+	query string
+}
+
+// NewSQLSource returns a new Source that loads entries from the database with
+// the query. The query takes the language name as the only argument, and
+// returns rows of the section, key, form and value of entries (see
+// SourceEntry). The DefaultSQLQuery is used when the query is empty, which
+// might need to be adapted for the placeholder syntax and reserved words of the
+// database.
+func NewSQLSource(db *sql.DB, query string) *SQLSource {
+	if query == "" {
+		query = DefaultSQLQuery
+	}
+	return &SQLSource{
+		db:    db,
+		query: query,
+	}
+}
+
+// Entries returns all entries of the language in the database.
+func (s *SQLSource) Entries(lang string) ([]SourceEntry, error) {
+	rows, err := s.db.Query(s.query, lang)
+	if err != nil {
+		return nil, errors.Wrap(err, "query")
+	}
+	defer func() { _ = rows.Close() }()
+
+	var entries []SourceEntry
+	for rows.Next() {
+		var e SourceEntry
+		err = rows.Scan(&e.Section, &e.Key, &e.Form, &e.Value)
+		if err != nil {
+			return nil, errors.Wrap(err, "scan")
+		}
+		entries = append(entries, e)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "iterate rows")
+	}
+	return entries, nil
+}
@@ -0,0 +1,115 @@
+// Copyright 2026 Joe Chen. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package i18n
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryRows are rows of the "i18n_messages" table in the order of columns
+// "lang", "section", "key", "form" and "value", for the memory driver.
+var memoryRows = [][5]string{
+	{"en-US", "", "title", "", "Issue tracker"},
+	{"zh-CN", "", "title", "", "问题追踪"},
+	{"en-US", "", "issue", "one", "issue"},
+	{"en-US", "", "issue", "other", "issues"},
+	{"en-US", "messages", "issues", "", "%d open ${issue, 1}"},
+}
+
+func init() {
+	sql.Register("i18n-memory", memoryDriver{})
+}
+
+// memoryDriver is a database driver that only supports the DefaultSQLQuery on
+// the memoryRows.
+type memoryDriver struct{}
+
+func (memoryDriver) Open(string) (driver.Conn, error) { return memoryConn{}, nil }
+
+type memoryConn struct{}
+
+func (memoryConn) Prepare(query string) (driver.Stmt, error) {
+	if query != DefaultSQLQuery {
+		return nil, fmt.Errorf("unsupported query %q", query)
+	}
+	return memoryStmt{}, nil
+}
+func (memoryConn) Close() error              { return nil }
+func (memoryConn) Begin() (driver.Tx, error) { return nil, fmt.Errorf("unsupported") }
+
+type memoryStmt struct{}
+
+func (memoryStmt) Close() error  { return nil }
+func (memoryStmt) NumInput() int { return 1 }
+func (memoryStmt) Exec([]driver.Value) (driver.Result, error) {
+	return nil, fmt.Errorf("unsupported")
+}
+
+func (memoryStmt) Query(args []driver.Value) (driver.Rows, error) {
+	rows := &memoryResult{}
+	for i := range memoryRows {
+		if row := memoryRows[i]; row[0] == args[0] {
+			rows.rows = append(rows.rows, []string{row[1], row[2], row[3], row[4]})
+		}
+	}
+	return rows, nil
+}
+
+type memoryResult struct {
+	rows [][]string
+}
+
+func (*memoryResult) Columns() []string { return []string{"section", "key", "form", "value"} }
+func (*memoryResult) Close() error      { return nil }
+
+func (r *memoryResult) Next(dest []driver.Value) error {
+	if len(r.rows) == 0 {
+		return io.EOF
+	}
+	for i, v := range r.rows[0] {
+		dest[i] = v
+	}
+	r.rows = r.rows[1:]
+	return nil
+}
+
+func TestSQLSource(t *testing.T) {
+	db, err := sql.Open("i18n-memory", "")
+	require.Nil(t, err)
+	defer func() { _ = db.Close() }()
+
+	src := NewSQLSource(db, "")
+	entries, err := src.Entries("en-US")
+	require.Nil(t, err)
+	want := []SourceEntry{
+		{Key: "title", Value: "Issue tracker"},
+		{Key: "issue", Form: "one", Value: "issue"},
+		{Key: "issue", Form: "other", Value: "issues"},
+		{Section: "messages", Key: "issues", Value: "%d open ${issue, 1}"},
+	}
+	assert.Equal(t, want, entries)
+
+	s := NewStore()
+	l, err := s.AddLocale("en-US", "English", src)
+	require.Nil(t, err)
+	assert.Equal(t, "Issue tracker", l.Translate("title"))
+	assert.Equal(t, "1 open issue", l.Translate("messages::issues", 1))
+
+	l, err = s.AddLocale("zh-CN", "简体中文", src)
+	require.Nil(t, err)
+	assert.Equal(t, "问题追踪", l.Translate("title"))
+
+	t.Run("bad query", func(t *testing.T) {
+		_, err := NewSQLSource(db, "SELECT 1").Entries("en-US")
+		assert.Equal(t, `query: unsupported query "SELECT 1"`, fmt.Sprintf("%v", err))
+	})
+}
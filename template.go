@@ -0,0 +1,235 @@
+// Copyright 2026 Joe Chen. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package i18n
+
+import (
+	"html/template"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// FuncMap returns template functions backed by the store that work with both
+// html/template and text/template. The first argument of every function is the
+// locale, either a *Locale or a language name in the store:
+//   - {{T .Locale "key" args...}} translates the message with positional
+//     arguments.
+//   - {{TN .Locale "key" "name" value...}} translates the message with named
+//     arguments, which may also be a single map[string]interface{} or struct,
+//     see NamedArgs.
+//   - {{Plural .Locale "file" 2}} returns the plural form of the noun.
+//   - {{Lang .Locale}} and {{Dir .Locale}} return the language name and the
+//     text direction (i.e. "ltr" or "rtl").
+//   - {{Date .Locale .Time "medium"}}, {{Time ...}}, {{DateTime ...}},
+//     {{Relative .Locale .Duration "long"}}, {{List .Locale .Items "conjunction"}},
+//     {{Unit .Locale 5 "digital-megabyte" "short"}} and {{Compact .Locale 1200 "short"}}
+//     are the formatting helpers of the Locale.
+//
+// Translated messages are plain strings, thus they are escaped as a whole by
+// html/template. When any argument of T or TN is template.HTML, the message and
+// all other arguments are escaped while the template.HTML arguments are kept
+// intact, and the result is template.HTML.
+func FuncMap(s *Store) map[string]interface{} {
+	f := &funcMap{store: s}
+	return map[string]interface{}{
+		"T":        f.translate,
+		"TN":       f.translateNamed,
+		"Plural":   f.plural,
+		"Lang":     f.lang,
+		"Dir":      f.dir,
+		"Date":     f.date,
+		"Time":     f.time,
+		"DateTime": f.dateTime,
+		"Relative": f.relative,
+		"List":     f.list,
+		"Unit":     f.unit,
+		"Compact":  f.compact,
+	}
+}
+
+type funcMap struct {
+	store *Store
+}
+
+// locale returns the locale of the value that is either a *Locale or a language
+// name in the store.
+func (f *funcMap) locale(v interface{}) (*Locale, error) {
+	switch v := v.(type) {
+	case *Locale:
+		return v, nil
+	case string:
+		l, err := f.store.Locale(v)
+		if err != nil {
+			return nil, errors.Wrapf(err, "get locale %q", v)
+		}
+		return l, nil
+	}
+	return nil, errors.Errorf("invalid locale type %T; expected *i18n.Locale or string", v)
+}
+
+func (f *funcMap) translate(locale interface{}, key string, args ...interface{}) (interface{}, error) {
+	l, err := f.locale(locale)
+	if err != nil {
+		return nil, err
+	}
+
+	safe := make(map[string]string)
+	for i, arg := range args {
+		if html, ok := arg.(template.HTML); ok {
+			if len(safe) == 0 {
+				args = append([]interface{}(nil), args...) // Do not modify the caller's slice
+			}
+			args[i] = htmlSentinel(i, safe, html)
+		}
+	}
+	if len(safe) == 0 {
+		return l.Translate(key, args...), nil
+	}
+	return escapeHTML(l.Translate(key, args...), safe), nil
+}
+
+func (f *funcMap) translateNamed(locale interface{}, key string, pairs ...interface{}) (interface{}, error) {
+	l, err := f.locale(locale)
+	if err != nil {
+		return nil, err
+	}
+
+	args, err := namedArgsOf(pairs)
+	if err != nil {
+		return nil, err
+	}
+
+	safe := make(map[string]string)
+	i := 0
+	for name, arg := range args {
+		if html, ok := arg.(template.HTML); ok {
+			if len(safe) == 0 {
+				args = copyArgs(args) // Do not modify the caller's map
+			}
+			args[name] = htmlSentinel(i, safe, html)
+			i++
+		}
+	}
+	if len(safe) == 0 {
+		return l.TranslateNamed(key, args), nil
+	}
+	return escapeHTML(l.TranslateNamed(key, args), safe), nil
+}
+
+// namedArgsOf returns the named arguments of the name and value pairs, or the
+// single map[string]interface{} or struct.
+func namedArgsOf(pairs []interface{}) (map[string]interface{}, error) {
+	if len(pairs) == 1 {
+		if args, ok := pairs[0].(map[string]interface{}); ok {
+			return args, nil
+		}
+		if args := NamedArgs(pairs[0]); args != nil {
+			return args, nil
+		}
+	}
+
+	if len(pairs)%2 != 0 {
+		return nil, errors.Errorf("odd number of named arguments: %d", len(pairs))
+	}
+	args := make(map[string]interface{}, len(pairs)/2)
+	for i := 0; i < len(pairs); i += 2 {
+		name, ok := pairs[i].(string)
+		if !ok {
+			return nil, errors.Errorf("invalid argument name type %T at %d; expected string", pairs[i], i)
+		}
+		args[name] = pairs[i+1]
+	}
+	return args, nil
+}
+
+func copyArgs(args map[string]interface{}) map[string]interface{} {
+	copied := make(map[string]interface{}, len(args))
+	for name, arg := range args {
+		copied[name] = arg
+	}
+	return copied
+}
+
+func (f *funcMap) plural(locale interface{}, noun string, n interface{}) (string, error) {
+	l, err := f.locale(locale)
+	if err != nil {
+		return "", err
+	}
+	return l.Plural(noun, n), nil
+}
+
+func (f *funcMap) lang(locale interface{}) (string, error) {
+	l, err := f.locale(locale)
+	if err != nil {
+		return "", err
+	}
+	return l.Lang(), nil
+}
+
+func (f *funcMap) dir(locale interface{}) (string, error) {
+	l, err := f.locale(locale)
+	if err != nil {
+		return "", err
+	}
+	return l.Dir(), nil
+}
+
+func (f *funcMap) date(locale interface{}, t time.Time, style string) (string, error) {
+	l, err := f.locale(locale)
+	if err != nil {
+		return "", err
+	}
+	return l.FormatDate(t, style), nil
+}
+
+func (f *funcMap) time(locale interface{}, t time.Time, style string) (string, error) {
+	l, err := f.locale(locale)
+	if err != nil {
+		return "", err
+	}
+	return l.FormatTime(t, style), nil
+}
+
+func (f *funcMap) dateTime(locale interface{}, t time.Time, style string) (string, error) {
+	l, err := f.locale(locale)
+	if err != nil {
+		return "", err
+	}
+	return l.FormatDateTime(t, style), nil
+}
+
+// relative accepts a time.Duration or a time.Time like the "relative" format
+// placeholder.
+func (f *funcMap) relative(locale interface{}, v interface{}, style string) (string, error) {
+	l, err := f.locale(locale)
+	if err != nil {
+		return "", err
+	}
+	return formatRelativeTimeArg(l, style, v, nil), nil
+}
+
+func (f *funcMap) list(locale interface{}, items []string, style string) (string, error) {
+	l, err := f.locale(locale)
+	if err != nil {
+		return "", err
+	}
+	return l.FormatList(items, style), nil
+}
+
+func (f *funcMap) unit(locale interface{}, value interface{}, unit, width string) (string, error) {
+	l, err := f.locale(locale)
+	if err != nil {
+		return "", err
+	}
+	return l.FormatUnit(value, unit, width), nil
+}
+
+func (f *funcMap) compact(locale interface{}, value interface{}, style string) (string, error) {
+	l, err := f.locale(locale)
+	if err != nil {
+		return "", err
+	}
+	return l.FormatCompact(value, style), nil
+}
@@ -0,0 +1,137 @@
+// Copyright 2026 Joe Chen. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package i18n
+
+import (
+	"bytes"
+	"fmt"
+	htmltemplate "html/template"
+	"testing"
+	texttemplate "text/template"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFuncMap(t *testing.T) {
+	s := NewStore()
+	l, err := s.AddLocale(
+		"en-US",
+		"English",
+		[]byte(`
+[plurals]
+file.one = file
+file.other = files
+
+[messages]
+plain = Hello, %s!
+markup = <b>%s</b> changed %d ${file, 2}
+named = <b>{user}</b> changed {count} ${file, count}
+`),
+	)
+	assert.Nil(t, err)
+
+	data := map[string]interface{}{
+		"Locale":   l,
+		"User":     "<i>Joe</i>",
+		"Link":     htmltemplate.HTML(`<a href="/joe">Joe</a>`),
+		"Named":    map[string]interface{}{"user": htmltemplate.HTML(`<a href="/joe">Joe</a>`), "count": 1},
+		"Created":  time.Date(2021, time.March, 4, 5, 6, 7, 0, time.UTC),
+		"Duration": 72 * time.Hour,
+		"Items":    []string{"a", "b", "c"},
+	}
+
+	tests := []struct {
+		name     string
+		tmpl     string
+		wantText string
+		wantHTML string
+	}{
+		{
+			name:     "T",
+			tmpl:     `{{T .Locale "messages::plain" .User}}`,
+			wantText: `Hello, <i>Joe</i>!`,
+			wantHTML: `Hello, &lt;i&gt;Joe&lt;/i&gt;!`,
+		},
+		{
+			name:     "T with language name",
+			tmpl:     `{{T "en-US" "messages::plain" "Joe"}}`,
+			wantText: `Hello, Joe!`,
+			wantHTML: `Hello, Joe!`,
+		},
+		{
+			name:     "T with markup in message",
+			tmpl:     `{{T .Locale "messages::markup" .User 2}}`,
+			wantText: `<b><i>Joe</i></b> changed 2 files`,
+			wantHTML: `&lt;b&gt;&lt;i&gt;Joe&lt;/i&gt;&lt;/b&gt; changed 2 files`,
+		},
+		{
+			name:     "T with safe argument",
+			tmpl:     `{{T .Locale "messages::markup" .Link 1}}`,
+			wantText: `&lt;b&gt;<a href="/joe">Joe</a>&lt;/b&gt; changed 1 file`,
+			wantHTML: `&lt;b&gt;<a href="/joe">Joe</a>&lt;/b&gt; changed 1 file`,
+		},
+		{
+			name:     "TN with pairs",
+			tmpl:     `{{TN .Locale "messages::named" "user" .User "count" 2}}`,
+			wantText: `<b><i>Joe</i></b> changed 2 files`,
+			wantHTML: `&lt;b&gt;&lt;i&gt;Joe&lt;/i&gt;&lt;/b&gt; changed 2 files`,
+		},
+		{
+			name:     "TN with map and safe argument",
+			tmpl:     `{{TN .Locale "messages::named" .Named}}`,
+			wantText: `&lt;b&gt;<a href="/joe">Joe</a>&lt;/b&gt; changed 1 file`,
+			wantHTML: `&lt;b&gt;<a href="/joe">Joe</a>&lt;/b&gt; changed 1 file`,
+		},
+		{
+			name:     "Plural",
+			tmpl:     `{{Plural .Locale "file" 2}}`,
+			wantText: `files`,
+			wantHTML: `files`,
+		},
+		{
+			name:     "Lang and Dir",
+			tmpl:     `{{Lang .Locale}} {{Dir .Locale}}`,
+			wantText: `en-US ltr`,
+			wantHTML: `en-US ltr`,
+		},
+		{
+			name:     "Date, Time and DateTime",
+			tmpl:     `{{Date .Locale .Created "medium"}}; {{Time .Locale .Created "short"}}; {{DateTime .Locale .Created "short"}}`,
+			wantText: `Mar 4, 2021; 5:06 AM; 3/4/21, 5:06 AM`,
+			wantHTML: `Mar 4, 2021; 5:06 AM; 3/4/21, 5:06 AM`,
+		},
+		{
+			name:     "Relative",
+			tmpl:     `{{Relative .Locale .Duration "long"}}`,
+			wantText: `in 3 days`,
+			wantHTML: `in 3 days`,
+		},
+		{
+			name:     "List, Unit and Compact",
+			tmpl:     `{{List .Locale .Items "conjunction"}}; {{Unit .Locale 5 "digital-megabyte" "short"}}; {{Compact .Locale 1200 "short"}}`,
+			wantText: `a, b, and c; 5 MB; 1.2K`,
+			wantHTML: `a, b, and c; 5 MB; 1.2K`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			err := texttemplate.Must(texttemplate.New("").Funcs(FuncMap(s)).Parse(test.tmpl)).Execute(&buf, data)
+			assert.Nil(t, err)
+			assert.Equal(t, test.wantText, buf.String())
+
+			buf.Reset()
+			err = htmltemplate.Must(htmltemplate.New("").Funcs(FuncMap(s)).Parse(test.tmpl)).Execute(&buf, data)
+			assert.Nil(t, err)
+			assert.Equal(t, test.wantHTML, buf.String())
+		})
+	}
+
+	t.Run("no such locale", func(t *testing.T) {
+		err := texttemplate.Must(texttemplate.New("").Funcs(FuncMap(s)).Parse(`{{T "zh-CN" "messages::plain"}}`)).Execute(&bytes.Buffer{}, nil)
+		assert.Contains(t, fmt.Sprintf("%v", err), `get locale "zh-CN": locale not found`)
+	})
+}
@@ -0,0 +1,108 @@
+// Copyright 2026 Joe Chen. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package i18n
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"unknwon.dev/i18n/internal/plural"
+)
+
+// maxFractionDigits is the maximum number of fraction digits to keep when
+// formatting floats.
+const maxFractionDigits = 3
+
+// decimalString returns the decimal representation of the number in the form of
+// "-1234.5". Floats are rounded to at most maxFractionDigits fraction digits,
+// and strings are kept as-is to preserve visible fraction digits (e.g. "1.50").
+func decimalString(number interface{}) (string, error) {
+	switch v := number.(type) {
+	case int:
+		return strconv.FormatInt(int64(v), 10), nil
+	case int8:
+		return strconv.FormatInt(int64(v), 10), nil
+	case int16:
+		return strconv.FormatInt(int64(v), 10), nil
+	case int32:
+		return strconv.FormatInt(int64(v), 10), nil
+	case int64:
+		return strconv.FormatInt(v, 10), nil
+	case uint:
+		return strconv.FormatUint(uint64(v), 10), nil
+	case uint8:
+		return strconv.FormatUint(uint64(v), 10), nil
+	case uint16:
+		return strconv.FormatUint(uint64(v), 10), nil
+	case uint32:
+		return strconv.FormatUint(uint64(v), 10), nil
+	case uint64:
+		return strconv.FormatUint(v, 10), nil
+	case float32:
+		return formatFloat(float64(v)), nil
+	case float64:
+		return formatFloat(v), nil
+	case string:
+		if _, err := strconv.ParseFloat(v, 64); err != nil || strings.ContainsAny(v, "eE") {
+			return "", errors.Errorf("invalid number %q", v)
+		}
+		return v, nil
+	}
+	return "", errors.Errorf("invalid type %T; expected number or string", number)
+}
+
+func formatFloat(f float64) string {
+	s := strconv.FormatFloat(f, 'f', maxFractionDigits, 64)
+	s = strings.TrimRight(s, "0")
+	s = strings.TrimSuffix(s, ".")
+	if s == "-0" {
+		s = "0"
+	}
+	return s
+}
+
+// FormatUnit formats the value with the CLDR unit patterns of the locale, e.g.
+// "12.5 MB" for 12.5 of "digital-megabyte" in the "short" width. The plural
+// rule of the locale is used to pick the pattern, e.g. "1 hour" and "2 hours".
+//
+// The value is an integer, a float (rounded to at most 3 fraction digits) or a
+// decimal string (e.g. "1.50"). The unit is a CLDR unit identifier, e.g.
+// "digital-megabyte", "duration-hour" and "length-kilometer". The width is one
+// of "long", "short" and "narrow".
+func (l *Locale) FormatUnit(value interface{}, unit, width string) string {
+	number, err := decimalString(value)
+	if err != nil {
+		return fmt.Sprintf("<%v>", err)
+	}
+
+	patterns := l.cldr.Unit(unit, width)
+	if patterns == nil {
+		return fmt.Sprintf("<no such unit: %s:%s>", unit, width)
+	}
+
+	ops, err := plural.NewOperands(number)
+	if err != nil {
+		return fmt.Sprintf("<%v>", err)
+	}
+	pattern, ok := patterns[l.pluralForm(ops)]
+	if !ok {
+		pattern = patterns[plural.Other]
+	}
+	return strings.Replace(pattern, "{0}", l.cldr.Numbers.Format(number), 1)
+}
+
+// formatUnitArg formats a number argument with the unit. The style is the unit,
+// optionally followed by the width of ":long", ":short" (the default) or
+// ":narrow", e.g. "digital-megabyte:long".
+func formatUnitArg(l *Locale, style string, arg interface{}, _ []interface{}) string {
+	unit, width := style, "short"
+	if i := strings.IndexByte(style, ':'); i >= 0 {
+		unit, width = style[:i], style[i+1:]
+	}
+	return l.FormatUnit(arg, unit, width)
+}
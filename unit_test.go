@@ -0,0 +1,101 @@
+// Copyright 2026 Joe Chen. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package i18n
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecimalString(t *testing.T) {
+	tests := []struct {
+		number  interface{}
+		want    string
+		wantErr string
+	}{
+		{number: 12, want: "12"},
+		{number: int64(-12), want: "-12"},
+		{number: uint8(12), want: "12"},
+		{number: 12.5, want: "12.5"},
+		{number: float32(0.25), want: "0.25"},
+		{number: 2.0 / 3, want: "0.667"},
+		{number: -0.0001, want: "0"},
+		{number: "1.50", want: "1.50"},
+		{number: "1e3", wantErr: `invalid number "1e3"`},
+		{number: "", wantErr: `invalid number ""`},
+		{number: true, wantErr: "invalid type bool; expected number or string"},
+	}
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("%#v", test.number), func(t *testing.T) {
+			got, err := decimalString(test.number)
+			if test.wantErr != "" {
+				assert.EqualError(t, err, test.wantErr)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, test.want, got)
+		})
+	}
+}
+
+func TestLocale_FormatUnit(t *testing.T) {
+	s := NewStore()
+	en, err := s.AddLocale("en-US", "English", []byte(``))
+	assert.Nil(t, err)
+	de, err := s.AddLocale("de-DE", "Deutsch", []byte(``))
+	assert.Nil(t, err)
+	ru, err := s.AddLocale("ru-RU", "Русский", []byte(``))
+	assert.Nil(t, err)
+
+	tests := []struct {
+		name   string
+		locale *Locale
+		value  interface{}
+		unit   string
+		width  string
+		want   string
+	}{
+		{name: "short", locale: en, value: 12.5, unit: "digital-megabyte", width: "short", want: "12.5 MB"},
+		{name: "long one", locale: en, value: 1, unit: "duration-hour", width: "long", want: "1 hour"},
+		{name: "long other", locale: en, value: 3, unit: "duration-hour", width: "long", want: "3 hours"},
+		{name: "visible fraction digits", locale: en, value: "1.0", unit: "length-kilometer", width: "long", want: "1.0 kilometers"},
+		{name: "narrow", locale: en, value: 5, unit: "length-kilometer", width: "narrow", want: "5km"},
+		{name: "grouping", locale: en, value: 1234567, unit: "digital-byte", width: "long", want: "1,234,567 bytes"},
+		{name: "de decimal", locale: de, value: 12.5, unit: "digital-megabyte", width: "short", want: "12,5 MB"},
+		{name: "de plural", locale: de, value: 2, unit: "duration-day", width: "long", want: "2 Tage"},
+		{name: "ru few", locale: ru, value: 3, unit: "duration-hour", width: "long", want: "3 часа"},
+		{name: "ru many", locale: ru, value: 5, unit: "duration-hour", width: "long", want: "5 часов"},
+		{name: "ru other", locale: ru, value: 1.5, unit: "duration-hour", width: "long", want: "1,5 часа"},
+		{name: "no such unit", locale: en, value: 1, unit: "length-furlong", width: "long", want: "<no such unit: length-furlong:long>"},
+		{name: "invalid type", locale: en, value: true, unit: "duration-hour", width: "long", want: "<invalid type bool; expected number or string>"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := test.locale.FormatUnit(test.value, test.unit, test.width)
+			assert.Equal(t, test.want, got)
+		})
+	}
+}
+
+func TestLocale_Translate_Unit(t *testing.T) {
+	l, err := NewStore().AddLocale("en-US", "English", []byte(`
+[plurals]
+file.one = file
+file.other = files
+
+[messages]
+size = %[1]d ${file, 1}, ${unit:digital-megabyte, 2}
+elapsed = Finished in ${unit:duration-second:long, 1}
+`))
+	assert.Nil(t, err)
+
+	got := l.Translate("messages::size", 3, 12.5)
+	assert.Equal(t, "3 files, 12.5 MB", got)
+
+	got = l.Translate("messages::elapsed", 1)
+	assert.Equal(t, "Finished in 1 second", got)
+}